@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/Smartling/smartling-cli/cmd/helpers/build"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	"github.com/spf13/cobra"
 )
@@ -20,6 +21,10 @@ var (
 	proxy              string
 	verbose            int
 	showConfig         bool
+	recordDir          string
+	replayDir          string
+	logFormat          string
+	logFile            string
 
 	isInit     bool
 	isFiles    bool
@@ -34,7 +39,21 @@ func NewRootCmd() *cobra.Command {
 		Short:   "Manage translation files using Smartling CLI.",
 		Version: build.CliVersion,
 		Long: `Manage translation files using Smartling CLI.
-                Complete documentation is available at https://www.smartling.com`,
+                Complete documentation is available at https://www.smartling.com
+
+Exit codes:
+  0  success
+  1  general error
+  2  invalid arguments, configuration or Smartling validation error
+  3  authentication failed
+  4  project, job, file or glossary not found
+  5  partial failure: some items of the operation failed
+  6  network error: Smartling could not be reached
+  7  timed out waiting, e.g. "jobs wait --timeout"
+  8  awaited job was cancelled
+
+With --output json, failures are printed to stdout as
+  {"error": {"code", "exitCode", "message", "operation", "description", "fields"}}`,
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 			return RunRootPersistentPreRun(cmd)
 		},
@@ -71,6 +90,13 @@ purposes.`)
 	rootCmd.PersistentFlags().BoolVar(&showConfig, "show-config", false,
 		`Print the resolved account, project, user, and config file path
 to stderr before the command runs.`)
+	rootCmd.PersistentFlags().StringVar(&recordDir, "record", "", `Record every HTTP request/response pair into
+specified directory. Secrets and tokens are redacted.`)
+	rootCmd.PersistentFlags().StringVar(&replayDir, "replay", "", `Serve HTTP responses from directory previously
+populated with --record instead of calling Smartling API.`)
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", rlog.TextFormat, `Log line format: text or json. JSON lines carry level,
+command path, file/locale and HTTP request details.`)
+	rootCmd.PersistentFlags().StringVar(&logFile, "log-file", "", `Append log lines to specified file instead of stderr.`)
 
 	return rootCmd
 }
@@ -78,6 +104,9 @@ to stderr before the command runs.`)
 // RunRootPersistentPreRun runs before any subcommand.
 func RunRootPersistentPreRun(cmd *cobra.Command) error {
 	configureLoggerVerbose()
+	if err := configureLoggerOutput(cmd.CommandPath()); err != nil {
+		return err
+	}
 
 	path := cmd.CommandPath()
 	isInit = strings.HasPrefix(path, "smartling-cli init")
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	"github.com/Smartling/smartling-cli/services/helpers/client"
 	"github.com/Smartling/smartling-cli/services/helpers/config"
@@ -25,6 +27,8 @@ func CLIClientConfig() client.Config {
 		Insecure:     insecure,
 		Proxy:        proxy,
 		SmartlingURL: smartlingURL,
+		RecordDir:    recordDir,
+		ReplayDir:    replayDir,
 	}
 }
 
@@ -84,3 +88,20 @@ func configureLoggerVerbose() {
 		rlog.SetLevel(lorg.LevelDebug)
 	}
 }
+
+func configureLoggerOutput(commandPath string) error {
+	if err := rlog.SetLogFormat(logFormat); err != nil {
+		return err
+	}
+	rlog.SetCommand(commandPath)
+
+	if logFile == "" {
+		return nil
+	}
+	file, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("unable to open log file: %w", err)
+	}
+	rlog.SetOutput(file)
+	return nil
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+)
+
+// ErrNotConfirmed is returned when the operator declines a destructive action.
+var ErrNotConfirmed = errors.New("operation aborted by user")
+
+// Confirm asks the operator "<question> [y/N]:" before a destructive action.
+// assumeYes skips the prompt; without it the action is refused when stdin is
+// not an interactive terminal, so scripts have to opt in explicitly.
+func Confirm(question string, assumeYes bool) error {
+	return confirm(question, assumeYes, os.Stderr, os.Stdin, stdinIsTerminal())
+}
+
+func confirm(question string, assumeYes bool, stderrW io.Writer, stdinR io.Reader, stdinIsTerminal bool) error {
+	if assumeYes {
+		return nil
+	}
+	if !stdinIsTerminal {
+		return clierror.UIError{
+			Operation:   "confirm",
+			Err:         errors.New("confirmation required"),
+			Description: "stdin is not a terminal; pass --yes to confirm",
+		}
+	}
+	_, _ = fmt.Fprintf(stderrW, "%s [y/N]: ", question)
+	if !confirmContinue(stdinR) {
+		_, _ = fmt.Fprintln(stderrW, "aborted")
+		return ErrNotConfirmed
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+)
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name       string
+		assumeYes  bool
+		isTerminal bool
+		input      string
+		wantErr    error
+		wantPrompt string
+	}{
+		{name: "--yes skips the prompt", assumeYes: true},
+		{name: "terminal confirms", isTerminal: true, input: "y\n", wantPrompt: "Delete? [y/N]: "},
+		{name: "terminal declines", isTerminal: true, input: "\n", wantErr: ErrNotConfirmed, wantPrompt: "Delete? [y/N]: aborted\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr bytes.Buffer
+			err := confirm("Delete?", tt.assumeYes, &stderr, strings.NewReader(tt.input), tt.isTerminal)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("confirm() error = %v, want %v", err, tt.wantErr)
+			}
+			if stderr.String() != tt.wantPrompt {
+				t.Errorf("confirm() prompt = %q, want %q", stderr.String(), tt.wantPrompt)
+			}
+		})
+	}
+
+	var uiErr clierror.UIError
+	if err := confirm("Delete?", false, &bytes.Buffer{}, strings.NewReader("y\n"), false); !errors.As(err, &uiErr) {
+		t.Errorf("confirm() without a terminal error = %v, want UIError", err)
+	}
+}
@@ -0,0 +1,122 @@
+package devserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	listen        string
+	sourceLocale  string
+	targetLocales []string
+)
+
+// NewDevServerCmd creates a new dev-server command.
+func NewDevServerCmd() *cobra.Command {
+	devServerCmd := &cobra.Command{
+		Use:   "dev-server",
+		Short: "Run an in-memory Smartling API stand-in for offline testing.",
+		Long: `smartling-cli dev-server — run an in-memory Smartling API stand-in.
+
+Serves the subset of Smartling APIs used by this tool (authentication,
+projects, files, job batches, jobs, glossaries and machine translation)
+from memory, so commands can be exercised end-to-end without network
+access or real credentials by pointing --smartling-url at it.
+
+State lives only as long as the process. Translations are never produced:
+downloads of translated files return the original content.
+
+Project and account IDs are taken from --project and --account; when
+--user and --secret are given, only these credentials are accepted.
+
+Available options:
+  --listen <address>
+    Address to listen on.
+
+  --source-locale <locale>
+    Source locale of the seeded project.
+
+  --target-locales <locale>[,<locale>...]
+    Target locales of the seeded project.
+`,
+		Example: `
+# Start server and run commands against it from another terminal
+
+  smartling-cli dev-server -p devproject -a devaccount
+
+  smartling-cli --smartling-url http://127.0.0.1:8088 -p devproject -a devaccount \
+    --user any --secret any files push '**/*.json'
+
+`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			params := devserver.Params{
+				AccountUID:     flagOrDefault(cmd, "account", "devaccount"),
+				ProjectID:      flagOrDefault(cmd, "project", "devproject"),
+				ProjectName:    "Dev Project",
+				SourceLocaleID: sourceLocale,
+				TargetLocales:  targetLocales,
+				UserID:         flagOrDefault(cmd, "user", ""),
+				Secret:         flagOrDefault(cmd, "secret", ""),
+			}
+			if err := run(cmd.Context(), params); err != nil {
+				rlog.Errorf("failed to run dev-server: %s", err)
+				os.Exit(clierror.ExitCode(err))
+			}
+		},
+	}
+	devServerCmd.Flags().StringVar(&listen, "listen", "127.0.0.1:8088", "Address to listen on.")
+	devServerCmd.Flags().StringVar(&sourceLocale, "source-locale", "en-US", "Source locale of the seeded project.")
+	devServerCmd.Flags().StringSliceVar(&targetLocales, "target-locales", []string{"de-DE", "fr-FR"},
+		"Target locales of the seeded project.")
+
+	return devServerCmd
+}
+
+func run(ctx context.Context, params devserver.Params) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           devserver.NewServer(params),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Printf("listening on http://%s (project %s, account %s)\n",
+		listener.Addr(), params.ProjectID, params.AccountUID)
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func flagOrDefault(cmd *cobra.Command, name, fallback string) string {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return fallback
+	}
+	return value
+}
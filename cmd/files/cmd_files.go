@@ -7,6 +7,7 @@ import (
 	"github.com/Smartling/smartling-cli/cmd"
 	"github.com/Smartling/smartling-cli/services/files"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	"github.com/Smartling/smartling-cli/services/jobs/lifecycle"
 
 	batchapi "github.com/Smartling/api-sdk-go/api/batches"
 	jobapi "github.com/Smartling/api-sdk-go/api/job"
@@ -70,6 +71,6 @@ func (i srvInitializer) InitFilesSrv(ctx context.Context) (files.Service, error)
 	batchApi := batchapi.NewBatch(client.Client)
 	jobApi := jobapi.NewJob(client.Client)
 	jobFileApi := jobfile.NewJobFile(client.Client)
-	srv := files.NewService(&client, batchApi, jobApi, jobFileApi.List, cnf, fileConfig)
+	srv := files.NewService(&client, batchApi, jobApi, jobFileApi.List, jobFileApi.Remove, lifecycle.NewAPI(client.Client), cnf, fileConfig)
 	return srv, nil
 }
@@ -3,17 +3,22 @@ package delete
 import (
 	"os"
 
-	"github.com/Smartling/smartling-cli/cmd/files"
+	filescmd "github.com/Smartling/smartling-cli/cmd/files"
+	"github.com/Smartling/smartling-cli/services/files"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	"github.com/spf13/cobra"
 )
 
-var uri string
+var (
+	uri        string
+	reportFile string
+)
 
 // NewDeleteCmd creates a new command to delete files.
-func NewDeleteCmd(initializer files.SrvInitializer) *cobra.Command {
+func NewDeleteCmd(initializer filescmd.SrvInitializer) *cobra.Command {
 	deleteCmd := &cobra.Command{
 		Use:   "delete <uri>",
 		Short: "Deletes given file from Smartling.",
@@ -31,6 +36,9 @@ to read files list from stdin:
 Available options:
   -p --project <project>
     Specify project to use.
+
+  --report <file>
+    Write per-file results as JSON to the specified file.
 ` + help.AuthenticationOptions,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := cmd.Context()
@@ -41,16 +49,21 @@ Available options:
 			s, err := initializer.InitFilesSrv(ctx)
 			if err != nil {
 				rlog.Errorf("failed to get files service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
-			err = s.RunDelete(ctx, uri)
+			err = s.RunDelete(ctx, files.DeleteParams{
+				URI:        uri,
+				ReportFile: reportFile,
+			})
 			if err != nil {
 				rlog.Errorf("failed to run delete: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
 
+	deleteCmd.Flags().StringVar(&reportFile, "report", "", `Write per-file results as JSON to specified file.`)
+
 	return deleteCmd
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	cmdmocks "github.com/Smartling/smartling-cli/cmd/files/mocks"
+	"github.com/Smartling/smartling-cli/services/files"
 	srvmocks "github.com/Smartling/smartling-cli/services/files/mocks"
 
 	"github.com/stretchr/testify/mock"
@@ -16,9 +17,9 @@ func TestNewDeleteCmd(t *testing.T) {
 	buf := new(bytes.Buffer)
 	filesSrv := srvmocks.NewMockService(t)
 	uriArg := "https://example.com:8080/path/to/resource?search=a"
-	filesSrv.On("RunDelete", mock.Anything, uriArg).Run(func(args mock.Arguments) {
+	filesSrv.On("RunDelete", mock.Anything, files.DeleteParams{URI: uriArg}).Run(func(args mock.Arguments) {
 		fmt.Fprintf(buf, "RunDelete was called with %d args\n", len(args))
-		fmt.Fprintf(buf, "uri: %v\n", args[1])
+		fmt.Fprintf(buf, "uri: %v\n", args[1].(files.DeleteParams).URI)
 	}).Return(nil)
 
 	initializer := cmdmocks.NewMockSrvInitializer(t)
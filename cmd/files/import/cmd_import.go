@@ -5,6 +5,7 @@ import (
 
 	filescmd "github.com/Smartling/smartling-cli/cmd/files"
 	"github.com/Smartling/smartling-cli/services/files"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
@@ -66,7 +67,7 @@ Available options:
 			s, err := initializer.InitFilesSrv(ctx)
 			if err != nil {
 				rlog.Errorf("failed to get files service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			params := files.ImportParams{
@@ -80,7 +81,7 @@ Available options:
 			err = s.RunImport(ctx, params)
 			if err != nil {
 				rlog.Errorf("failed to run import: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
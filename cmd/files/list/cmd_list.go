@@ -4,6 +4,7 @@ import (
 	"os"
 
 	filescmd "github.com/Smartling/smartling-cli/cmd/files"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/format"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
@@ -71,13 +72,13 @@ Available options:
 			s, err := initializer.InitFilesSrv(ctx)
 			if err != nil {
 				rlog.Errorf("failed to get files service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			err = s.RunList(ctx, formatType, short, uri)
 			if err != nil {
 				rlog.Errorf("failed to run list: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
@@ -8,6 +8,7 @@ import (
 	filescmd "github.com/Smartling/smartling-cli/cmd/files"
 	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
 	"github.com/Smartling/smartling-cli/services/files"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/format"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
@@ -30,6 +31,7 @@ var (
 	formatPath  string
 	locales     []string
 	threads     uint32
+	reportFile  string
 )
 
 // NewPullCmd creates a new command to pull files.
@@ -116,6 +118,10 @@ Available options:
     Does not call GetFileStatus, so --progress filtering is not applied.
     Without --job, locale list comes from --locale flags only; omitting --locale
     produces no output.
+
+  --report <file>
+    Write per-file × locale results (ok, skipped, failed) as JSON
+    to the specified file.
 ` + help.AuthenticationOptions,
 		Example: `
 # Pull translated files
@@ -151,7 +157,7 @@ Available options:
 			s, err := initializer.InitFilesSrv(ctx)
 			if err != nil {
 				rlog.Errorf("failed to get files service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			var threadsCfg *string
@@ -167,7 +173,7 @@ Available options:
 			threadsParamI, err := strconv.ParseUint(threadsParam, 10, 32)
 			if err != nil {
 				rlog.Errorf("failed to parse `threads` parameter: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			params := files.PullParams{
@@ -184,11 +190,12 @@ Available options:
 				Resume:       resume,
 				DryRun:       dryRun,
 				Threads:      uint32(threadsParamI),
+				ReportFile:   reportFile,
 			}
 			err = s.RunPull(ctx, params)
 			if err != nil {
 				rlog.Errorf("failed to run pull: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
@@ -202,6 +209,7 @@ Available options:
 	pullCmd.Flags().StringArrayVarP(&locales, "locale", "l", []string{}, `Authorize only specified locales.`)
 	pullCmd.Flags().BoolVar(&resume, "resume", false, `Resume a previously interrupted pull operation, skipping already downloaded files.`)
 	pullCmd.Flags().BoolVar(&dryRun, "dry-run", false, `Print the file × locale matrix that would be downloaded, then exit.`)
+	pullCmd.Flags().StringVar(&reportFile, "report", "", `Write per-file × locale results as JSON to specified file.`)
 	pullCmd.Flags().Uint32Var(&threads, threadsFlag, 20, `If command can be executed concurrently, it will be
 executed for at most <number> of threads.`)
 	pullCmd.Flags().StringVar(&formatPath, "format", "", `Can be used to format path to downloaded files.
@@ -19,14 +19,19 @@ func NewPushCmd(initializer filescmd.SrvInitializer) *cobra.Command {
 		directory  string
 		directives []string
 		job        string
+		template   string
 		nojob      bool
+		reportFile string
+
+		changedOnly   bool
+		removeMissing bool
 	)
 
 	pushCmd := &cobra.Command{
 		Use:     "push <file> <uri> --job <job name> [--authorize] [--locale <locale>]",
 		Aliases: []string{"upload"},
 		Short:   "Creates job and uploads specified file into this job.",
-		Long: `smartling-cli files push <file> [<uri>] --job <job name> [--authorize] [--locale <locale>] [--type <type>] [--branch (@auto|<branch name>)] [--directory <work dir>] [--directive <smartling directive>]
+		Long: `smartling-cli files push <file> [<uri>] (--job <job name>|--job-template <name>) [--authorize] [--locale <locale>] [--type <type>] [--branch (@auto|<branch name>)] [--directory <work dir>] [--directive <smartling directive>]
 
 Creates a new job (or reuses existing) in Smartling TMS and uploads designated
 file(s) for translation.
@@ -44,6 +49,33 @@ You can use the same job name for multiple CLI calls. The same job will be used
 in this case (CLI searches by the job name). If the job with the same name exists,
 but it has state Canceled or Closed, then a new job will be created with timestamp suffix.
 
+Use --job-template option to create the job from a named template in the
+"jobs" section of the config file, so every team member creates identically
+shaped jobs:
+
+  jobs:
+    release:
+      name: "Release {branch} {date}"
+      description: "Strings for the upcoming release"
+      due_in: 5d
+      locales: [fr-FR, de-DE]
+      reference_number: REL
+      callback_url: https://example.com/smartling-hook
+      custom_fields:
+        <field uid>: <value>
+
+The name may contain {branch} (--branch value or current git branch),
+{date} (YYYY-MM-DD) and {user} (current OS user) placeholders. due_in is a
+duration (36h) or a number of days (5d). An open job with the rendered name
+is reused. --locale options override the template locales.
+
+To push into an existing job incrementally, use --changed-only option.
+The job's files are compared with the local files: files missing from the
+job are added, files whose content differs from the uploaded original are
+updated, and unchanged files are not uploaded again. With --remove-missing,
+job files which are not among the local files are removed from the job.
+An added/updated/removed summary is printed at the end.
+
 To authorize the job after uploading all files, use --authorize option.
 
 To specify locales for the files in the job, use one or more --locale options.
@@ -73,6 +105,14 @@ can be used to override detected file type.
 
   smartling-cli files upload "src/**/*.json" --nojob
 
+# Upload files into a job created from the "release" config template
+
+  smartling-cli files push "src/**/*.json" --job-template release --branch @auto
+
+# Upload only new and changed files into a release job and drop files deleted locally
+
+  smartling-cli files push "src/**/*.json" --job aabbccdd1122 --changed-only --remove-missing
+
 # Manual branch naming
 
   smartling-cli files push "**/*.txt" --branch "feature-branch"
@@ -126,7 +166,12 @@ can be used to override detected file type.
 				FileType:    fileType,
 				Directives:  directives,
 				JobIDOrName: job,
+				JobTemplate: template,
 				NoJob:       nojob,
+				ReportFile:  reportFile,
+
+				ChangedOnly:   changedOnly,
+				RemoveMissing: removeMissing,
 			}
 
 			return s.RunPush(ctx, p)
@@ -149,7 +194,16 @@ Override automatically detected file type.`)
 Provide a name for the Smartling translation job or job UID.
 All files will be uploaded into this job.
 If the flag is not specified then the "CLI uploads" name will be used.`)
+	pushCmd.Flags().StringVar(&template, "job-template", "", `<template name>
+Create or reuse the job described by the named template from the "jobs"
+section of the config file.`)
 	pushCmd.Flags().BoolVarP(&nojob, "nojob", "", false, `Upload the file without adding it to a translation job. The file will be available in Smartling but will not be part of any translation workflow.`)
+	pushCmd.Flags().BoolVar(&changedOnly, "changed-only", false, `Compare the job's files with the local files and upload only files
+which are new to the job or differ from the uploaded original.`)
+	pushCmd.Flags().BoolVar(&removeMissing, "remove-missing", false, `Remove files which are not among the local files from the job.
+Requires --changed-only.`)
+	pushCmd.Flags().StringVar(&reportFile, "report", "", `<file>
+Write per-file results as JSON to specified file.`)
 
 	return pushCmd
 }
@@ -4,6 +4,7 @@ import (
 	"os"
 
 	filescmd "github.com/Smartling/smartling-cli/cmd/files"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
@@ -40,13 +41,13 @@ Available options:
 			s, err := initializer.InitFilesSrv(ctx)
 			if err != nil {
 				rlog.Errorf("failed to get files service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			err = s.RunRename(ctx, old, new)
 			if err != nil {
 				rlog.Errorf("failed to run rename: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
@@ -0,0 +1,52 @@
+package glarchive
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+const yesFlag = "yes"
+
+// NewArchiveCmd builds the `glossaries archive` command.
+func NewArchiveCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var yes bool
+
+	archiveCmd := &cobra.Command{
+		Use:   "archive <glossaryUID|glossaryName>",
+		Short: "Archive a glossary",
+		Long: `Archive a glossary. An archived glossary keeps its entries and can still be
+viewed and exported, but is no longer applied to translations.
+
+The command asks for confirmation; pass --yes to archive without a prompt,
+which is required when stdin is not a terminal.`,
+		Example: `
+# Archive a glossary without a prompt
+
+  smartling-cli glossaries archive "CLI glossary" --yes
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve archive params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, yes, outputParams)
+		},
+	}
+
+	archiveCmd.Flags().BoolVar(&yes, yesFlag, false, "Archive without asking for confirmation.")
+
+	return archiveCmd
+}
@@ -0,0 +1,24 @@
+package glarchive
+
+import (
+	"context"
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+func run(ctx context.Context,
+	initializer glossariescmd.SrvInitializer,
+	params srv.GlossaryParams,
+	yes bool,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running glossary archive with params: %v", params)
+	question := func(view srv.ViewOutput) string {
+		return fmt.Sprintf("Archive glossary %q (%s) with %d active entries?", view.GlossaryName, view.GlossaryUID, view.ActiveEntries)
+	}
+	return glossariescmd.RunRemoval(ctx, initializer, params, yes, outputParams, question, srv.Service.RunArchive)
+}
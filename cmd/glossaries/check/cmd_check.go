@@ -0,0 +1,98 @@
+package glcheck
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Flag names accepted by `glossaries check`.
+const (
+	glossaryFileFlag = "glossary-file"
+	mediaTypeFlag    = "media-type"
+	sourceLocaleFlag = "source-locale"
+	localeFlag       = "locale"
+	fileFlag         = "file"
+)
+
+// NewCheckCmd builds the `glossaries check` command.
+func NewCheckCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var (
+		glossaryFile string
+		mediaType    string
+		sourceLocale string
+		locales      []string
+		files        []string
+	)
+
+	checkCmd := &cobra.Command{
+		Use:   "check [<glossaryUID|glossaryName>]",
+		Short: "Check local translations against a glossary",
+		Long: `Scan local source files and their pulled translations for glossary terms
+which the translation does not follow: a source term is used, but the
+translation does not use its approved translation, or a do-not-translate
+(DNT) term was translated.
+
+The glossary is exported from Smartling as TBX, or read from a local CSV,
+XLSX, or TBX file given by --glossary-file; with --glossary-file the check
+runs offline and needs no credentials. Export the glossary once with
+"glossaries export" to check offline from then on.
+
+Source files are the files matched by the --file patterns, or by the
+"files:" patterns of the config file which have a push type, as
+"files push" uploads them. Translations are looked up where "files pull"
+writes them, following the pull format of the config file. Files are
+compared line by line when source and translation have the same number of
+lines, and as a whole otherwise. Terms match case-insensitively as whole
+words.
+
+The source locale defaults to the first locale of the glossary, and every
+other locale is checked unless --locale limits them. The command exits with
+code 5 when violations are found, so it can gate a release.`,
+		Example: `
+# Check the translations of the configured files
+
+  smartling-cli glossaries check "CLI glossary"
+
+# Check offline against an exported glossary
+
+  smartling-cli glossaries export "CLI glossary" terms.tbx --file-type tbx --tbx-version v3
+  smartling-cli glossaries check --glossary-file terms.tbx
+
+# Check the German translations of JSON files only
+
+  smartling-cli glossaries check "CLI glossary" --file "locales/*.json" --locale de-DE --output table
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var glossaryUIDOrName string
+			if len(args) == 1 {
+				glossaryUIDOrName = args[0]
+			}
+			params, err := resolveParams(cmd, glossaryUIDOrName, glossaryFile, mediaType, sourceLocale, locales, files)
+			if err != nil {
+				return fmt.Errorf("failed to resolve check params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	checkCmd.Flags().StringVar(&glossaryFile, glossaryFileFlag, "", "Local glossary file to check against instead of exporting a glossary.")
+	checkCmd.Flags().StringVar(&mediaType, mediaTypeFlag, "", `Override the media type of --glossary-file. Must be one of "text/csv", "text/xml", or "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet". By default derived from the file extension.`)
+	checkCmd.Flags().StringVar(&sourceLocale, sourceLocaleFlag, "", "Locale of the source files. Defaults to the first locale of the glossary.")
+	checkCmd.Flags().StringArrayVar(&locales, localeFlag, nil, "Locale of the translations to check (repeatable). Defaults to every other glossary locale.")
+	checkCmd.Flags().StringArrayVar(&files, fileFlag, nil, `Pattern of the source files to check (repeatable). Defaults to the "files:" patterns of the config file.`)
+
+	return checkCmd
+}
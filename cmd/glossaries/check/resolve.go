@@ -0,0 +1,68 @@
+package glcheck
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/config"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+func resolveParams(cmd *cobra.Command, glossaryUIDOrName, glossaryFile, mediaType, sourceLocale string, locales, files []string) (srv.CheckParams, error) {
+	rlog.Debugf("resolving check params")
+
+	params := srv.CheckParams{
+		GlossaryUIDOrName: glossaryUIDOrName,
+		GlossaryFile:      glossaryFile,
+		SourceLocaleID:    sourceLocale,
+		LocaleIDs:         locales,
+		Patterns:          files,
+		Directory:         resolve.ConfigDirectory(cmd),
+	}
+	if glossaryFile != "" {
+		params.GlossaryFormat = glossaryfile.FormatFromPath(glossaryFile)
+		if mediaType != "" {
+			params.GlossaryFormat = glossaryfile.FormatFromMediaType(mediaType)
+		}
+	}
+
+	if glossaryUIDOrName == "" {
+		// Offline checks read the config file for its `files:` patterns
+		// only, so that they need no credentials.
+		path, err := config.GetPath(params.Directory, resolve.ConfigFile(cmd), false)
+		if err != nil {
+			if len(files) > 0 {
+				return params, nil
+			}
+			return srv.CheckParams{}, err
+		}
+		if params.Config, err = config.LoadConfigFromFile(path); err != nil {
+			return srv.CheckParams{}, clierror.UIError{
+				Operation:   "config",
+				Err:         err,
+				Description: "failed to read config",
+			}
+		}
+		return params, nil
+	}
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.CheckParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+	params.Config = cnf
+
+	params.AccountUID, err = resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.CheckParams{}, err
+	}
+	return params, nil
+}
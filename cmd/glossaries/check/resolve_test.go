@@ -0,0 +1,87 @@
+package glcheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	m.Run()
+}
+
+func makeCmd(t *testing.T, configPath, accountFlag string) *cobra.Command {
+	t.Helper()
+	root := rootcmd.NewRootCmd()
+	checkCmd := NewCheckCmd(nil)
+	root.AddCommand(checkCmd)
+	_ = root.PersistentFlags().Set("config", configPath)
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+	if accountFlag != "" {
+		_ = root.PersistentFlags().Set("account", accountFlag)
+	}
+	return checkCmd
+}
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	cfgPath := filepath.Join(t.TempDir(), "smartling.yml")
+	require.NoError(t, os.WriteFile(cfgPath, []byte(content), 0o600))
+	return cfgPath
+}
+
+func Test_resolveParams(t *testing.T) {
+	const files = "files:\n  \"*.properties\":\n    push:\n      type: javaProperties\n"
+
+	t.Run("offline — config patterns without credentials", func(t *testing.T) {
+		cfgPath := writeConfig(t, files)
+		got, err := resolveParams(makeCmd(t, cfgPath, ""), "", "terms.TBX", "", "en-US", []string{"de-DE"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, glossaryfile.FormatTBX, got.GlossaryFormat)
+		assert.Equal(t, "terms.TBX", got.GlossaryFile)
+		assert.Equal(t, "en-US", got.SourceLocaleID)
+		assert.Equal(t, []string{"de-DE"}, got.LocaleIDs)
+		assert.Equal(t, cfgPath, got.Config.Path)
+		assert.Contains(t, got.Config.Files, "*.properties")
+		assert.Empty(t, got.AccountUID)
+	})
+
+	t.Run("--media-type overrides the extension", func(t *testing.T) {
+		got, err := resolveParams(makeCmd(t, writeConfig(t, ""), ""), "", "terms.dat", "text/csv", "", nil, []string{"*.json"})
+		require.NoError(t, err)
+		assert.Equal(t, glossaryfile.FormatCSV, got.GlossaryFormat)
+		assert.Equal(t, []string{"*.json"}, got.Patterns)
+	})
+
+	t.Run("glossary with account from config file", func(t *testing.T) {
+		t.Setenv("SMARTLING_USER_ID", "test-user")
+		t.Setenv("SMARTLING_SECRET", "test-secret")
+		cfgPath := writeConfig(t, "account_id: config-account-uid\n"+files)
+		got, err := resolveParams(makeCmd(t, cfgPath, ""), "CLI glossary", "", "", "", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, srv.CheckParams{
+			AccountUID:        uid.AccountUID("config-account-uid"),
+			GlossaryUIDOrName: "CLI glossary",
+			Directory:         ".",
+			Config:            got.Config,
+		}, got)
+		assert.Contains(t, got.Config.Files, "*.properties")
+	})
+
+	t.Run("glossary without account — error", func(t *testing.T) {
+		t.Setenv("SMARTLING_USER_ID", "test-user")
+		t.Setenv("SMARTLING_SECRET", "test-secret")
+		_, err := resolveParams(makeCmd(t, filepath.Join(t.TempDir(), "no-smartling.yml"), ""), "CLI glossary", "", "", "", nil, nil)
+		assert.Error(t, err)
+	})
+}
@@ -19,6 +19,7 @@ var (
 	allowedOutputs = []string{
 		"table",
 		"json",
+		"csv",
 		"simple",
 	}
 	joinedAllowedOutputs = strings.Join(allowedOutputs, ", ")
@@ -13,17 +13,20 @@ import (
 // FileConfig defines file config for glossary
 type FileConfig struct {
 	Glossaries struct {
-		Export ExportConfig `yaml:"export,omitzero"`
-		Create CreateConfig `yaml:"create,omitzero"`
-		Import ImportConfig `yaml:"import,omitzero"`
+		Export  ExportConfig  `yaml:"export,omitzero"`
+		Create  CreateConfig  `yaml:"create,omitzero"`
+		Import  ImportConfig  `yaml:"import,omitzero"`
+		Entries EntriesConfig `yaml:"entries,omitzero"`
 	} `yaml:"glossaries,omitzero"`
 }
 
 // ImportConfig mirrors the flags accepted by `glossaries import`. Each field
 // maps to a flag on the command (and to a Smartling Glossary Import API field).
 type ImportConfig struct {
-	ArchiveMode bool   `yaml:"archive_mode,omitzero"`
-	MediaType   string `yaml:"media_type,omitzero"`
+	ArchiveMode    bool     `yaml:"archive_mode,omitzero"`
+	MediaType      string   `yaml:"media_type,omitzero"`
+	SkipValidation bool     `yaml:"skip_validation,omitzero"`
+	Labels         []string `yaml:"labels,omitzero"`
 }
 
 // ExportConfig mirrors the flags accepted by `glossaries export`.
@@ -47,6 +50,7 @@ type ExportFilterConfig struct {
 	DntLocaleID                string               `yaml:"dnt_locale_id,omitzero"`
 	ReturnFallbackTranslations bool                 `yaml:"return_fallback_translations,omitzero"`
 	LabelsType                 string               `yaml:"labels_type,omitzero"`
+	Labels                     []string             `yaml:"labels,omitzero"`
 	DntTermSet                 bool                 `yaml:"dnt_term_set,omitzero"`
 	Created                    CreatedConfig        `yaml:"created,omitzero"`
 	CreatedBy                  CreatedByConfig      `yaml:"created_by,omitzero"`
@@ -54,6 +58,12 @@ type ExportFilterConfig struct {
 	LastModifiedBy             LastModifiedByConfig `yaml:"last_modified_by,omitzero"`
 }
 
+// EntriesConfig mirrors the flags accepted by `glossaries entries list` and
+// `glossaries entries search`.
+type EntriesConfig struct {
+	Filter ExportFilterConfig `yaml:"filter,omitzero"`
+}
+
 // CreatedConfig filters entries by creation.
 type CreatedConfig struct {
 	Level string `yaml:"level,omitzero"`
@@ -0,0 +1,77 @@
+package glconvert
+
+import (
+	"fmt"
+
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Flag names accepted by `glossaries convert`.
+const (
+	mediaTypeFlag  = "media-type"
+	fileTypeFlag   = "file-type"
+	tbxVersionFlag = "tbx-version"
+)
+
+// NewConvertCmd builds the `glossaries convert` command. Conversion is local
+// and needs neither a config file nor credentials.
+func NewConvertCmd() *cobra.Command {
+	var (
+		mediaType  string
+		fileType   string
+		tbxVersion string
+	)
+
+	convertCmd := &cobra.Command{
+		Use:   "convert <input> <output>",
+		Short: "Convert a glossary file between CSV, XLSX, and TBX",
+		Long: `Convert a glossary file between CSV, XLSX, and TBX locally, without calling
+Smartling.
+
+The output follows the conventions the Glossary Import API expects for the
+"text/csv", spreadsheet, and "text/xml" media types: tables have
+"Entry UID", "Definition", "Part of Speech" and "Labels" columns followed by
+"Term (<localeId>)", "Notes (<localeId>)" and "DNT (<localeId>)" for every
+locale, and TBX files are TBX-Basic v2 (<martif>) or v3 (<tbx>). Entry
+columns the input does not carry, such as "Part of Speech", are left out.
+TBX has no entry labels; they are dropped with a warning.
+
+The input is checked like "glossaries validate" checks it, and nothing is
+written when it has errors. Formats are derived from the file extensions;
+--media-type overrides the input format and --file-type the output format.`,
+		Example: `
+# Convert a vendor CSV to TBX v3
+
+  smartling-cli glossaries convert vendor.csv terms.tbx --tbx-version v3
+
+# Convert a TBX file to a spreadsheet
+
+  smartling-cli glossaries convert terms.tbx terms.xlsx
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(args[0], args[1], mediaType, fileType, tbxVersion)
+			if err != nil {
+				return fmt.Errorf("failed to resolve convert params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, params, outputParams)
+		},
+	}
+
+	f := convertCmd.Flags()
+	f.StringVar(&mediaType, mediaTypeFlag, "", `Override the media type of the input. Must be one of "text/csv", "text/xml", or "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet". By default derived from the file extension.`)
+	f.StringVar(&fileType, fileTypeFlag, "", "Output file type: csv, xlsx, or tbx. By default derived from the file extension.")
+	f.StringVar(&tbxVersion, tbxVersionFlag, "", "TBX version of TBX output: v2 or v3. Defaults to v3.")
+
+	return convertCmd
+}
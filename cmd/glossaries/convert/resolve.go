@@ -0,0 +1,31 @@
+package glconvert
+
+import (
+	"strings"
+
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+func resolveParams(input, output, mediaType, fileType, tbxVersion string) (srv.ConvertParams, error) {
+	rlog.Debugf("resolving convert params")
+
+	params := srv.ConvertParams{
+		InputPath:    input,
+		InputFormat:  glossaryfile.FormatFromPath(input),
+		OutputPath:   output,
+		OutputFormat: glossaryfile.FormatFromPath(output),
+		TBXVersion:   strings.ToLower(tbxVersion),
+	}
+	if mediaType != "" {
+		params.InputFormat = glossaryfile.FormatFromMediaType(mediaType)
+	}
+	if fileType != "" {
+		params.OutputFormat = glossaryfile.FormatFromPath("." + fileType)
+	}
+	if params.OutputFormat == glossaryfile.FormatTBX && params.TBXVersion == "" {
+		params.TBXVersion = glossaryfile.TBXVersion3
+	}
+	return params, nil
+}
@@ -0,0 +1,64 @@
+package glconvert
+
+import (
+	"reflect"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	m.Run()
+}
+
+func Test_resolveParams(t *testing.T) {
+	tests := []struct {
+		name                                        string
+		input, output, mediaType, fileType, version string
+		want                                        srv.ConvertParams
+	}{
+		{
+			name:  "tbx output defaults to v3",
+			input: "vendor.csv", output: "terms.TBX",
+			want: srv.ConvertParams{
+				InputPath: "vendor.csv", InputFormat: glossaryfile.FormatCSV,
+				OutputPath: "terms.TBX", OutputFormat: glossaryfile.FormatTBX, TBXVersion: "v3",
+			},
+		},
+		{
+			name:  "explicit version",
+			input: "vendor.xlsx", output: "terms.tbx", version: "V2",
+			want: srv.ConvertParams{
+				InputPath: "vendor.xlsx", InputFormat: glossaryfile.FormatXLSX,
+				OutputPath: "terms.tbx", OutputFormat: glossaryfile.FormatTBX, TBXVersion: "v2",
+			},
+		},
+		{
+			name:  "--media-type and --file-type override the extensions",
+			input: "vendor.dat", output: "terms.out", mediaType: "text/xml", fileType: "xlsx",
+			want: srv.ConvertParams{
+				InputPath: "vendor.dat", InputFormat: glossaryfile.FormatTBX,
+				OutputPath: "terms.out", OutputFormat: glossaryfile.FormatXLSX,
+			},
+		},
+		{
+			name:  "unknown extensions leave the formats empty",
+			input: "vendor.dat", output: "terms.out",
+			want: srv.ConvertParams{InputPath: "vendor.dat", OutputPath: "terms.out"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveParams(tt.input, tt.output, tt.mediaType, tt.fileType, tt.version)
+			if err != nil {
+				t.Fatalf("resolveParams() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveParams() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package glconvert
+
+import (
+	"context"
+
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+func run(ctx context.Context, params srv.ConvertParams, outputParams output.Params) error {
+	rlog.Debugf("running glossary convert with params: %v", params)
+
+	convertOutput, err := srv.NewService(nil, nil).RunConvert(ctx, params)
+	if convertOutput.JSON != nil {
+		outputFormat := static.GetOutputFormat[srv.ConvertOutput](outputParams.Format)
+		outputFormat.FormatAndRender(convertOutput)
+		return clierror.Rendered(err)
+	}
+	return err
+}
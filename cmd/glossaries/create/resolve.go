@@ -1,8 +1,6 @@
 package glcreate
 
 import (
-	"strings"
-
 	rootcmd "github.com/Smartling/smartling-cli/cmd"
 	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
 	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
@@ -37,7 +35,7 @@ func resolveParams(cmd *cobra.Command, fileConfig glossariescmd.FileConfig, glos
 	localeIDs := resolve.FallbackStringArray(cmd, localeFlag, cfg.LocaleIDs)
 	rawFallbacks := resolve.FallbackStringArray(cmd, fallbackLocaleFlag, cfg.FallbackLocales)
 
-	fallbacks, err := parseFallbackLocales(rawFallbacks)
+	fallbacks, err := glossariescmd.ParseFallbackLocales(rawFallbacks)
 	if err != nil {
 		return srv.CreateParams{}, err
 	}
@@ -51,21 +49,3 @@ func resolveParams(cmd *cobra.Command, fileConfig glossariescmd.FileConfig, glos
 		FallbackLocales:  fallbacks,
 	}, nil
 }
-
-func parseFallbackLocales(raws []string) ([]srv.FallbackLocale, error) {
-	fallbacks := make([]srv.FallbackLocale, 0, len(raws))
-	for _, raw := range raws {
-		parts := strings.SplitN(raw, ":", 2)
-		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-			return nil, clierror.UIError{
-				Operation:   "parse",
-				Description: "fallback locale must use format '<fallbackLocaleId>:<localeId>[,<localeId>...]', got: " + raw,
-			}
-		}
-		fallbacks = append(fallbacks, srv.FallbackLocale{
-			FallbackLocaleID: parts[0],
-			LocaleIDs:        strings.Split(parts[1], ","),
-		})
-	}
-	return fallbacks, nil
-}
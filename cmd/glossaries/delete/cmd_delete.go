@@ -0,0 +1,54 @@
+package gldelete
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+const yesFlag = "yes"
+
+// NewDeleteCmd builds the `glossaries delete` command.
+func NewDeleteCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var yes bool
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <glossaryUID|glossaryName>",
+		Short: "Delete a glossary",
+		Long: `Delete a glossary together with all of its entries. This cannot be undone;
+use "glossaries archive" to keep the entries, or "glossaries export" to
+keep a copy.
+
+The command asks for confirmation; pass --yes to delete without a prompt,
+which is required when stdin is not a terminal.`,
+		Example: `
+# Keep a copy of a glossary, then delete it
+
+  smartling-cli glossaries export "CLI glossary" backup.csv
+  smartling-cli glossaries delete "CLI glossary" --yes
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve delete params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, yes, outputParams)
+		},
+	}
+
+	deleteCmd.Flags().BoolVar(&yes, yesFlag, false, "Delete without asking for confirmation.")
+
+	return deleteCmd
+}
@@ -0,0 +1,83 @@
+package gldiff
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Flag names accepted by `glossaries diff`.
+const (
+	fileFlag         = "file"
+	mediaTypeFlag    = "media-type"
+	sourceLocaleFlag = "source-locale"
+)
+
+// NewDiffCmd builds the `glossaries diff` command.
+func NewDiffCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var (
+		file         string
+		mediaType    string
+		sourceLocale string
+	)
+
+	diffCmd := &cobra.Command{
+		Use:   "diff <glossaryUID|glossaryName> [<otherGlossaryUID|otherGlossaryName>]",
+		Short: "Compare a glossary with another glossary or a local file",
+		Long: `Compare a glossary with another glossary, or with a CSV, XLSX, or TBX file
+given by --file, and report what the other side adds, removes, or changes.
+
+Both glossaries are exported as CSV; entries are aligned by their term in the
+source locale, which defaults to the first locale of the glossary that the
+other side has too. Reported are terms (entries) added or removed, and
+changed definitions, translations, DNT flags, and labels. Definitions, DNT
+flags, and labels are compared only when both sides carry them.
+
+With --output csv the added and changed entries are printed as a glossary
+table with the entry UIDs of the first glossary, ready for
+"glossaries import" into it. Removed entries are not part of that table.`,
+		Example: `
+# Compare two glossaries
+
+  smartling-cli glossaries diff "Web glossary" "Mobile glossary"
+
+# Compare a glossary with a local TBX file, aligning entries by German terms
+
+  smartling-cli glossaries diff "Web glossary" --file ./terms.tbx --source-locale de-DE
+
+# Bring the web glossary in line with the mobile one
+
+  smartling-cli glossaries diff "Web glossary" "Mobile glossary" --output csv > changes.csv
+  smartling-cli glossaries import "Web glossary" ./changes.csv
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var other string
+			if len(args) == 2 {
+				other = args[1]
+			}
+			params, err := resolveParams(cmd, args[0], other, file, mediaType, sourceLocale)
+			if err != nil {
+				return fmt.Errorf("failed to resolve diff params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	diffCmd.Flags().StringVar(&file, fileFlag, "", "Local glossary file to compare the glossary with instead of a second glossary.")
+	diffCmd.Flags().StringVar(&mediaType, mediaTypeFlag, "", `Override the media type of --file. Must be one of "text/csv", "text/xml", or "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet". By default derived from the file extension.`)
+	diffCmd.Flags().StringVar(&sourceLocale, sourceLocaleFlag, "", "Locale whose terms align the entries of both sides.")
+
+	return diffCmd
+}
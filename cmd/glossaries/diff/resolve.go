@@ -0,0 +1,47 @@
+package gldiff
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+func resolveParams(cmd *cobra.Command, glossaryUIDOrName, otherGlossaryUIDOrName, file, mediaType, sourceLocale string) (srv.DiffParams, error) {
+	rlog.Debugf("resolving diff params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.DiffParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.DiffParams{}, err
+	}
+
+	var format glossaryfile.Format
+	if file != "" {
+		format = glossaryfile.FormatFromPath(file)
+		if mediaType != "" {
+			format = glossaryfile.FormatFromMediaType(mediaType)
+		}
+	}
+
+	return srv.DiffParams{
+		AccountUID:             accountUID,
+		GlossaryUIDOrName:      glossaryUIDOrName,
+		OtherGlossaryUIDOrName: otherGlossaryUIDOrName,
+		File:                   file,
+		FileFormat:             format,
+		SourceLocaleID:         sourceLocale,
+	}, nil
+}
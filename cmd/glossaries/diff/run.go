@@ -0,0 +1,53 @@
+package gldiff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+)
+
+func run(ctx context.Context,
+	initializer glossariescmd.SrvInitializer,
+	params srv.DiffParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running glossary diff with params: %v", params)
+	glossarySrv, err := initializer.InitGlossarySrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Glossary service",
+		}
+	}
+
+	diffOutput, err := glossarySrv.RunDiff(ctx, params)
+	if err != nil {
+		if errors.Is(err, glossaryapi.ErrGlossaryNotFound) {
+			names := fmt.Sprintf("%q", params.GlossaryUIDOrName)
+			if params.OtherGlossaryUIDOrName != "" {
+				names += fmt.Sprintf(" or %q", params.OtherGlossaryUIDOrName)
+			}
+			return clierror.UIError{
+				Operation:   "find glossary",
+				Err:         err,
+				Description: "no glossary found for " + names,
+			}
+		}
+		return err
+	}
+
+	outputFormat := static.GetOutputFormat[srv.DiffOutput](outputParams.Format)
+	outputFormat.FormatAndRender(diffOutput)
+
+	return nil
+}
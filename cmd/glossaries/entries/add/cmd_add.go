@@ -0,0 +1,51 @@
+package glentryadd
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	glentries "github.com/Smartling/smartling-cli/cmd/glossaries/entries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewAddCmd builds the `glossaries entries add` command.
+func NewAddCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	addCmd := &cobra.Command{
+		Use:   "add <glossaryUID|glossaryName>",
+		Short: "Add an entry to a glossary",
+		Long: `Add an entry to a glossary. Give at least one term with --term; notes and
+DNT flags apply to the terms given.`,
+		Example: `
+# Add an entry with an English and a German term
+
+  smartling-cli glossaries entries add "CLI glossary" --term en-US=checkout --term de-DE=Kasse \
+    --definition "The page where the order is paid"
+
+# Add a brand name which is never translated
+
+  smartling-cli glossaries entries add "CLI glossary" --term en-US=Smartling --dnt en-US
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve add entry params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	glentries.AddChangeFlags(addCmd.Flags())
+
+	return addCmd
+}
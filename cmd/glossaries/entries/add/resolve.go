@@ -0,0 +1,41 @@
+package glentryadd
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	glentries "github.com/Smartling/smartling-cli/cmd/glossaries/entries"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+func resolveParams(cmd *cobra.Command, glossaryUIDOrName string) (srv.AddEntryParams, error) {
+	rlog.Debugf("resolving add entry params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.AddEntryParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.AddEntryParams{}, err
+	}
+
+	changes, err := glentries.ResolveChanges(cmd)
+	if err != nil {
+		return srv.AddEntryParams{}, err
+	}
+
+	return srv.AddEntryParams{
+		AccountUID:        accountUID,
+		GlossaryUIDOrName: glossaryUIDOrName,
+		Changes:           changes,
+	}, nil
+}
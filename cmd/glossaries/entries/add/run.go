@@ -0,0 +1,39 @@
+package glentryadd
+
+import (
+	"context"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	glentries "github.com/Smartling/smartling-cli/cmd/glossaries/entries"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+func run(ctx context.Context,
+	initializer glossariescmd.SrvInitializer,
+	params srv.AddEntryParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running glossary entry add with params: %v", params)
+	glossarySrv, err := initializer.InitGlossarySrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Glossary service",
+		}
+	}
+
+	entryOutput, err := glossarySrv.RunAddEntry(ctx, params)
+	if err != nil {
+		return glentries.NotFoundError(err, params.GlossaryUIDOrName)
+	}
+
+	outputFormat := static.GetOutputFormat[srv.EntryOutput](outputParams.Format)
+	outputFormat.FormatAndRender(entryOutput)
+
+	return nil
+}
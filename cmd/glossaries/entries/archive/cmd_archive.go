@@ -0,0 +1,46 @@
+package glentryarchive
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewArchiveCmd builds the `glossaries entries archive` command.
+func NewArchiveCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	archiveCmd := &cobra.Command{
+		Use:   "archive <glossaryUID|glossaryName> <entryUID>...",
+		Short: "Archive glossary entries",
+		Long: `Archive one or more glossary entries. Archived entries are no longer used
+in translation and are left out of "entries list" unless
+--filter-entry-state ARCHIVED is given.
+
+Every entry UID is checked first; when one is unknown nothing is archived.`,
+		Example: `
+# Archive two entries
+
+  smartling-cli glossaries entries archive "CLI glossary" <entryUID> <otherEntryUID>
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0], args[1:])
+			if err != nil {
+				return fmt.Errorf("failed to resolve archive entries params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	return archiveCmd
+}
@@ -0,0 +1,35 @@
+package glentryarchive
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+func resolveParams(cmd *cobra.Command, glossaryUIDOrName string, entryUIDs []string) (srv.ArchiveEntriesParams, error) {
+	rlog.Debugf("resolving archive entries params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.ArchiveEntriesParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.ArchiveEntriesParams{}, err
+	}
+
+	return srv.ArchiveEntriesParams{
+		AccountUID:        accountUID,
+		GlossaryUIDOrName: glossaryUIDOrName,
+		EntryUIDs:         entryUIDs,
+	}, nil
+}
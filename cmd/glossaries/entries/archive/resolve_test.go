@@ -0,0 +1,72 @@
+package glentryarchive
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"github.com/spf13/cobra"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	m.Run()
+}
+
+func makeCmd(t *testing.T, configPath, accountFlag string) *cobra.Command {
+	t.Helper()
+	root := rootcmd.NewRootCmd()
+	child := NewArchiveCmd(nil)
+	root.AddCommand(child)
+	_ = root.PersistentFlags().Set("config", configPath)
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+	if accountFlag != "" {
+		_ = root.PersistentFlags().Set("account", accountFlag)
+	}
+	return child
+}
+
+func Test_resolveParams(t *testing.T) {
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+
+	noConfigPath := filepath.Join(t.TempDir(), "no-smartling.yml")
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *cobra.Command
+		want    srv.ArchiveEntriesParams
+		wantErr bool
+	}{
+		{
+			name:  "every entry UID",
+			setup: func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "flag-account-uid") },
+			want: srv.ArchiveEntriesParams{
+				AccountUID:        uid.AccountUID("flag-account-uid"),
+				GlossaryUIDOrName: "Web",
+				EntryUIDs:         []string{"e1", "e2"},
+			},
+		},
+		{
+			name:    "missing account — error",
+			setup:   func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "") },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveParams(tt.setup(t), "Web", []string{"e1", "e2"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveParams() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveParams() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
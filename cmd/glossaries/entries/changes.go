@@ -0,0 +1,108 @@
+package glentries
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Entry field flags shared by `entries add` and `entries update`.
+const (
+	TermFlag         = "term"
+	NotesFlag        = "notes"
+	DNTFlag          = "dnt"
+	DefinitionFlag   = "definition"
+	PartOfSpeechFlag = "part-of-speech"
+	LabelFlag        = "label"
+)
+
+// AddChangeFlags registers the entry field flags. Their values are read back
+// with ResolveChanges.
+func AddChangeFlags(f *pflag.FlagSet) {
+	f.StringArray(TermFlag, nil, `Term of a locale as "<localeId>=<term>" (repeatable). An empty term removes the locale's translation.`)
+	f.StringArray(NotesFlag, nil, `Notes of a locale's term as "<localeId>=<notes>" (repeatable).`)
+	f.StringArray(DNTFlag, nil, `Mark a locale's term "do not translate" as "<localeId>[=true|false]" (repeatable).`)
+	f.String(DefinitionFlag, "", "Definition of the entry.")
+	f.String(PartOfSpeechFlag, "", "Part of speech of the entry, e.g. NOUN or VERB.")
+	f.StringArray(LabelFlag, nil, `Label UID of the entry (repeatable). Replaces the entry's labels; pass --label "" to clear them.`)
+}
+
+// ResolveChanges reads the entry field flags. Flags which were not set are
+// left out of the changes.
+func ResolveChanges(cmd *cobra.Command) (srv.EntryChanges, error) {
+	var changes srv.EntryChanges
+	flags := cmd.Flags()
+
+	terms, err := localeValues(flags, TermFlag)
+	if err != nil {
+		return srv.EntryChanges{}, err
+	}
+	changes.Terms = terms
+	if changes.Notes, err = localeValues(flags, NotesFlag); err != nil {
+		return srv.EntryChanges{}, err
+	}
+	dnt, err := flags.GetStringArray(DNTFlag)
+	if err != nil {
+		return srv.EntryChanges{}, err
+	}
+	for _, value := range dnt {
+		localeID, raw, hasValue := strings.Cut(value, "=")
+		flag := true
+		if hasValue {
+			if flag, err = strconv.ParseBool(raw); err != nil {
+				return srv.EntryChanges{}, fmt.Errorf("invalid --%s %q: want <localeId>[=true|false]", DNTFlag, value)
+			}
+		}
+		if localeID == "" {
+			return srv.EntryChanges{}, fmt.Errorf("invalid --%s %q: want <localeId>[=true|false]", DNTFlag, value)
+		}
+		if changes.DNT == nil {
+			changes.DNT = map[string]bool{}
+		}
+		changes.DNT[localeID] = flag
+	}
+
+	if flags.Changed(DefinitionFlag) {
+		definition, _ := flags.GetString(DefinitionFlag)
+		changes.Definition = &definition
+	}
+	if flags.Changed(PartOfSpeechFlag) {
+		partOfSpeech, _ := flags.GetString(PartOfSpeechFlag)
+		changes.PartOfSpeech = &partOfSpeech
+	}
+	if flags.Changed(LabelFlag) {
+		labels, _ := flags.GetStringArray(LabelFlag)
+		changes.LabelUIDs = []string{}
+		for _, label := range labels {
+			if label != "" {
+				changes.LabelUIDs = append(changes.LabelUIDs, label)
+			}
+		}
+	}
+	return changes, nil
+}
+
+// localeValues parses "<localeId>=<value>" flag values.
+func localeValues(flags *pflag.FlagSet, name string) (map[string]string, error) {
+	values, err := flags.GetStringArray(name)
+	if err != nil {
+		return nil, err
+	}
+	var byLocale map[string]string
+	for _, value := range values {
+		localeID, text, ok := strings.Cut(value, "=")
+		if !ok || localeID == "" {
+			return nil, fmt.Errorf("invalid --%s %q: want <localeId>=<value>", name, value)
+		}
+		if byLocale == nil {
+			byLocale = map[string]string{}
+		}
+		byLocale[localeID] = text
+	}
+	return byLocale, nil
+}
@@ -0,0 +1,33 @@
+package glentries
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewEntriesCmd builds the `glossaries entries` command.
+func NewEntriesCmd() *cobra.Command {
+	entriesCmd := &cobra.Command{
+		Use:   "entries",
+		Short: "Manage the entries of a glossary",
+		Long: `List, search, view, add, update, or archive single glossary entries without
+exporting and re-importing the whole glossary.
+
+Glossaries are identified by their UID or name; entries by their entry UID,
+as shown by "glossaries entries list".`,
+		Example: `
+# List the entries of a glossary
+
+  smartling-cli glossaries entries list "CLI glossary"
+
+# Search entries by term or definition
+
+  smartling-cli glossaries entries search "CLI glossary" checkout
+
+# Fix the German term of an entry
+
+  smartling-cli glossaries entries update "CLI glossary" <entryUID> --term de-DE=Kasse
+`,
+	}
+
+	return entriesCmd
+}
@@ -0,0 +1,32 @@
+package glentries
+
+import (
+	"errors"
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+)
+
+// NotFoundError turns a missing glossary, entry or label into a UIError;
+// other errors are returned as they are.
+func NotFoundError(err error, glossaryUIDOrName string) error {
+	switch {
+	case errors.Is(err, glossaryapi.ErrGlossaryNotFound):
+		return clierror.UIError{
+			Operation:   "find glossary",
+			Err:         err,
+			Description: fmt.Sprintf("no glossary found for %q", glossaryUIDOrName),
+		}
+	case errors.Is(err, srv.ErrEntryNotFound):
+		return clierror.UIError{
+			Operation:   "find entry",
+			Err:         err,
+			Description: fmt.Sprintf("no such entry in glossary %q; list the entry UIDs with \"glossaries entries list\"", glossaryUIDOrName),
+		}
+	}
+	return glossariescmd.LabelNotFoundError(err)
+}
@@ -0,0 +1,47 @@
+package glentryget
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewGetCmd builds the `glossaries entries get` command.
+func NewGetCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	getCmd := &cobra.Command{
+		Use:   "get <glossaryUID|glossaryName> <entryUID>",
+		Short: "Show a glossary entry",
+		Long: `Show a glossary entry: its state, definition, part of speech, labels, and
+the term, notes, and DNT flag of every locale.`,
+		Example: `
+# Show an entry
+
+  smartling-cli glossaries entries get "CLI glossary" <entryUID>
+
+# Show an entry as JSON
+
+  smartling-cli glossaries entries get "CLI glossary" <entryUID> --output json
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to resolve get entry params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	return getCmd
+}
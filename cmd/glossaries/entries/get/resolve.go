@@ -0,0 +1,35 @@
+package glentryget
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+func resolveParams(cmd *cobra.Command, glossaryUIDOrName, entryUID string) (srv.EntryParams, error) {
+	rlog.Debugf("resolving get entry params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.EntryParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.EntryParams{}, err
+	}
+
+	return srv.EntryParams{
+		AccountUID:        accountUID,
+		GlossaryUIDOrName: glossaryUIDOrName,
+		EntryUID:          entryUID,
+	}, nil
+}
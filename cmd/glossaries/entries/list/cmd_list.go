@@ -0,0 +1,66 @@
+package glentrylist
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+const limitFlag = "limit"
+
+// NewListCmd builds the `glossaries entries list` command.
+func NewListCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var limit int
+
+	listCmd := &cobra.Command{
+		Use:   "list <glossaryUID|glossaryName>",
+		Short: "List the entries of a glossary",
+		Long: `List the entries of a glossary. Archived entries are left out unless
+--filter-entry-state ARCHIVED is given.
+
+The --filter-* flags are the ones of "glossaries export" and narrow the
+listed entries the same way. Every page of results is read unless --limit
+caps the number of entries.`,
+		Example: `
+# List the entries of a glossary
+
+  smartling-cli glossaries entries list "CLI glossary"
+
+# List the entries without a German translation
+
+  smartling-cli glossaries entries list "CLI glossary" --filter-missing-translation-locale de-DE
+
+# List the first 20 archived entries as a table
+
+  smartling-cli glossaries entries list "CLI glossary" --filter-entry-state ARCHIVED --limit 20 --output table
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			fileConfig, err := glossariescmd.BindFileConfig(cmd)
+			if err != nil {
+				return err
+			}
+			params, err := resolveParams(cmd, fileConfig, args[0], limit)
+			if err != nil {
+				return fmt.Errorf("failed to resolve list entries params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	listCmd.Flags().IntVar(&limit, limitFlag, 0, "Maximum number of entries to list; 0 lists every entry.")
+	glossariescmd.AddFilterFlags(listCmd.Flags())
+
+	return listCmd
+}
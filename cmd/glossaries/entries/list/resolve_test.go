@@ -0,0 +1,134 @@
+package glentrylist
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"github.com/spf13/cobra"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	m.Run()
+}
+
+func makeCmd(t *testing.T, configPath, accountFlag string) *cobra.Command {
+	t.Helper()
+	root := rootcmd.NewRootCmd()
+	listCmd := NewListCmd(nil)
+	root.AddCommand(listCmd)
+	_ = root.PersistentFlags().Set("config", configPath)
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+	if accountFlag != "" {
+		_ = root.PersistentFlags().Set("account", accountFlag)
+	}
+	return listCmd
+}
+
+func Test_resolveParams(t *testing.T) {
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+
+	noConfigPath := filepath.Join(t.TempDir(), "no-smartling.yml")
+	var fileConfig glossariescmd.FileConfig
+	fileConfig.Glossaries.Entries.Filter = glossariescmd.ExportFilterConfig{
+		Query:      "config query",
+		EntryState: "ARCHIVED",
+	}
+
+	tests := []struct {
+		name       string
+		setup      func(t *testing.T) *cobra.Command
+		fileConfig glossariescmd.FileConfig
+		limit      int
+		want       srv.ListEntriesParams
+		wantErr    bool
+	}{
+		{
+			name:  "no filter",
+			setup: func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "flag-account-uid") },
+			limit: 10,
+			want: srv.ListEntriesParams{
+				AccountUID:        uid.AccountUID("flag-account-uid"),
+				GlossaryUIDOrName: "Web",
+				Limit:             10,
+			},
+		},
+		{
+			name: "filter from flags",
+			setup: func(t *testing.T) *cobra.Command {
+				cmd := makeCmd(t, noConfigPath, "flag-account-uid")
+				_ = cmd.Flags().Set(glossariescmd.FilterQueryFlag, "checkout")
+				_ = cmd.Flags().Set(glossariescmd.FilterMissingTranslationLocaleFlag, "de-DE")
+				_ = cmd.Flags().Set(glossariescmd.FilterCreatedByUserIDFlag, "user-1")
+				return cmd
+			},
+			want: srv.ListEntriesParams{
+				AccountUID:        uid.AccountUID("flag-account-uid"),
+				GlossaryUIDOrName: "Web",
+				Filter: srv.ExportFilter{
+					Query:                      "checkout",
+					MissingTranslationLocaleID: "de-DE",
+					CreatedBy:                  srv.CreatedBy{UserIDs: []string{"user-1"}},
+				},
+			},
+		},
+		{
+			name:       "filter from fileConfig",
+			setup:      func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "flag-account-uid") },
+			fileConfig: fileConfig,
+			want: srv.ListEntriesParams{
+				AccountUID:        uid.AccountUID("flag-account-uid"),
+				GlossaryUIDOrName: "Web",
+				Filter:            srv.ExportFilter{Query: "config query", EntryState: "ARCHIVED"},
+			},
+		},
+		{
+			name: "invalid date — error",
+			setup: func(t *testing.T) *cobra.Command {
+				cmd := makeCmd(t, noConfigPath, "flag-account-uid")
+				_ = cmd.Flags().Set(glossariescmd.FilterCreatedDateFlag, "yesterday")
+				return cmd
+			},
+			wantErr: true,
+		},
+		{
+			name: "account from config file",
+			setup: func(t *testing.T) *cobra.Command {
+				cfgPath := filepath.Join(t.TempDir(), "smartling.yml")
+				if err := os.WriteFile(cfgPath, []byte("account_id: config-account-uid\n"), 0o600); err != nil {
+					t.Fatalf("write config: %v", err)
+				}
+				return makeCmd(t, cfgPath, "")
+			},
+			want: srv.ListEntriesParams{
+				AccountUID:        uid.AccountUID("config-account-uid"),
+				GlossaryUIDOrName: "Web",
+			},
+		},
+		{
+			name:    "missing account — error",
+			setup:   func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "") },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveParams(tt.setup(t), tt.fileConfig, "Web", tt.limit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveParams() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveParams() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package glentrysearch
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+const limitFlag = "limit"
+
+// NewSearchCmd builds the `glossaries entries search` command.
+func NewSearchCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var limit int
+
+	searchCmd := &cobra.Command{
+		Use:   "search <glossaryUID|glossaryName> <query>",
+		Short: "Search the entries of a glossary",
+		Long: `Search the entries of a glossary for a free-text query, matched against
+terms and definitions. It is "entries list" with the query given as an
+argument instead of --filter-query; the other --filter-* flags narrow the
+results further.`,
+		Example: `
+# Find the entries about checkout
+
+  smartling-cli glossaries entries search "CLI glossary" checkout
+
+# Find checkout entries which are not translated to French yet
+
+  smartling-cli glossaries entries search "CLI glossary" checkout --filter-missing-translation-locale fr-FR
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			fileConfig, err := glossariescmd.BindFileConfig(cmd)
+			if err != nil {
+				return err
+			}
+			params, err := resolveParams(cmd, fileConfig, args[0], args[1], limit)
+			if err != nil {
+				return fmt.Errorf("failed to resolve search entries params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	searchCmd.Flags().IntVar(&limit, limitFlag, 0, "Maximum number of entries to list; 0 lists every match.")
+	glossariescmd.AddFilterFlags(searchCmd.Flags())
+	searchCmd.Flags().Lookup(glossariescmd.FilterQueryFlag).Hidden = true
+
+	return searchCmd
+}
@@ -0,0 +1,44 @@
+package glentrysearch
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+// resolveParams builds the list params; the query overrides --filter-query.
+func resolveParams(cmd *cobra.Command, fileConfig glossariescmd.FileConfig, glossaryUIDOrName, query string, limit int) (srv.ListEntriesParams, error) {
+	rlog.Debugf("resolving search entries params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.ListEntriesParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.ListEntriesParams{}, err
+	}
+
+	filter, err := glossariescmd.ResolveFilter(cmd, fileConfig.Glossaries.Entries.Filter)
+	if err != nil {
+		return srv.ListEntriesParams{}, err
+	}
+	filter.Query = query
+
+	return srv.ListEntriesParams{
+		AccountUID:        accountUID,
+		GlossaryUIDOrName: glossaryUIDOrName,
+		Filter:            filter,
+		Limit:             limit,
+	}, nil
+}
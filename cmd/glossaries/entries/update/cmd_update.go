@@ -0,0 +1,55 @@
+package glentryupdate
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	glentries "github.com/Smartling/smartling-cli/cmd/glossaries/entries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewUpdateCmd builds the `glossaries entries update` command.
+func NewUpdateCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	updateCmd := &cobra.Command{
+		Use:   "update <glossaryUID|glossaryName> <entryUID>",
+		Short: "Update a glossary entry",
+		Long: `Update a glossary entry. Only the fields given by flags change; every other
+field, and the terms of locales not named by --term, keep their values.
+An empty --term removes the translation of its locale.`,
+		Example: `
+# Fix the German term of an entry
+
+  smartling-cli glossaries entries update "CLI glossary" <entryUID> --term de-DE=Kasse
+
+# Clear the definition and drop the French translation
+
+  smartling-cli glossaries entries update "CLI glossary" <entryUID> --definition "" --term fr-FR=
+
+# Stop marking the English term "do not translate"
+
+  smartling-cli glossaries entries update "CLI glossary" <entryUID> --dnt en-US=false
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to resolve update entry params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	glentries.AddChangeFlags(updateCmd.Flags())
+
+	return updateCmd
+}
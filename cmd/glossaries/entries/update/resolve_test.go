@@ -0,0 +1,118 @@
+package glentryupdate
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	glentries "github.com/Smartling/smartling-cli/cmd/glossaries/entries"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"github.com/spf13/cobra"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	m.Run()
+}
+
+func makeCmd(t *testing.T, configPath, accountFlag string) *cobra.Command {
+	t.Helper()
+	root := rootcmd.NewRootCmd()
+	updateCmd := NewUpdateCmd(nil)
+	root.AddCommand(updateCmd)
+	_ = root.PersistentFlags().Set("config", configPath)
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+	if accountFlag != "" {
+		_ = root.PersistentFlags().Set("account", accountFlag)
+	}
+	return updateCmd
+}
+
+func Test_resolveParams(t *testing.T) {
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+
+	noConfigPath := filepath.Join(t.TempDir(), "no-smartling.yml")
+	entry := srv.EntryParams{
+		AccountUID:        uid.AccountUID("flag-account-uid"),
+		GlossaryUIDOrName: "Web",
+		EntryUID:          "entry-uid",
+	}
+	empty := ""
+
+	tests := []struct {
+		name    string
+		flags   [][2]string
+		account string
+		want    srv.UpdateEntryParams
+		wantErr bool
+	}{
+		{
+			name:    "no flags — no changes",
+			account: "flag-account-uid",
+			want:    srv.UpdateEntryParams{EntryParams: entry},
+		},
+		{
+			name:    "terms, notes and DNT by locale",
+			account: "flag-account-uid",
+			flags: [][2]string{
+				{glentries.TermFlag, "de-DE=Kasse"},
+				{glentries.TermFlag, "fr-FR="},
+				{glentries.NotesFlag, "de-DE=a=b"},
+				{glentries.DNTFlag, "de-DE"},
+				{glentries.DNTFlag, "en-US=false"},
+			},
+			want: srv.UpdateEntryParams{EntryParams: entry, Changes: srv.EntryChanges{
+				Terms: map[string]string{"de-DE": "Kasse", "fr-FR": ""},
+				Notes: map[string]string{"de-DE": "a=b"},
+				DNT:   map[string]bool{"de-DE": true, "en-US": false},
+			}},
+		},
+		{
+			name:    "empty definition and label clear the fields",
+			account: "flag-account-uid",
+			flags:   [][2]string{{glentries.DefinitionFlag, ""}, {glentries.LabelFlag, ""}},
+			want: srv.UpdateEntryParams{EntryParams: entry, Changes: srv.EntryChanges{
+				Definition: &empty,
+				LabelUIDs:  []string{},
+			}},
+		},
+		{
+			name:    "term without locale — error",
+			account: "flag-account-uid",
+			flags:   [][2]string{{glentries.TermFlag, "Kasse"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid DNT value — error",
+			account: "flag-account-uid",
+			flags:   [][2]string{{glentries.DNTFlag, "de-DE=maybe"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing account — error",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := makeCmd(t, noConfigPath, tt.account)
+			for _, flag := range tt.flags {
+				if err := cmd.Flags().Set(flag[0], flag[1]); err != nil {
+					t.Fatalf("set --%s: %v", flag[0], err)
+				}
+			}
+			got, err := resolveParams(cmd, "Web", "entry-uid")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveParams() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveParams() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,31 +17,6 @@ const (
 	focusLocaleFlag = "focus-locale"
 	localeFlag      = "locale"
 	skipEntriesFlag = "skip-entries"
-
-	filterQueryFlag                      = "filter-query"
-	filterLocaleFlag                     = "filter-locale"
-	filterEntryUIDFlag                   = "filter-entry-uid"
-	filterEntryStateFlag                 = "filter-entry-state"
-	filterMissingTranslationLocaleFlag   = "filter-missing-translation-locale"
-	filterPresentTranslationLocaleFlag   = "filter-present-translation-locale"
-	filterDntLocaleFlag                  = "filter-dnt-locale"
-	filterReturnFallbackTranslationsFlag = "filter-return-fallback-translations"
-	filterLabelsTypeFlag                 = "filter-labels-type"
-	filterDntTermSetFlag                 = "filter-dnt-term-set"
-
-	filterCreatedLevelFlag = "filter-created-level"
-	filterCreatedTypeFlag  = "filter-created-type"
-	createdDateFlag        = "filter-created-date"
-
-	filterLastModifiedLevelFlag = "filter-last-modified-level"
-	filterLastModifiedTypeFlag  = "filter-last-modified-type"
-	lastModifiedDateFlag        = "filter-last-modified-date"
-
-	filterCreatedByLevelFlag  = "filter-created-by-level"
-	filterCreatedByUserIDFlag = "filter-created-by-user-id"
-
-	filterLastModifiedByLevelFlag  = "filter-last-modified-by-level"
-	filterLastModifiedByUserIDFlag = "filter-last-modified-by-user-id"
 )
 
 // NewExportCmd builds the `glossaries export` command.
@@ -53,28 +28,6 @@ func NewExportCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
 		focusLocale string
 		locales     []string
 		skipEntries bool
-
-		fQuery                      string
-		fLocaleIDs                  []string
-		fEntryUIDs                  []string
-		fEntryState                 string
-		fMissingTranslationLocaleID string
-		fPresentTranslationLocaleID string
-		fDntLocaleID                string
-		fReturnFallback             bool
-		fLabelsType                 string
-		fDntTermSet                 bool
-
-		fCreatedLevel   string
-		fCreatedType    string
-		fCreatedDateStr string
-		fLastModLevel   string
-		fLastModType    string
-		fLastModDateStr string
-		fCreatedByLevel string
-		fCreatedByUsers []string
-		fLastModByLevel string
-		fLastModByUsers []string
 	)
 
 	exportCmd := &cobra.Command{
@@ -152,29 +105,7 @@ current directory.`,
 	f.StringArrayVar(&locales, localeFlag, nil, "Target locale ID to include in the export (repeatable).")
 	f.BoolVar(&skipEntries, skipEntriesFlag, false, "Skip glossary entries in the export.")
 
-	f.StringVar(&fQuery, filterQueryFlag, "", "Filter: free-text query to match entries.")
-	f.StringArrayVar(&fLocaleIDs, filterLocaleFlag, nil, "Filter: locale ID to match (repeatable → filter.localeIds).")
-	f.StringArrayVar(&fEntryUIDs, filterEntryUIDFlag, nil, "Filter: entry UID to match (repeatable → filter.entryUids).")
-	f.StringVar(&fEntryState, filterEntryStateFlag, "", "Filter: entry state to match.")
-	f.StringVar(&fMissingTranslationLocaleID, filterMissingTranslationLocaleFlag, "", "Filter: locale ID that must be missing a translation.")
-	f.StringVar(&fPresentTranslationLocaleID, filterPresentTranslationLocaleFlag, "", "Filter: locale ID that must have a translation.")
-	f.StringVar(&fDntLocaleID, filterDntLocaleFlag, "", "Filter: DNT (do-not-translate) locale ID.")
-	f.BoolVar(&fReturnFallback, filterReturnFallbackTranslationsFlag, false, "Filter: include fallback translations in the result.")
-	f.StringVar(&fLabelsType, filterLabelsTypeFlag, "", "Filter: labels.type to match.")
-	f.BoolVar(&fDntTermSet, filterDntTermSetFlag, false, "Filter: restrict to entries whose DNT term-set flag is set.")
-
-	f.StringVar(&fCreatedLevel, filterCreatedLevelFlag, "", "Filter: created.level.")
-	f.StringVar(&fCreatedType, filterCreatedTypeFlag, "", "Filter: created.type (e.g. AFTER, BEFORE).")
-	f.StringVar(&fCreatedDateStr, createdDateFlag, "", "Filter: created.date in RFC3339 (e.g. 2026-01-02T15:04:05Z).")
-
-	f.StringVar(&fLastModLevel, filterLastModifiedLevelFlag, "", "Filter: lastModified.level.")
-	f.StringVar(&fLastModType, filterLastModifiedTypeFlag, "", "Filter: lastModified.type.")
-	f.StringVar(&fLastModDateStr, lastModifiedDateFlag, "", "Filter: lastModified.date in RFC3339.")
-
-	f.StringVar(&fCreatedByLevel, filterCreatedByLevelFlag, "", "Filter: createdBy.level.")
-	f.StringArrayVar(&fCreatedByUsers, filterCreatedByUserIDFlag, nil, "Filter: createdBy.userIds entry (repeatable).")
-	f.StringVar(&fLastModByLevel, filterLastModifiedByLevelFlag, "", "Filter: lastModifiedBy.level.")
-	f.StringArrayVar(&fLastModByUsers, filterLastModifiedByUserIDFlag, nil, "Filter: lastModifiedBy.userIds entry (repeatable).")
+	glossariescmd.AddFilterFlags(f)
 
 	return exportCmd
 }
@@ -255,12 +255,14 @@ func Test_resolveParams(t *testing.T) {
 			name: "filter fields from flags",
 			setup: func(t *testing.T) *cobra.Command {
 				cmd := defaultSetup(t)
-				_ = cmd.Flags().Set(filterQueryFlag, "checkout")
-				_ = cmd.Flags().Set(filterLocaleFlag, "en-US")
-				_ = cmd.Flags().Set(filterEntryStateFlag, "ACTIVE")
-				_ = cmd.Flags().Set(filterReturnFallbackTranslationsFlag, "true")
-				_ = cmd.Flags().Set(filterCreatedLevelFlag, "ACCOUNT")
-				_ = cmd.Flags().Set(filterCreatedByUserIDFlag, "user-1")
+				_ = cmd.Flags().Set(glossariescmd.FilterQueryFlag, "checkout")
+				_ = cmd.Flags().Set(glossariescmd.FilterLocaleFlag, "en-US")
+				_ = cmd.Flags().Set(glossariescmd.FilterEntryStateFlag, "ACTIVE")
+				_ = cmd.Flags().Set(glossariescmd.FilterReturnFallbackTranslationsFlag, "true")
+				_ = cmd.Flags().Set(glossariescmd.FilterCreatedLevelFlag, "ACCOUNT")
+				_ = cmd.Flags().Set(glossariescmd.FilterCreatedByUserIDFlag, "user-1")
+				_ = cmd.Flags().Set(glossariescmd.FilterLabelFlag, "mobile")
+				_ = cmd.Flags().Set(glossariescmd.FilterLabelFlag, "web")
 				return cmd
 			},
 			glossaryUIDOrName: testGlossary,
@@ -273,6 +275,7 @@ func Test_resolveParams(t *testing.T) {
 				p.Filter.ReturnFallbackTranslations = true
 				p.Filter.Created.Level = "ACCOUNT"
 				p.Filter.CreatedBy.UserIDs = []string{"user-1"}
+				p.Filter.Labels = []string{"mobile", "web"}
 				return p
 			}(),
 		},
@@ -309,7 +312,7 @@ func Test_resolveParams(t *testing.T) {
 			name: "filter-created-date parsed from RFC3339 flag",
 			setup: func(t *testing.T) *cobra.Command {
 				cmd := defaultSetup(t)
-				_ = cmd.Flags().Set(createdDateFlag, "2026-01-02T15:04:05Z")
+				_ = cmd.Flags().Set(glossariescmd.FilterCreatedDateFlag, "2026-01-02T15:04:05Z")
 				return cmd
 			},
 			glossaryUIDOrName: testGlossary,
@@ -324,7 +327,7 @@ func Test_resolveParams(t *testing.T) {
 			name: "filter-last-modified-date parsed from RFC3339 flag",
 			setup: func(t *testing.T) *cobra.Command {
 				cmd := defaultSetup(t)
-				_ = cmd.Flags().Set(lastModifiedDateFlag, "2026-06-15T00:00:00Z")
+				_ = cmd.Flags().Set(glossariescmd.FilterLastModifiedDateFlag, "2026-06-15T00:00:00Z")
 				return cmd
 			},
 			glossaryUIDOrName: testGlossary,
@@ -340,7 +343,7 @@ func Test_resolveParams(t *testing.T) {
 			name: "invalid RFC3339 date — error",
 			setup: func(t *testing.T) *cobra.Command {
 				cmd := defaultSetup(t)
-				_ = cmd.Flags().Set(createdDateFlag, "not-a-date")
+				_ = cmd.Flags().Set(glossariescmd.FilterCreatedDateFlag, "not-a-date")
 				return cmd
 			},
 			glossaryUIDOrName: testGlossary,
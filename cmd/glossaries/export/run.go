@@ -39,7 +39,7 @@ func run(ctx context.Context,
 				Description: fmt.Sprintf("no glossary found for %q", params.GlossaryUIDOrName),
 			}
 		}
-		return err
+		return glossariescmd.LabelNotFoundError(err)
 	}
 
 	outputFormat := static.GetOutputFormat[srv.ExportOutput](outputParams.Format)
@@ -0,0 +1,29 @@
+package glossaries
+
+import (
+	"strings"
+
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+)
+
+// ParseFallbackLocales parses --fallback-locale values of the form
+// '<fallbackLocaleId>:<localeId>[,<localeId>...]'. It always returns a
+// non-nil slice.
+func ParseFallbackLocales(raws []string) ([]srv.FallbackLocale, error) {
+	fallbacks := make([]srv.FallbackLocale, 0, len(raws))
+	for _, raw := range raws {
+		parts := strings.SplitN(raw, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, clierror.UIError{
+				Operation:   "parse",
+				Description: "fallback locale must use format '<fallbackLocaleId>:<localeId>[,<localeId>...]', got: " + raw,
+			}
+		}
+		fallbacks = append(fallbacks, srv.FallbackLocale{
+			FallbackLocaleID: parts[0],
+			LocaleIDs:        strings.Split(parts[1], ","),
+		})
+	}
+	return fallbacks, nil
+}
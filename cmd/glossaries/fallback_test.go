@@ -0,0 +1,77 @@
+package glossaries
+
+import (
+	"reflect"
+	"testing"
+
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+)
+
+func TestParseFallbackLocales(t *testing.T) {
+	tests := []struct {
+		name    string
+		raws    []string
+		want    []srv.FallbackLocale
+		wantErr bool
+	}{
+		{
+			name: "nil input returns empty slice",
+			raws: nil,
+			want: []srv.FallbackLocale{},
+		},
+		{
+			name: "single entry — one locale",
+			raws: []string{"es:es-ES"},
+			want: []srv.FallbackLocale{
+				{FallbackLocaleID: "es", LocaleIDs: []string{"es-ES"}},
+			},
+		},
+		{
+			name: "single entry — multiple locales",
+			raws: []string{"es:es-MX,es-AR,es-ES"},
+			want: []srv.FallbackLocale{
+				{FallbackLocaleID: "es", LocaleIDs: []string{"es-MX", "es-AR", "es-ES"}},
+			},
+		},
+		{
+			name: "multiple entries",
+			raws: []string{"es:es-ES", "pt:pt-BR,pt-PT"},
+			want: []srv.FallbackLocale{
+				{FallbackLocaleID: "es", LocaleIDs: []string{"es-ES"}},
+				{FallbackLocaleID: "pt", LocaleIDs: []string{"pt-BR", "pt-PT"}},
+			},
+		},
+		{
+			name:    "no colon — error",
+			raws:    []string{"no-colon"},
+			wantErr: true,
+		},
+		{
+			name:    "empty fallback locale ID — error",
+			raws:    []string{":es-ES"},
+			wantErr: true,
+		},
+		{
+			name:    "empty locale IDs after colon — error",
+			raws:    []string{"es:"},
+			wantErr: true,
+		},
+		{
+			name:    "empty string — error",
+			raws:    []string{""},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFallbackLocales(tt.raws)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseFallbackLocales() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFallbackLocales() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
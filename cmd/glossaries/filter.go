@@ -0,0 +1,148 @@
+package glossaries
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Entry filter flags shared by `glossaries export` and `glossaries entries`.
+const (
+	FilterQueryFlag                      = "filter-query"
+	FilterLocaleFlag                     = "filter-locale"
+	FilterEntryUIDFlag                   = "filter-entry-uid"
+	FilterEntryStateFlag                 = "filter-entry-state"
+	FilterMissingTranslationLocaleFlag   = "filter-missing-translation-locale"
+	FilterPresentTranslationLocaleFlag   = "filter-present-translation-locale"
+	FilterDntLocaleFlag                  = "filter-dnt-locale"
+	FilterReturnFallbackTranslationsFlag = "filter-return-fallback-translations"
+	FilterLabelsTypeFlag                 = "filter-labels-type"
+	FilterLabelFlag                      = "filter-label"
+	FilterDntTermSetFlag                 = "filter-dnt-term-set"
+
+	FilterCreatedLevelFlag = "filter-created-level"
+	FilterCreatedTypeFlag  = "filter-created-type"
+	FilterCreatedDateFlag  = "filter-created-date"
+
+	FilterLastModifiedLevelFlag = "filter-last-modified-level"
+	FilterLastModifiedTypeFlag  = "filter-last-modified-type"
+	FilterLastModifiedDateFlag  = "filter-last-modified-date"
+
+	FilterCreatedByLevelFlag  = "filter-created-by-level"
+	FilterCreatedByUserIDFlag = "filter-created-by-user-id"
+
+	FilterLastModifiedByLevelFlag  = "filter-last-modified-by-level"
+	FilterLastModifiedByUserIDFlag = "filter-last-modified-by-user-id"
+)
+
+// AddFilterFlags registers the --filter-* flags. Their values are read back
+// with ResolveFilter.
+func AddFilterFlags(f *pflag.FlagSet) {
+	f.String(FilterQueryFlag, "", "Filter: free-text query to match entries.")
+	f.StringArray(FilterLocaleFlag, nil, "Filter: locale ID to match (repeatable → filter.localeIds).")
+	f.StringArray(FilterEntryUIDFlag, nil, "Filter: entry UID to match (repeatable → filter.entryUids).")
+	f.String(FilterEntryStateFlag, "", "Filter: entry state to match.")
+	f.String(FilterMissingTranslationLocaleFlag, "", "Filter: locale ID that must be missing a translation.")
+	f.String(FilterPresentTranslationLocaleFlag, "", "Filter: locale ID that must have a translation.")
+	f.String(FilterDntLocaleFlag, "", "Filter: DNT (do-not-translate) locale ID.")
+	f.Bool(FilterReturnFallbackTranslationsFlag, false, "Filter: include fallback translations in the result.")
+	f.String(FilterLabelsTypeFlag, "", "Filter: labels.type to match.")
+	f.StringArray(FilterLabelFlag, nil, "Filter: name or UID of a label to match (repeatable → filter.labels.labelUids).")
+	f.Bool(FilterDntTermSetFlag, false, "Filter: restrict to entries whose DNT term-set flag is set.")
+
+	f.String(FilterCreatedLevelFlag, "", "Filter: created.level.")
+	f.String(FilterCreatedTypeFlag, "", "Filter: created.type (e.g. AFTER, BEFORE).")
+	f.String(FilterCreatedDateFlag, "", "Filter: created.date in RFC3339 (e.g. 2026-01-02T15:04:05Z).")
+
+	f.String(FilterLastModifiedLevelFlag, "", "Filter: lastModified.level.")
+	f.String(FilterLastModifiedTypeFlag, "", "Filter: lastModified.type.")
+	f.String(FilterLastModifiedDateFlag, "", "Filter: lastModified.date in RFC3339.")
+
+	f.String(FilterCreatedByLevelFlag, "", "Filter: createdBy.level.")
+	f.StringArray(FilterCreatedByUserIDFlag, nil, "Filter: createdBy.userIds entry (repeatable).")
+	f.String(FilterLastModifiedByLevelFlag, "", "Filter: lastModifiedBy.level.")
+	f.StringArray(FilterLastModifiedByUserIDFlag, nil, "Filter: lastModifiedBy.userIds entry (repeatable).")
+}
+
+// ResolveFilter builds the entry filter from the --filter-* flags, falling
+// back to the config file values for flags that were not set.
+func ResolveFilter(cmd *cobra.Command, cfg ExportFilterConfig) (srv.ExportFilter, error) {
+	str := func(name string, config *string) string {
+		return resolve.FallbackString(cmd.Flags().Lookup(name), resolve.StringParam{FlagName: name, Config: config})
+	}
+	boolean := func(name string, config *bool) bool {
+		return resolve.FallbackBool(cmd.Flags().Lookup(name), resolve.BoolParam{FlagName: name, Config: config})
+	}
+
+	filter := srv.ExportFilter{
+		Query:                      str(FilterQueryFlag, &cfg.Query),
+		LocaleID:                   resolve.FallbackStringArray(cmd, FilterLocaleFlag, cfg.LocaleIDs),
+		EntryUIDs:                  resolve.FallbackStringArray(cmd, FilterEntryUIDFlag, cfg.EntryUIDs),
+		EntryState:                 str(FilterEntryStateFlag, &cfg.EntryState),
+		MissingTranslationLocaleID: str(FilterMissingTranslationLocaleFlag, &cfg.MissingTranslationLocaleID),
+		PresentTranslationLocaleID: str(FilterPresentTranslationLocaleFlag, &cfg.PresentTranslationLocaleID),
+		DntLocaleID:                str(FilterDntLocaleFlag, &cfg.DntLocaleID),
+		ReturnFallbackTranslations: boolean(FilterReturnFallbackTranslationsFlag, &cfg.ReturnFallbackTranslations),
+		LabelsType:                 str(FilterLabelsTypeFlag, &cfg.LabelsType),
+		Labels:                     resolve.FallbackStringArray(cmd, FilterLabelFlag, cfg.Labels),
+		DntTermSet:                 boolean(FilterDntTermSetFlag, &cfg.DntTermSet),
+		Created: srv.Created{
+			Level: str(FilterCreatedLevelFlag, &cfg.Created.Level),
+			Type:  str(FilterCreatedTypeFlag, &cfg.Created.Type),
+		},
+		CreatedBy: srv.CreatedBy{
+			Level:   str(FilterCreatedByLevelFlag, &cfg.CreatedBy.Level),
+			UserIDs: resolve.FallbackStringArray(cmd, FilterCreatedByUserIDFlag, cfg.CreatedBy.UserIDs),
+		},
+		LastModified: srv.LastModified{
+			Level: str(FilterLastModifiedLevelFlag, &cfg.LastModified.Level),
+			Type:  str(FilterLastModifiedTypeFlag, &cfg.LastModified.Type),
+		},
+		LastModifiedBy: srv.LastModifiedBy{
+			Level:   str(FilterLastModifiedByLevelFlag, &cfg.LastModifiedBy.Level),
+			UserIDs: resolve.FallbackStringArray(cmd, FilterLastModifiedByUserIDFlag, cfg.LastModifiedBy.UserIDs),
+		},
+	}
+
+	var err error
+	if filter.Created.Date, err = resolve.FallbackDate(cmd, FilterCreatedDateFlag, cfg.Created.Date); err != nil {
+		return srv.ExportFilter{}, err
+	}
+	if filter.LastModified.Date, err = resolve.FallbackDate(cmd, FilterLastModifiedDateFlag, cfg.LastModified.Date); err != nil {
+		return srv.ExportFilter{}, err
+	}
+	return filter, nil
+}
+
+// LabelNotFoundError turns an unknown label, given to --filter-label or
+// --label, into a UIError; other errors are returned as they are.
+func LabelNotFoundError(err error) error {
+	if errors.Is(err, srv.ErrLabelNotFound) {
+		return clierror.UIError{
+			Operation:   "find label",
+			Err:         err,
+			Description: fmt.Sprintf("%v; list the labels with \"glossaries labels list\"", err),
+		}
+	}
+	return err
+}
+
+// GlossaryNotFoundError turns an unknown glossary into a UIError; other
+// errors are returned as they are.
+func GlossaryNotFoundError(err error, glossaryUIDOrName string) error {
+	if errors.Is(err, glossaryapi.ErrGlossaryNotFound) {
+		return clierror.UIError{
+			Operation:   "find glossary",
+			Err:         err,
+			Description: fmt.Sprintf("no glossary found for %q", glossaryUIDOrName),
+		}
+	}
+	return err
+}
@@ -0,0 +1,50 @@
+package glimportcancel
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+const glossaryFlag = "glossary"
+
+// NewCancelCmd builds the `glossaries import cancel` command.
+func NewCancelCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var glossary string
+
+	cancelCmd := &cobra.Command{
+		Use:   "cancel <importUID>",
+		Short: "Discard a previewed glossary import",
+		Long: `Cancel a pending import created by "glossaries import --preview". The
+glossary is left unchanged. Only pending imports can be canceled.`,
+		Example: `
+# Discard a previewed import
+
+  smartling-cli glossaries import cancel 0b7e3c1a-5a2f-4c1e-9f3e-1d2c3b4a5f6e --glossary "CLI glossary"
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, glossary, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve import cancel params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	cancelCmd.Flags().StringVar(&glossary, glossaryFlag, "", "UID or name of the glossary the import belongs to.")
+	_ = cancelCmd.MarkFlagRequired(glossaryFlag)
+
+	return cancelCmd
+}
@@ -13,15 +13,21 @@ import (
 // Smartling Glossary Import API request body
 // (https://api-reference.smartling.com/#tag/Glossary-API/operation/importGlossary).
 const (
-	archiveModeFlag = "archive-mode"
-	mediaTypeFlag   = "media-type"
+	archiveModeFlag    = "archive-mode"
+	labelFlag          = "label"
+	mediaTypeFlag      = "media-type"
+	previewFlag        = "preview"
+	skipValidationFlag = "skip-validation"
 )
 
 // NewImportCmd builds the `glossaries import` command.
 func NewImportCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
 	var (
-		archiveMode bool
-		mediaType   string
+		archiveMode    bool
+		labels         []string
+		mediaType      string
+		preview        bool
+		skipValidation bool
 	)
 
 	importCmd := &cobra.Command{
@@ -31,7 +37,21 @@ func NewImportCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
 
 Validates and uploads the file, waits for the server to confirm the import,
 then polls until the import reaches SUCCESSFUL or FAILED status. New entries
-are created.`,
+are created.
+
+Before uploading, the file is checked locally the same way as by
+"glossaries validate": locale columns must be glossary locales, and terms and
+entry UIDs must not repeat. Any error stops the import; warnings are logged.
+Pass --skip-validation to leave all checks to the server.
+
+--label adds an existing label to every entry of a CSV or XLSX file, on top
+of the labels in its Labels column. Create labels with
+"glossaries labels create".
+
+With --preview the import stops before confirmation: the glossary is left
+unchanged and the pending entry and translation changes, as well as any
+warnings, are printed together with the import UID. Apply the import with
+"glossaries import confirm" or discard it with "glossaries import cancel".`,
 		Example: `
 # Import a CSV file into a glossary (media type derived from .csv extension)
 
@@ -48,6 +68,15 @@ are created.`,
 # Override the auto-derived media type
 
   smartling-cli glossaries import "CLI glossary" ./terms.dat --media-type text/csv
+
+# Label every imported entry, e.g. to slice a shared glossary by product
+
+  smartling-cli glossaries import "CLI glossary" ./mobile.csv --label mobile
+
+# Review the changes first, then apply them
+
+  smartling-cli glossaries import "CLI glossary" ./terms.csv --preview
+  smartling-cli glossaries import confirm <importUID> --glossary "CLI glossary"
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -73,6 +102,9 @@ are created.`,
 	}
 
 	importCmd.Flags().BoolVar(&archiveMode, archiveModeFlag, false, "Archive entries that are missing from the imported file.")
+	importCmd.Flags().BoolVar(&skipValidation, skipValidationFlag, false, "Upload the file without validating it locally first.")
+	importCmd.Flags().StringArrayVar(&labels, labelFlag, nil, "Name or UID of a label to add to every imported entry (repeatable). Not supported for TBX files.")
+	importCmd.Flags().BoolVar(&preview, previewFlag, false, "Upload the file and show the pending changes without applying them.")
 	importCmd.Flags().StringVar(&mediaType, mediaTypeFlag, "", `Override the media type. Must be one of "text/csv", "text/xml", or "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet". By default derived from the file extension.`)
 
 	return importCmd
@@ -0,0 +1,50 @@
+package glimportconfirm
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+const glossaryFlag = "glossary"
+
+// NewConfirmCmd builds the `glossaries import confirm` command.
+func NewConfirmCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var glossary string
+
+	confirmCmd := &cobra.Command{
+		Use:   "confirm <importUID>",
+		Short: "Apply a previewed glossary import",
+		Long: `Confirm a pending import created by "glossaries import --preview" and wait
+until the glossary is updated. Only pending imports can be confirmed.`,
+		Example: `
+# Apply a previewed import
+
+  smartling-cli glossaries import confirm 0b7e3c1a-5a2f-4c1e-9f3e-1d2c3b4a5f6e --glossary "CLI glossary"
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, glossary, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve import confirm params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	confirmCmd.Flags().StringVar(&glossary, glossaryFlag, "", "UID or name of the glossary the import belongs to.")
+	_ = confirmCmd.MarkFlagRequired(glossaryFlag)
+
+	return confirmCmd
+}
@@ -0,0 +1,56 @@
+package glimportconfirm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+)
+
+func run(ctx context.Context,
+	initializer glossariescmd.SrvInitializer,
+	params srv.ImportActionParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running glossary import confirm with params: %v", params)
+	glossarySrv, err := initializer.InitGlossarySrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Glossary service",
+		}
+	}
+
+	confirmOutput, err := glossarySrv.RunImportConfirm(ctx, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, glossaryapi.ErrGlossaryNotFound):
+			return clierror.UIError{
+				Operation:   "find glossary",
+				Err:         err,
+				Description: fmt.Sprintf("no glossary found for %q", params.GlossaryUIDOrName),
+			}
+		case errors.Is(err, glossaryapi.ErrImportNotFound):
+			return clierror.UIError{
+				Operation:   "find import",
+				Err:         err,
+				Description: fmt.Sprintf("no glossary import found for %q", params.ImportUID),
+			}
+		}
+		return err
+	}
+
+	outputFormat := static.GetOutputFormat[srv.ImportActionOutput](outputParams.Format)
+	outputFormat.FormatAndRender(confirmOutput)
+
+	return nil
+}
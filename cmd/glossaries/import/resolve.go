@@ -38,6 +38,12 @@ func resolveParams(cmd *cobra.Command, fileConfig glossariescmd.FileConfig, glos
 	if mediaType == "" {
 		mediaType = mediaTypeFromPath(inFile)
 	}
+	skipValidation := resolve.FallbackBool(cmd.Flags().Lookup(skipValidationFlag), resolve.BoolParam{FlagName: skipValidationFlag, Config: &cfg.SkipValidation})
+	labels := resolve.FallbackStringArray(cmd, labelFlag, cfg.Labels)
+	preview, err := cmd.Flags().GetBool(previewFlag)
+	if err != nil {
+		return srv.ImportParams{}, err
+	}
 
 	return srv.ImportParams{
 		AccountUID:        accountUID,
@@ -48,6 +54,9 @@ func resolveParams(cmd *cobra.Command, fileConfig glossariescmd.FileConfig, glos
 			Name:      filepath.Base(inFile),
 			MediaType: mediaType,
 		},
+		Preview:        preview,
+		SkipValidation: skipValidation,
+		Labels:         labels,
 	}, nil
 }
 
@@ -175,9 +175,10 @@ func Test_resolveParams(t *testing.T) {
 			name:  "mediaType from fileConfig when no flag set",
 			setup: func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, string(testAccount)) },
 			fileConfig: glossariescmd.FileConfig{Glossaries: struct {
-				Export glossariescmd.ExportConfig `yaml:"export,omitzero"`
-				Create glossariescmd.CreateConfig `yaml:"create,omitzero"`
-				Import glossariescmd.ImportConfig `yaml:"import,omitzero"`
+				Export  glossariescmd.ExportConfig  `yaml:"export,omitzero"`
+				Create  glossariescmd.CreateConfig  `yaml:"create,omitzero"`
+				Import  glossariescmd.ImportConfig  `yaml:"import,omitzero"`
+				Entries glossariescmd.EntriesConfig `yaml:"entries,omitzero"`
 			}{Import: glossariescmd.ImportConfig{MediaType: "text/xml"}}},
 			glossaryUIDOrName: testGlossary,
 			inFile:            "terms.dat",
@@ -203,9 +204,10 @@ func Test_resolveParams(t *testing.T) {
 			name:  "archiveMode from fileConfig",
 			setup: func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, string(testAccount)) },
 			fileConfig: glossariescmd.FileConfig{Glossaries: struct {
-				Export glossariescmd.ExportConfig `yaml:"export,omitzero"`
-				Create glossariescmd.CreateConfig `yaml:"create,omitzero"`
-				Import glossariescmd.ImportConfig `yaml:"import,omitzero"`
+				Export  glossariescmd.ExportConfig  `yaml:"export,omitzero"`
+				Create  glossariescmd.CreateConfig  `yaml:"create,omitzero"`
+				Import  glossariescmd.ImportConfig  `yaml:"import,omitzero"`
+				Entries glossariescmd.EntriesConfig `yaml:"entries,omitzero"`
 			}{Import: glossariescmd.ImportConfig{ArchiveMode: true}}},
 			glossaryUIDOrName: testGlossary,
 			inFile:            "terms.csv",
@@ -215,6 +217,89 @@ func Test_resolveParams(t *testing.T) {
 				return p
 			}(),
 		},
+		// ── preview ───────────────────────────────────────────────────────────
+		{
+			name: "--preview flag sets Preview",
+			setup: func(t *testing.T) *cobra.Command {
+				cmd := makeCmd(t, noConfigPath, string(testAccount))
+				_ = cmd.Flags().Set(previewFlag, "true")
+				return cmd
+			},
+			glossaryUIDOrName: testGlossary,
+			inFile:            "terms.csv",
+			want: func() srv.ImportParams {
+				p := baseWant("terms.csv", "text/csv")
+				p.Preview = true
+				return p
+			}(),
+		},
+		// ── skipValidation ────────────────────────────────────────────────────
+		{
+			name: "--skip-validation flag sets SkipValidation",
+			setup: func(t *testing.T) *cobra.Command {
+				cmd := makeCmd(t, noConfigPath, string(testAccount))
+				_ = cmd.Flags().Set(skipValidationFlag, "true")
+				return cmd
+			},
+			glossaryUIDOrName: testGlossary,
+			inFile:            "terms.csv",
+			want: func() srv.ImportParams {
+				p := baseWant("terms.csv", "text/csv")
+				p.SkipValidation = true
+				return p
+			}(),
+		},
+		{
+			name:  "skipValidation from fileConfig",
+			setup: func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, string(testAccount)) },
+			fileConfig: glossariescmd.FileConfig{Glossaries: struct {
+				Export  glossariescmd.ExportConfig  `yaml:"export,omitzero"`
+				Create  glossariescmd.CreateConfig  `yaml:"create,omitzero"`
+				Import  glossariescmd.ImportConfig  `yaml:"import,omitzero"`
+				Entries glossariescmd.EntriesConfig `yaml:"entries,omitzero"`
+			}{Import: glossariescmd.ImportConfig{SkipValidation: true}}},
+			glossaryUIDOrName: testGlossary,
+			inFile:            "terms.csv",
+			want: func() srv.ImportParams {
+				p := baseWant("terms.csv", "text/csv")
+				p.SkipValidation = true
+				return p
+			}(),
+		},
+		// ── labels ────────────────────────────────────────────────────────────
+		{
+			name: "--label flags set Labels",
+			setup: func(t *testing.T) *cobra.Command {
+				cmd := makeCmd(t, noConfigPath, string(testAccount))
+				_ = cmd.Flags().Set(labelFlag, "mobile")
+				_ = cmd.Flags().Set(labelFlag, "2026 release")
+				return cmd
+			},
+			glossaryUIDOrName: testGlossary,
+			inFile:            "terms.csv",
+			want: func() srv.ImportParams {
+				p := baseWant("terms.csv", "text/csv")
+				p.Labels = []string{"mobile", "2026 release"}
+				return p
+			}(),
+		},
+		{
+			name:  "labels from fileConfig",
+			setup: func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, string(testAccount)) },
+			fileConfig: glossariescmd.FileConfig{Glossaries: struct {
+				Export  glossariescmd.ExportConfig  `yaml:"export,omitzero"`
+				Create  glossariescmd.CreateConfig  `yaml:"create,omitzero"`
+				Import  glossariescmd.ImportConfig  `yaml:"import,omitzero"`
+				Entries glossariescmd.EntriesConfig `yaml:"entries,omitzero"`
+			}{Import: glossariescmd.ImportConfig{Labels: []string{"web"}}}},
+			glossaryUIDOrName: testGlossary,
+			inFile:            "terms.csv",
+			want: func() srv.ImportParams {
+				p := baseWant("terms.csv", "text/csv")
+				p.Labels = []string{"web"}
+				return p
+			}(),
+		},
 		// ── error cases ───────────────────────────────────────────────────────
 		{
 			name:    "missing account — error",
@@ -46,7 +46,7 @@ func run(ctx context.Context,
 				Description: fmt.Sprintf("no glossary import found for %q", params.GlossaryUIDOrName),
 			}
 		}
-		return err
+		return glossariescmd.LabelNotFoundError(err)
 	}
 
 	outputFormat := static.GetOutputFormat[srv.ImportOutput](outputParams.Format)
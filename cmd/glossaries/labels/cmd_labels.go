@@ -0,0 +1,32 @@
+package gllabels
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewLabelsCmd builds the `glossaries labels` command.
+func NewLabelsCmd() *cobra.Command {
+	labelsCmd := &cobra.Command{
+		Use:   "labels",
+		Short: "Manage glossary labels",
+		Long: `List, create, or delete the glossary labels of the account.
+
+Labels belong to the account and can be put on the entries of any of its
+glossaries, e.g. to slice one shared glossary by product. Add a label to
+every entry of an import with "glossaries import --label", and export or
+list only the labeled entries with --filter-label.`,
+		Example: `
+# List the labels of the account
+
+  smartling-cli glossaries labels list
+
+# Label the entries of a product and export them again
+
+  smartling-cli glossaries labels create mobile
+  smartling-cli glossaries import "CLI glossary" ./mobile.csv --label mobile
+  smartling-cli glossaries export "CLI glossary" --filter-label mobile
+`,
+	}
+
+	return labelsCmd
+}
@@ -0,0 +1,45 @@
+package gllabelcreate
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCreateCmd builds the `glossaries labels create` command.
+func NewCreateCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create <labelName>",
+		Short: "Create a glossary label",
+		Long: `Create a glossary label in the account.
+
+Label names are unique regardless of case and must not contain a comma, which
+separates labels in the Labels column of glossary files.`,
+		Example: `
+# Create a label for the entries of the mobile apps
+
+  smartling-cli glossaries labels create mobile
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve create label params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	return createCmd
+}
@@ -0,0 +1,52 @@
+package gllabeldelete
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+const yesFlag = "yes"
+
+// NewDeleteCmd builds the `glossaries labels delete` command.
+func NewDeleteCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var yes bool
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <labelUID|labelName>",
+		Short: "Delete a glossary label",
+		Long: `Delete a glossary label. The label is taken off every entry that has it, in
+all glossaries of the account; the entries themselves are kept.
+
+The command asks for confirmation; pass --yes to delete without a prompt,
+which is required when stdin is not a terminal.`,
+		Example: `
+# Delete a label without a prompt
+
+  smartling-cli glossaries labels delete mobile --yes
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve delete label params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, yes, outputParams)
+		},
+	}
+
+	deleteCmd.Flags().BoolVar(&yes, yesFlag, false, "Delete without asking for confirmation.")
+
+	return deleteCmd
+}
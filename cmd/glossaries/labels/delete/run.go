@@ -0,0 +1,46 @@
+package gllabeldelete
+
+import (
+	"context"
+	"fmt"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+func run(ctx context.Context,
+	initializer glossariescmd.SrvInitializer,
+	params srv.LabelParams,
+	yes bool,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running glossary labels delete with params: %v", params)
+	glossarySrv, err := initializer.InitGlossarySrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Glossary service",
+		}
+	}
+
+	question := fmt.Sprintf("Delete label %q? It is taken off every glossary entry that has it. This cannot be undone.", params.LabelUIDOrName)
+	if err := rootcmd.Confirm(question, yes); err != nil {
+		return err
+	}
+
+	deleteOutput, err := glossarySrv.RunDeleteLabel(ctx, params)
+	if err != nil {
+		return glossariescmd.LabelNotFoundError(err)
+	}
+
+	outputFormat := static.GetOutputFormat[srv.LabelOutput](outputParams.Format)
+	outputFormat.FormatAndRender(deleteOutput)
+
+	return nil
+}
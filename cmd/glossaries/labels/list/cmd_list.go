@@ -0,0 +1,42 @@
+package gllabellist
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewListCmd builds the `glossaries labels list` command.
+func NewListCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List glossary labels",
+		Long:  `List the glossary labels of the account with their UIDs.`,
+		Example: `
+# List the labels as a table
+
+  smartling-cli glossaries labels list --output table
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to resolve list labels params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	return listCmd
+}
@@ -0,0 +1,31 @@
+package gllabellist
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+func resolveParams(cmd *cobra.Command) (srv.LabelsParams, error) {
+	rlog.Debugf("resolving list labels params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.LabelsParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.LabelsParams{}, err
+	}
+
+	return srv.LabelsParams{AccountUID: accountUID}, nil
+}
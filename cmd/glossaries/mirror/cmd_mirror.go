@@ -0,0 +1,83 @@
+package glmirror
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Flag names accepted by `glossaries mirror`.
+const (
+	fileTypeFlag   = "file-type"
+	tbxVersionFlag = "tbx-version"
+	pruneFlag      = "prune"
+)
+
+// NewMirrorCmd builds the `glossaries mirror` command.
+func NewMirrorCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var (
+		fileType   string
+		tbxVersion string
+		prune      bool
+	)
+
+	mirrorCmd := &cobra.Command{
+		Use:   "mirror <directory>",
+		Short: "Export every glossary of the account to a directory",
+		Long: `Export every glossary of the account to a directory with a stable layout,
+so that the directory can be kept in version control.
+
+With --file-type csv (the default) every glossary is written as one CSV file
+per locale, "<name>/<localeId>.csv". With --file-type tbx every glossary is
+written as a single "<name>.tbx" file of the --tbx-version structure (v3 by
+default). Characters which are not allowed in file names are replaced by "_";
+glossaries whose names differ only in case get their UID appended.
+
+Exports are normalized, with entries sorted by entry UID, so that a glossary
+which did not change produces the same bytes. Files whose content is unchanged
+are not rewritten, which keeps scheduled runs to minimal diffs. The command
+prints the added, updated, and stale files and a change summary.
+
+Files of the layout which no glossary produced any more, such as those of a
+deleted glossary or locale, are reported as stale; pass --prune to remove them.
+Other files in the directory are never touched.
+
+When some glossaries fail to export, the others are still written and the
+command exits with code 5.`,
+		Example: `
+# Mirror all glossaries as CSV files
+
+  smartling-cli glossaries mirror glossaries/
+
+# Mirror all glossaries as TBX v2 files and remove files of deleted glossaries
+
+  smartling-cli glossaries mirror glossaries/ --file-type tbx --tbx-version v2 --prune
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0], fileType, tbxVersion, prune)
+			if err != nil {
+				return fmt.Errorf("failed to resolve mirror params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	f := mirrorCmd.Flags()
+	f.StringVar(&fileType, fileTypeFlag, "csv", "File type: csv, one file per locale, or tbx, one file per glossary.")
+	f.StringVar(&tbxVersion, tbxVersionFlag, "", "TBX version when --file-type=tbx: v2 or v3. Defaults to v3.")
+	f.BoolVar(&prune, pruneFlag, false, "Remove files of the layout which no glossary produced.")
+
+	return mirrorCmd
+}
@@ -0,0 +1,43 @@
+package glmirror
+
+import (
+	"strings"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+func resolveParams(cmd *cobra.Command, directory, fileType, tbxVersion string, prune bool) (srv.MirrorParams, error) {
+	rlog.Debugf("resolving mirror params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.MirrorParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.MirrorParams{}, err
+	}
+
+	params := srv.MirrorParams{
+		AccountUID: accountUID,
+		Directory:  directory,
+		FileType:   strings.ToLower(fileType),
+		TbxVersion: strings.ToLower(tbxVersion),
+		Prune:      prune,
+	}
+	if params.FileType == srv.TbxExportFileType && params.TbxVersion == "" {
+		params.TbxVersion = "v3"
+	}
+	return params, nil
+}
@@ -0,0 +1,71 @@
+package glmirror
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+
+	"github.com/spf13/cobra"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	m.Run()
+}
+
+func makeCmd(t *testing.T, configPath, accountFlag string) *cobra.Command {
+	t.Helper()
+	root := rootcmd.NewRootCmd()
+	mirrorCmd := NewMirrorCmd(nil)
+	root.AddCommand(mirrorCmd)
+	_ = root.PersistentFlags().Set("config", configPath)
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+	if accountFlag != "" {
+		_ = root.PersistentFlags().Set("account", accountFlag)
+	}
+	return mirrorCmd
+}
+
+func Test_resolveParams(t *testing.T) {
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+	noConfigPath := filepath.Join(t.TempDir(), "no-smartling.yml")
+
+	tests := []struct {
+		name                 string
+		fileType, tbxVersion string
+		prune                bool
+		want                 srv.MirrorParams
+	}{
+		{
+			name:     "csv",
+			fileType: "CSV",
+			want:     srv.MirrorParams{AccountUID: "account", Directory: "glossaries", FileType: "csv"},
+		},
+		{
+			name:     "tbx defaults to v3",
+			fileType: "tbx", prune: true,
+			want: srv.MirrorParams{AccountUID: "account", Directory: "glossaries", FileType: "tbx", TbxVersion: "v3", Prune: true},
+		},
+		{
+			name:     "explicit tbx version",
+			fileType: "tbx", tbxVersion: "V2",
+			want: srv.MirrorParams{AccountUID: "account", Directory: "glossaries", FileType: "tbx", TbxVersion: "v2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := makeCmd(t, noConfigPath, "account")
+			got, err := resolveParams(cmd, "glossaries", tt.fileType, tt.tbxVersion, tt.prune)
+			if err != nil {
+				t.Fatalf("resolveParams() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveParams() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
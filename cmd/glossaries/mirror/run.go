@@ -0,0 +1,36 @@
+package glmirror
+
+import (
+	"context"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+func run(ctx context.Context,
+	initializer glossariescmd.SrvInitializer,
+	params srv.MirrorParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running glossary mirror with params: %v", params)
+	glossarySrv, err := initializer.InitGlossarySrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Glossary service",
+		}
+	}
+
+	mirrorOutput, err := glossarySrv.RunMirror(ctx, params)
+	if mirrorOutput.JSON != nil {
+		outputFormat := static.GetOutputFormat[srv.MirrorOutput](outputParams.Format)
+		outputFormat.FormatAndRender(mirrorOutput)
+		return clierror.Rendered(err)
+	}
+	return err
+}
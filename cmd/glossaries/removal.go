@@ -0,0 +1,53 @@
+package glossaries
+
+import (
+	"context"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+)
+
+// RunRemoval runs `glossaries archive` and `glossaries delete`: it asks
+// question about the glossary and, once confirmed, removes it with remove
+// and renders the result.
+func RunRemoval(ctx context.Context,
+	initializer SrvInitializer,
+	params srv.GlossaryParams,
+	yes bool,
+	outputParams output.Params,
+	question func(srv.ViewOutput) string,
+	remove func(srv.Service, context.Context, srv.GlossaryParams) (srv.ArchiveOutput, error),
+) error {
+	glossarySrv, err := initializer.InitGlossarySrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Glossary service",
+		}
+	}
+
+	// The glossary is read first, so that the prompt names what is about to
+	// be removed and an unknown glossary fails before asking.
+	view, err := glossarySrv.RunView(ctx, params)
+	if err != nil {
+		return GlossaryNotFoundError(err, params.GlossaryUIDOrName)
+	}
+	if err := rootcmd.Confirm(question(view), yes); err != nil {
+		return err
+	}
+
+	params.GlossaryUIDOrName = view.GlossaryUID
+	out, err := remove(glossarySrv, ctx, params)
+	if err != nil {
+		return GlossaryNotFoundError(err, params.GlossaryUIDOrName)
+	}
+
+	outputFormat := static.GetOutputFormat[srv.ArchiveOutput](outputParams.Format)
+	outputFormat.FormatAndRender(out)
+
+	return nil
+}
@@ -28,6 +28,6 @@ func (i srvInitializer) InitGlossarySrv(ctx context.Context) (srv.Service, error
 		return nil, err
 	}
 	glossaryApi := glossaryapi.NewGlossary(client.Client)
-	glossarySrv := srv.NewService(glossaryApi)
+	glossarySrv := srv.NewService(glossaryApi, srv.NewAPI(client.Client))
 	return glossarySrv, nil
 }
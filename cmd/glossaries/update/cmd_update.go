@@ -0,0 +1,76 @@
+package glupdate
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Flag names accepted by `glossaries update`.
+const (
+	nameFlag                 = "name"
+	descriptionFlag          = "description"
+	verificationModeFlag     = "verification-mode"
+	addLocaleFlag            = "add-locale"
+	removeLocaleFlag         = "remove-locale"
+	fallbackLocaleFlag       = "fallback-locale"
+	removeFallbackLocaleFlag = "remove-fallback-locale"
+)
+
+// NewUpdateCmd builds the `glossaries update` command.
+func NewUpdateCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	updateCmd := &cobra.Command{
+		Use:   "update <glossaryUID|glossaryName>",
+		Short: "Update glossary metadata",
+		Long: `Change the name, description, verification mode, locales, or fallback
+locales of a glossary. Only the given attributes are changed; the glossary
+is shown as "glossaries view" shows it afterwards.
+
+--fallback-locale uses the same format as for "glossaries create" and
+replaces the mapping of its fallback locale, or adds it:
+  --fallback-locale <fallbackLocaleId>:<localeId>[,<localeId>...]
+
+A removed locale is also removed from the fallback locale mappings; the
+mapping of a removed fallback locale, and mappings left without locales,
+are dropped.`,
+		Example: `
+# Add Spanish locales and let Mexican Spanish fall back to Spain
+
+  smartling-cli glossaries update "CLI glossary" --add-locale es-ES --add-locale es-MX --fallback-locale es-ES:es-MX
+
+# Rename a glossary and clear its description
+
+  smartling-cli glossaries update "CLI glossary" --name "Website glossary" --description ""
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve update params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	f := updateCmd.Flags()
+	f.String(nameFlag, "", "New glossary name.")
+	f.String(descriptionFlag, "", "New glossary description.")
+	f.Bool(verificationModeFlag, false, "Enable or disable verification mode, e.g. --verification-mode=false.")
+	f.StringArray(addLocaleFlag, nil, "Locale ID to add to the glossary (repeatable).")
+	f.StringArray(removeLocaleFlag, nil, "Locale ID to remove from the glossary (repeatable).")
+	f.StringArray(fallbackLocaleFlag, nil, "Fallback locale mapping to set (repeatable). Format: '<fallbackLocaleId>:<localeId>[,<localeId>...]'.")
+	f.StringArray(removeFallbackLocaleFlag, nil, "Fallback locale ID whose mapping to remove (repeatable).")
+
+	return updateCmd
+}
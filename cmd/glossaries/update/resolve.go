@@ -0,0 +1,62 @@
+package glupdate
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+// resolveParams resolves glossaries-update params. Only flags set on the
+// command line are applied, so the description can be cleared with an empty
+// value.
+func resolveParams(cmd *cobra.Command, glossaryUIDOrName string) (srv.UpdateParams, error) {
+	rlog.Debugf("resolving update params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.UpdateParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.UpdateParams{}, err
+	}
+
+	flags := cmd.Flags()
+	params := srv.UpdateParams{
+		AccountUID:        accountUID,
+		GlossaryUIDOrName: glossaryUIDOrName,
+	}
+	if flags.Changed(nameFlag) {
+		name, _ := flags.GetString(nameFlag)
+		params.GlossaryName = &name
+	}
+	if flags.Changed(descriptionFlag) {
+		description, _ := flags.GetString(descriptionFlag)
+		params.Description = &description
+	}
+	if flags.Changed(verificationModeFlag) {
+		verificationMode, _ := flags.GetBool(verificationModeFlag)
+		params.VerificationMode = &verificationMode
+	}
+	params.AddLocaleIDs, _ = flags.GetStringArray(addLocaleFlag)
+	params.RemoveLocaleIDs, _ = flags.GetStringArray(removeLocaleFlag)
+	params.RemoveFallbackLocaleIDs, _ = flags.GetStringArray(removeFallbackLocaleFlag)
+
+	rawFallbacks, _ := flags.GetStringArray(fallbackLocaleFlag)
+	if len(rawFallbacks) > 0 {
+		if params.FallbackLocales, err = glossariescmd.ParseFallbackLocales(rawFallbacks); err != nil {
+			return srv.UpdateParams{}, err
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,125 @@
+package glupdate
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"github.com/spf13/cobra"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	m.Run()
+}
+
+func makeCmd(t *testing.T, configPath, accountFlag string) *cobra.Command {
+	t.Helper()
+	root := rootcmd.NewRootCmd()
+	updateCmd := NewUpdateCmd(nil)
+	root.AddCommand(updateCmd)
+	_ = root.PersistentFlags().Set("config", configPath)
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+	if accountFlag != "" {
+		_ = root.PersistentFlags().Set("account", accountFlag)
+	}
+	return updateCmd
+}
+
+func Test_resolveParams(t *testing.T) {
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+
+	noConfigPath := filepath.Join(t.TempDir(), "no-smartling.yml")
+	const testAccount = uid.AccountUID("test-account-uid")
+
+	baseWant := func() srv.UpdateParams {
+		return srv.UpdateParams{
+			AccountUID:              testAccount,
+			GlossaryUIDOrName:       "Web",
+			AddLocaleIDs:            []string{},
+			RemoveLocaleIDs:         []string{},
+			RemoveFallbackLocaleIDs: []string{},
+		}
+	}
+	withFlags := func(values map[string][]string) func(t *testing.T) *cobra.Command {
+		return func(t *testing.T) *cobra.Command {
+			cmd := makeCmd(t, noConfigPath, string(testAccount))
+			for name, flagValues := range values {
+				for _, value := range flagValues {
+					_ = cmd.Flags().Set(name, value)
+				}
+			}
+			return cmd
+		}
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *cobra.Command
+		want    srv.UpdateParams
+		wantErr bool
+	}{
+		{
+			name:  "unset flags are left out",
+			setup: withFlags(nil),
+			want:  baseWant(),
+		},
+		{
+			name: "empty description clears it",
+			setup: withFlags(map[string][]string{
+				nameFlag:             {"Website"},
+				descriptionFlag:      {""},
+				verificationModeFlag: {"false"},
+			}),
+			want: func() srv.UpdateParams {
+				p := baseWant()
+				p.GlossaryName, p.Description, p.VerificationMode = new("Website"), new(""), new(false)
+				return p
+			}(),
+		},
+		{
+			name: "locales and fallback locales",
+			setup: withFlags(map[string][]string{
+				addLocaleFlag:            {"es-ES", "es-MX"},
+				removeLocaleFlag:         {"fr-FR"},
+				fallbackLocaleFlag:       {"es-ES:es-MX"},
+				removeFallbackLocaleFlag: {"fr-FR"},
+			}),
+			want: func() srv.UpdateParams {
+				p := baseWant()
+				p.AddLocaleIDs = []string{"es-ES", "es-MX"}
+				p.RemoveLocaleIDs = []string{"fr-FR"}
+				p.FallbackLocales = []srv.FallbackLocale{{FallbackLocaleID: "es-ES", LocaleIDs: []string{"es-MX"}}}
+				p.RemoveFallbackLocaleIDs = []string{"fr-FR"}
+				return p
+			}(),
+		},
+		{
+			name:    "invalid fallback locale — error",
+			setup:   withFlags(map[string][]string{fallbackLocaleFlag: {"es-MX"}}),
+			wantErr: true,
+		},
+		{
+			name:    "missing account — error",
+			setup:   func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "") },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveParams(tt.setup(t), "Web")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveParams() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveParams() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package glvalidate
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// Flag names accepted by `glossaries validate`.
+const (
+	glossaryFlag  = "glossary"
+	mediaTypeFlag = "media-type"
+)
+
+// NewValidateCmd builds the `glossaries validate` command.
+func NewValidateCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	var (
+		glossary  string
+		mediaType string
+	)
+
+	validateCmd := &cobra.Command{
+		Use:   "validate <file>",
+		Short: "Check a glossary file before importing it",
+		Long: `Parse a CSV, XLSX, or TBX glossary file locally and report the problems
+that would make "glossaries import" fail or import it wrongly.
+
+Reported errors are duplicate terms within a locale, duplicate entry UIDs,
+entries without terms, text which is not valid UTF-8, malformed files, and
+TBX elements which do not match the v2 (<martif>) or v3 (<tbx>) structure
+declared by the root element. Entries with an empty definition and ignored
+columns are reported as warnings. Each issue names the CSV line, XLSX row,
+or TBX line it was found on.
+
+With --glossary the locale columns of the file are also checked against the
+locales of that glossary; without it the file is checked offline and no
+credentials are needed.
+
+The command exits with code 2 when the file has errors.`,
+		Example: `
+# Check a file offline
+
+  smartling-cli glossaries validate ./terms.csv
+
+# Check a file against the locales of a glossary
+
+  smartling-cli glossaries validate ./terms.tbx --glossary "CLI glossary"
+
+# List the issues as JSON
+
+  smartling-cli glossaries validate ./terms.xlsx --output json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, glossary, mediaType, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve validate params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	validateCmd.Flags().StringVar(&glossary, glossaryFlag, "", "UID or name of the glossary whose locales the file must use.")
+	validateCmd.Flags().StringVar(&mediaType, mediaTypeFlag, "", `Override the media type. Must be one of "text/csv", "text/xml", or "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet". By default derived from the file extension.`)
+
+	return validateCmd
+}
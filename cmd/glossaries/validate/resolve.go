@@ -0,0 +1,44 @@
+package glvalidate
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+func resolveParams(cmd *cobra.Command, glossaryUIDOrName, mediaType, path string) (srv.ValidateParams, error) {
+	rlog.Debugf("resolving validate params")
+
+	format := glossaryfile.FormatFromPath(path)
+	if mediaType != "" {
+		format = glossaryfile.FormatFromMediaType(mediaType)
+	}
+	params := srv.ValidateParams{
+		GlossaryUIDOrName: glossaryUIDOrName,
+		Path:              path,
+		Format:            format,
+	}
+	if glossaryUIDOrName == "" {
+		return params, nil
+	}
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.ValidateParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	params.AccountUID, err = resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), cnf.AccountID)
+	if err != nil {
+		return srv.ValidateParams{}, err
+	}
+	return params, nil
+}
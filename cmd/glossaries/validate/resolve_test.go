@@ -0,0 +1,110 @@
+package glvalidate
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"github.com/spf13/cobra"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	m.Run()
+}
+
+func makeCmd(t *testing.T, configPath, accountFlag string) *cobra.Command {
+	t.Helper()
+	root := rootcmd.NewRootCmd()
+	validateCmd := NewValidateCmd(nil)
+	root.AddCommand(validateCmd)
+	_ = root.PersistentFlags().Set("config", configPath)
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+	if accountFlag != "" {
+		_ = root.PersistentFlags().Set("account", accountFlag)
+	}
+	return validateCmd
+}
+
+func Test_resolveParams(t *testing.T) {
+	noConfigPath := filepath.Join(t.TempDir(), "no-smartling.yml")
+
+	tests := []struct {
+		name      string
+		setup     func(t *testing.T) *cobra.Command
+		glossary  string
+		mediaType string
+		path      string
+		want      srv.ValidateParams
+		wantErr   bool
+	}{
+		{
+			name:  "offline — no account or credentials needed",
+			setup: func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "") },
+			path:  "terms.XLSX",
+			want:  srv.ValidateParams{Path: "terms.XLSX", Format: glossaryfile.FormatXLSX},
+		},
+		{
+			name:      "--media-type overrides the extension",
+			setup:     func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "") },
+			mediaType: "text/xml",
+			path:      "terms.dat",
+			want:      srv.ValidateParams{Path: "terms.dat", Format: glossaryfile.FormatTBX},
+		},
+		{
+			name:  "unknown extension leaves the format empty",
+			setup: func(t *testing.T) *cobra.Command { return makeCmd(t, noConfigPath, "") },
+			path:  "terms.dat",
+			want:  srv.ValidateParams{Path: "terms.dat"},
+		},
+		{
+			name: "--glossary with account from config file",
+			setup: func(t *testing.T) *cobra.Command {
+				t.Setenv("SMARTLING_USER_ID", "test-user")
+				t.Setenv("SMARTLING_SECRET", "test-secret")
+				cfgPath := filepath.Join(t.TempDir(), "smartling.yml")
+				if err := os.WriteFile(cfgPath, []byte("account_id: config-account-uid\n"), 0o600); err != nil {
+					t.Fatalf("write config: %v", err)
+				}
+				return makeCmd(t, cfgPath, "")
+			},
+			glossary: "CLI glossary",
+			path:     "terms.csv",
+			want: srv.ValidateParams{
+				AccountUID:        uid.AccountUID("config-account-uid"),
+				GlossaryUIDOrName: "CLI glossary",
+				Path:              "terms.csv",
+				Format:            glossaryfile.FormatCSV,
+			},
+		},
+		{
+			name: "--glossary without account — error",
+			setup: func(t *testing.T) *cobra.Command {
+				t.Setenv("SMARTLING_USER_ID", "test-user")
+				t.Setenv("SMARTLING_SECRET", "test-secret")
+				return makeCmd(t, noConfigPath, "")
+			},
+			glossary: "CLI glossary",
+			path:     "terms.csv",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveParams(tt.setup(t), tt.glossary, tt.mediaType, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveParams() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveParams() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
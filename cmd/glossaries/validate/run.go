@@ -0,0 +1,54 @@
+package glvalidate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	srv "github.com/Smartling/smartling-cli/services/glossary"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+)
+
+func run(ctx context.Context,
+	initializer glossariescmd.SrvInitializer,
+	params srv.ValidateParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running glossary validate with params: %v", params)
+
+	// Offline validation never calls the API, so it must not require
+	// credentials either.
+	glossarySrv := srv.NewService(nil, nil)
+	if params.GlossaryUIDOrName != "" {
+		var err error
+		glossarySrv, err = initializer.InitGlossarySrv(ctx)
+		if err != nil {
+			return clierror.UIError{
+				Operation:   "init",
+				Err:         err,
+				Description: "unable to initialize Glossary service",
+			}
+		}
+	}
+
+	validateOutput, err := glossarySrv.RunValidate(ctx, params)
+	if validateOutput.JSON != nil {
+		outputFormat := static.GetOutputFormat[srv.ValidateOutput](outputParams.Format)
+		outputFormat.FormatAndRender(validateOutput)
+		return clierror.Rendered(err)
+	}
+	if errors.Is(err, glossaryapi.ErrGlossaryNotFound) {
+		return clierror.UIError{
+			Operation:   "find glossary",
+			Err:         err,
+			Description: fmt.Sprintf("no glossary found for %q", params.GlossaryUIDOrName),
+		}
+	}
+	return err
+}
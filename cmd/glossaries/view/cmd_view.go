@@ -0,0 +1,48 @@
+package glview
+
+import (
+	"fmt"
+
+	glossariescmd "github.com/Smartling/smartling-cli/cmd/glossaries"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewViewCmd builds the `glossaries view` command.
+func NewViewCmd(initializer glossariescmd.SrvInitializer) *cobra.Command {
+	viewCmd := &cobra.Command{
+		Use:   "view <glossaryUID|glossaryName>",
+		Short: "Show full details of a glossary",
+		Long: `Show the metadata of a glossary: name, description, locales, fallback
+locales, verification mode, whether it is archived, and the number of
+active and archived entries.`,
+		Example: `
+# View a glossary by name
+
+  smartling-cli glossaries view "CLI glossary"
+
+# View a glossary by UID as JSON
+
+  smartling-cli glossaries view <glossaryUID> --output json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(cmd, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve view params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			outputParams := output.Params{Format: format}
+			return run(ctx, initializer, params, outputParams)
+		},
+	}
+
+	return viewCmd
+}
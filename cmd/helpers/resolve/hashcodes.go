@@ -0,0 +1,34 @@
+package resolve
+
+import (
+	"slices"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/reader"
+)
+
+// Hashcodes returns the --hashcode values followed by the hashcodes read from
+// the --hashcodes-from file ("-" for stdin), without duplicates.
+func Hashcodes(hashcodes []string, from string) ([]string, error) {
+	if from == "" {
+		return hashcodes, nil
+	}
+	read, err := reader.ReadHashcodesFrom(from)
+	if err != nil {
+		return nil, clierror.UIError{
+			Operation:   "read hashcodes",
+			Err:         err,
+			Description: "--hashcodes-from accepts hashcodes separated by newlines or commas, CSV with a hashcode column, or JSON",
+		}
+	}
+	seen := make(map[string]bool, len(hashcodes)+len(read))
+	result := make([]string, 0, len(hashcodes)+len(read))
+	for _, hashcode := range slices.Concat(hashcodes, read) {
+		if seen[hashcode] {
+			continue
+		}
+		seen[hashcode] = true
+		result = append(result, hashcode)
+	}
+	return result, nil
+}
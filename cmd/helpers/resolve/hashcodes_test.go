@@ -0,0 +1,26 @@
+package resolve
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashcodes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hashcodes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("h2\nh3\n"), 0o600))
+
+	got, err := Hashcodes([]string{"h1", "h2"}, path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"h1", "h2", "h3"}, got)
+
+	got, err = Hashcodes([]string{"h1"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"h1"}, got)
+
+	_, err = Hashcodes(nil, filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
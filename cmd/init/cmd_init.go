@@ -4,6 +4,7 @@ import (
 	"os"
 
 	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/client"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
@@ -72,12 +73,12 @@ Default config values can be passed via following options:` +
 			s, err := srvInitializer.InitSrv()
 			if err != nil {
 				rlog.Errorf("failed to get init service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			err = s.RunInit(cmd.Context(), dryRun)
 			if err != nil {
 				rlog.Errorf("failed to run init: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
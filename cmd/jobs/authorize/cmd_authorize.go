@@ -0,0 +1,54 @@
+package jobauthorize
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	srv "github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	"github.com/spf13/cobra"
+)
+
+// NewAuthorizeCmd builds the `jobs authorize` command.
+func NewAuthorizeCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	var locales []string
+	authorizeCmd := &cobra.Command{
+		Use:   "authorize <translationJobUid|translationJobName>",
+		Short: "Authorize a translation job.",
+		Long: `Authorize a translation job, identified by UID or name, so that translation
+can start. By default all target locales of the job are authorized; use
+--locale to authorize only some of them.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Authorize all locales of a job
+
+  smartling-cli jobs authorize aabbccdd1122
+
+# Authorize only French and German
+
+  smartling-cli jobs authorize "Website Q1 2026" --locale fr-FR --locale de-DE
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cnf, err := rootcmd.Config()
+			if err != nil {
+				return err
+			}
+
+			params := srv.AuthorizeParams{
+				JobParams: srv.JobParams{ProjectID: cnf.ProjectID, JobUIDOrName: args[0]},
+				LocaleIDs: locales,
+			}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	authorizeCmd.Flags().StringArrayVarP(&locales, "locale", "l", nil, "Target locale to authorize (repeatable; default all job locales).")
+
+	return authorizeCmd
+}
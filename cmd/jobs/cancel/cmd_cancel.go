@@ -0,0 +1,49 @@
+package jobcancel
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	srv "github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCancelCmd builds the `jobs cancel` command.
+func NewCancelCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	var reason string
+	cancelCmd := &cobra.Command{
+		Use:   "cancel <translationJobUid|translationJobName>",
+		Short: "Cancel a translation job.",
+		Long: `Cancel a translation job, identified by UID or name. The optional --reason
+is stored with the job.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Cancel a job with a reason
+
+  smartling-cli jobs cancel aabbccdd1122 --reason "Content was withdrawn"
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cnf, err := rootcmd.Config()
+			if err != nil {
+				return err
+			}
+
+			params := srv.CancelParams{
+				JobParams: srv.JobParams{ProjectID: cnf.ProjectID, JobUIDOrName: args[0]},
+				Reason:    reason,
+			}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	cancelCmd.Flags().StringVar(&reason, "reason", "", "Reason for cancelling the job.")
+
+	return cancelCmd
+}
@@ -0,0 +1,42 @@
+package jobclose
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	srv "github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCloseCmd builds the `jobs close` command.
+func NewCloseCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	closeCmd := &cobra.Command{
+		Use:   "close <translationJobUid|translationJobName>",
+		Short: "Close a completed translation job.",
+		Long:  `Close a completed translation job, identified by UID or name.`,
+		Args:  cobra.ExactArgs(1),
+		Example: `
+# Close a job by name
+
+  smartling-cli jobs close "Website Q1 2026"
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cnf, err := rootcmd.Config()
+			if err != nil {
+				return err
+			}
+
+			params := srv.JobParams{ProjectID: cnf.ProjectID, JobUIDOrName: args[0]}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	return closeCmd
+}
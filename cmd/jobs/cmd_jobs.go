@@ -19,6 +19,7 @@ var (
 	allowedOutputs = []string{
 		"table",
 		"json",
+		"csv",
 		"simple",
 	}
 	joinedAllowedOutputs = strings.Join(allowedOutputs, ", ")
@@ -43,7 +44,8 @@ Available options:
   --output string   Output format: ` + joinedAllowedOutputs + ` (default "simple")
                     - simple: Human-readable format optimized for terminal display
                     - table: ASCII table with one row per job/file
-                    - json: Raw API response for programmatic processing and automation`,
+                    - json: Raw API response for programmatic processing and automation
+                    - csv: Comma-separated rows for spreadsheets and reporting tools`,
 		Example: `
 # View job progress in human-readable format
 
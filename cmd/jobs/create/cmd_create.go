@@ -0,0 +1,60 @@
+package jobcreate
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCreateCmd builds the `jobs create` command.
+func NewCreateCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create <jobName>",
+		Short: "Create a translation job.",
+		Long: `Create a new translation job in the project. The job is created without
+files; use "jobs files add" or "files push --job" to add content.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Create a job for two locales due at the end of the quarter
+
+  smartling-cli jobs create "Website Q1 2026" --target-locale fr-FR --target-locale de-DE --due 2026-03-31T17:00:00Z
+
+# Create a job with a reference number and a custom field
+
+  smartling-cli jobs create "Release 42" --reference-number JIRA-42 --custom-field abcd1234efgh=web
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cnf, err := rootcmd.Config()
+			if err != nil {
+				return err
+			}
+
+			params, err := resolveParams(cmd, cnf.ProjectID, args[0])
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	registerCreateFlags(createCmd)
+
+	return createCmd
+}
+
+func registerCreateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(descriptionFlag, "", "Job description.")
+	cmd.Flags().String(dueFlag, "", "Due date in RFC3339 format, e.g. 2026-03-31T17:00:00Z.")
+	cmd.Flags().StringArrayP(targetLocaleFlag, "l", nil, "Target locale of the job (repeatable).")
+	cmd.Flags().String(referenceNumberFlag, "", "Reference number, e.g. an issue tracker key.")
+	cmd.Flags().String(callbackURLFlag, "", "URL called by Smartling when the job is completed.")
+	cmd.Flags().StringArray(customFieldFlag, nil, "Custom field value as <fieldUid>=<value> (repeatable).")
+}
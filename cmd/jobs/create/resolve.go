@@ -0,0 +1,44 @@
+package jobcreate
+
+import (
+	"fmt"
+
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	"github.com/Smartling/smartling-cli/services/helpers"
+	srv "github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	descriptionFlag     = "description"
+	dueFlag             = "due"
+	targetLocaleFlag    = "target-locale"
+	referenceNumberFlag = "reference-number"
+	callbackURLFlag     = "callback-url"
+	customFieldFlag     = "custom-field"
+)
+
+// resolveParams resolves jobs-create params from flags with an env-var
+// fallback (flag → env).
+func resolveParams(cmd *cobra.Command, projectID, jobName string) (srv.CreateParams, error) {
+	due, err := resolve.FallbackDate(cmd, dueFlag, "")
+	if err != nil {
+		return srv.CreateParams{}, err
+	}
+	customFields, err := helpers.MKeyValueToMap(resolve.FallbackStringArray(cmd, customFieldFlag, nil))
+	if err != nil {
+		return srv.CreateParams{}, fmt.Errorf("invalid --%s: %w", customFieldFlag, err)
+	}
+
+	return srv.CreateParams{
+		ProjectID:       projectID,
+		JobName:         jobName,
+		Description:     resolve.FallbackString(cmd.Flags().Lookup(descriptionFlag), resolve.StringParam{FlagName: descriptionFlag}),
+		DueDate:         due,
+		TargetLocaleIDs: resolve.FallbackStringArray(cmd, targetLocaleFlag, nil),
+		ReferenceNumber: resolve.FallbackString(cmd.Flags().Lookup(referenceNumberFlag), resolve.StringParam{FlagName: referenceNumberFlag}),
+		CallbackURL:     resolve.FallbackString(cmd.Flags().Lookup(callbackURLFlag), resolve.StringParam{FlagName: callbackURLFlag}),
+		CustomFields:    customFields,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package jobcreate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveParams(t *testing.T) {
+	cmd := newTestCmd()
+	require.NoError(t, cmd.Flags().Set("due", "2026-03-31T17:00:00Z"))
+	require.NoError(t, cmd.Flags().Set("target-locale", "fr-FR"))
+	require.NoError(t, cmd.Flags().Set("target-locale", "de-DE"))
+	require.NoError(t, cmd.Flags().Set("custom-field", "abcd1234efgh=web"))
+
+	params, err := resolveParams(cmd, "proj-1", "Release")
+	require.NoError(t, err)
+	require.Equal(t, "proj-1", params.ProjectID)
+	require.Equal(t, "Release", params.JobName)
+	require.Equal(t, time.Date(2026, 3, 31, 17, 0, 0, 0, time.UTC), params.DueDate)
+	require.Equal(t, []string{"fr-FR", "de-DE"}, params.TargetLocaleIDs)
+	require.Equal(t, map[string]string{"abcd1234efgh": "web"}, params.CustomFields)
+}
+
+func TestResolveParams_InvalidDue(t *testing.T) {
+	cmd := newTestCmd()
+	require.NoError(t, cmd.Flags().Set("due", "tomorrow"))
+
+	_, err := resolveParams(cmd, "proj-1", "Release")
+	require.ErrorContains(t, err, "invalid --due")
+}
+
+// newTestCmd builds a command carrying the same flags as the real one so
+// resolveParams can read them.
+func newTestCmd() *cobra.Command {
+	c := &cobra.Command{Use: "create", RunE: func(*cobra.Command, []string) error { return nil }}
+	registerCreateFlags(c)
+	return c
+}
@@ -0,0 +1,42 @@
+package jobcreate
+
+import (
+	"context"
+	"fmt"
+
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	"github.com/Smartling/smartling-cli/services/jobs"
+	srv "github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+)
+
+func run(ctx context.Context,
+	initializer jobscmd.SrvInitializer,
+	params srv.CreateParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running jobs create with params: %v", params)
+	lifecycleSrv, err := initializer.InitLifecycleSrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Jobs service",
+		}
+	}
+
+	out, err := lifecycleSrv.RunCreate(ctx, params)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "create job",
+			Err:         err,
+			Description: fmt.Sprintf("unable to create job %q", params.JobName),
+		}
+	}
+
+	static.GetOutputFormat[jobs.ViewOutput](outputParams.Format).FormatAndRender(out)
+	return nil
+}
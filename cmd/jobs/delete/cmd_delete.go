@@ -0,0 +1,42 @@
+package jobdelete
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	srv "github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd builds the `jobs delete` command.
+func NewDeleteCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	deleteCmd := &cobra.Command{
+		Use:   "delete <translationJobUid|translationJobName>",
+		Short: "Delete a translation job.",
+		Long:  `Delete a translation job, identified by UID or name.`,
+		Args:  cobra.ExactArgs(1),
+		Example: `
+# Delete a job by UID
+
+  smartling-cli jobs delete aabbccdd1122
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cnf, err := rootcmd.Config()
+			if err != nil {
+				return err
+			}
+
+			params := srv.JobParams{ProjectID: cnf.ProjectID, JobUIDOrName: args[0]}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	return deleteCmd
+}
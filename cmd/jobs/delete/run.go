@@ -0,0 +1,47 @@
+package jobdelete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	srv "github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+)
+
+func run(ctx context.Context,
+	initializer jobscmd.SrvInitializer,
+	params srv.JobParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running jobs delete with params: %v", params)
+	lifecycleSrv, err := initializer.InitLifecycleSrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Jobs service",
+		}
+	}
+
+	out, err := lifecycleSrv.RunDelete(ctx, params)
+	if err != nil {
+		if errors.Is(err, jobapi.ErrNotFound) {
+			return clierror.UIError{
+				Operation:   "find job",
+				Err:         err,
+				Description: fmt.Sprintf("no job found for %q", params.JobUIDOrName),
+			}
+		}
+		return err
+	}
+
+	static.GetOutputFormat[srv.Output](outputParams.Format).FormatAndRender(out)
+	return nil
+}
@@ -0,0 +1,57 @@
+package jobexport
+
+import (
+	"fmt"
+	"os"
+
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+const outFlag = "out"
+
+// NewExportCmd builds the `jobs export` command.
+func NewExportCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	var out string
+	exportCmd := &cobra.Command{
+		Use:   "export <translationJobUid|translationJobName> --out <file>",
+		Short: "Snapshot which locales, files and strings belong to a job.",
+		Long: `Capture the membership of a translation job into a JSON file: the job's
+metadata and target locales, its source files with their locales, and its
+strings by hashcode and locale.
+
+Restore the snapshot later, or into another job, with "jobs import".
+Use "--out -" to write the snapshot to stdout.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Snapshot a job before a big refactor
+
+  smartling-cli jobs export "Website Q1 2026" --out job.json
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resolve export params: %w", err)
+			}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, out, output.Params{Format: format})
+		},
+	}
+
+	exportCmd.Flags().StringVar(&out, outFlag, "", `<file|->
+File to write the snapshot to (required).`)
+	if err := exportCmd.MarkFlagRequired(outFlag); err != nil {
+		rlog.Errorf("failed to mark --%s required: %s", outFlag, err)
+		os.Exit(1)
+	}
+
+	return exportCmd
+}
@@ -0,0 +1,26 @@
+package jobexport
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	srv "github.com/Smartling/smartling-cli/services/jobs/checkpoint"
+)
+
+func resolveParams(jobUIDOrName string) (srv.ExportParams, error) {
+	rlog.Debugf("resolving export params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.ExportParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	return srv.ExportParams{
+		ProjectID:    cnf.ProjectID,
+		JobUIDOrName: jobUIDOrName,
+	}, nil
+}
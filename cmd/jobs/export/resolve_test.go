@@ -0,0 +1,37 @@
+package jobexport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	os.Exit(m.Run())
+}
+
+func Test_resolveParams(t *testing.T) {
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+
+	root := rootcmd.NewRootCmd()
+	cfgPath := filepath.Join(t.TempDir(), "smartling.yml")
+	if err := os.WriteFile(cfgPath, []byte("project_id: config-project-id\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if err := root.PersistentFlags().Set("config", cfgPath); err != nil {
+		t.Fatalf("set config flag: %v", err)
+	}
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+
+	params, err := resolveParams("Website Q1 2026")
+	if err != nil {
+		t.Fatalf("resolveParams() error = %v", err)
+	}
+	if params.ProjectID != "config-project-id" || params.JobUIDOrName != "Website Q1 2026" {
+		t.Errorf("resolveParams() = %+v, want project from config and job from input", params)
+	}
+}
@@ -0,0 +1,60 @@
+package jobexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	srv "github.com/Smartling/smartling-cli/services/jobs/checkpoint"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+)
+
+func run(ctx context.Context,
+	initializer jobscmd.SrvInitializer,
+	params srv.ExportParams,
+	out string,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running jobs export with params: %v", params)
+	checkpointSrv, err := initializer.InitCheckpointSrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Jobs service",
+		}
+	}
+
+	snapshot, err := checkpointSrv.RunExport(ctx, params)
+	if err != nil {
+		if errors.Is(err, jobapi.ErrNotFound) {
+			return clierror.UIError{
+				Operation:   "find job",
+				Err:         err,
+				Description: fmt.Sprintf("no job found for %q", params.JobUIDOrName),
+			}
+		}
+		return clierror.UIError{
+			Operation:   "export job",
+			Err:         err,
+			Description: "unable to read job membership",
+		}
+	}
+	if err := snapshot.Write(out); err != nil {
+		return clierror.UIError{
+			Operation:   "write snapshot",
+			Err:         err,
+			Description: fmt.Sprintf("unable to write %s", out),
+		}
+	}
+	if out != "-" {
+		static.GetOutputFormat[srv.Snapshot](outputParams.Format).FormatAndRender(snapshot)
+	}
+	return nil
+}
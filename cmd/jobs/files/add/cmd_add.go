@@ -21,6 +21,7 @@ func NewJobFilesAddCmd(initializer filescmd.SrvInitializer) *cobra.Command {
 	var (
 		filePatterns  []string
 		targetLocales []string
+		reportFile    string
 	)
 	addCmd := &cobra.Command{
 		Use:   "add <translationJobUid|translationJobName>",
@@ -47,12 +48,13 @@ func NewJobFilesAddCmd(initializer filescmd.SrvInitializer) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return run(ctx, initializer, params, output.Params{Format: format})
+			return run(ctx, initializer, params, output.Params{Format: format}, reportFile)
 		},
 	}
 
 	addCmd.Flags().StringArrayVar(&filePatterns, fileFlag, nil, "File URI glob pattern to add (repeatable, required).")
 	addCmd.Flags().StringArrayVar(&targetLocales, targetLocaleFlag, nil, "Target locale to add the files to (repeatable; default all job locales).")
+	addCmd.Flags().StringVar(&reportFile, "report", "", "Write per-file results as JSON to specified file.")
 	if err := addCmd.MarkFlagRequired(fileFlag); err != nil {
 		rlog.Errorf("failed to mark --%s required: %s", fileFlag, err)
 		os.Exit(1)
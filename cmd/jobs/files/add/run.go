@@ -20,6 +20,7 @@ func run(ctx context.Context,
 	initializer filescmd.SrvInitializer,
 	params srv.AddParams,
 	outputParams output.Params,
+	reportFile string,
 ) error {
 	rlog.Debugf("running jobs files add with params: %v", params)
 	filesSrv, err := initializer.InitJobFilesSrv(ctx)
@@ -45,6 +46,11 @@ func run(ctx context.Context,
 
 	static.GetOutputFormat[srv.MutateOutput](outputParams.Format).FormatAndRender(addOutput)
 
+	report := addOutput.Report()
+	if err := report.WriteFile(reportFile); err != nil {
+		return clierror.Rendered(err)
+	}
+
 	failed := addOutput.FailedFileURIs()
 	if len(failed) > 0 || len(addOutput.Unmatched) > 0 {
 		var parts []string
@@ -54,11 +60,11 @@ func run(ctx context.Context,
 		if len(addOutput.Unmatched) > 0 {
 			parts = append(parts, fmt.Sprintf("no files matched: %s", strings.Join(addOutput.Unmatched, ", ")))
 		}
-		return clierror.UIError{
+		return clierror.Rendered(clierror.UIError{
 			Operation:   "add files",
-			Err:         errors.New("some --file patterns did not fully apply"),
+			Err:         errors.Join(errors.New("some --file patterns did not fully apply"), report.Err()),
 			Description: strings.Join(parts, "; "),
-		}
+		})
 	}
 	return nil
 }
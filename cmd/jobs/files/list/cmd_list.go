@@ -14,13 +14,17 @@ func NewListCmd(initializer filescmd.SrvInitializer) *cobra.Command {
 	var (
 		limit  uint32
 		offset uint32
+		all    bool
 	)
 
 	filesCmd := &cobra.Command{
 		Use:   "list <translationJobUid|translationJobName>",
 		Short: "List source files attached to a translation job.",
-		Long:  `List the source files attached to a translation job, by UID or name.`,
-		Args:  cobra.ExactArgs(1),
+		Long: `List the source files attached to a translation job, by UID or name.
+
+With --all every page is fetched, a few pages at a time; --limit then sets the
+page size. JSON output is streamed as one file per line (NDJSON).`,
+		Args: cobra.ExactArgs(1),
 		Example: `
 # List files for a job by UID
 
@@ -29,6 +33,10 @@ func NewListCmd(initializer filescmd.SrvInitializer) *cobra.Command {
 # List files for a job by name in JSON
 
   smartling-cli jobs files list "Website Q1 2026" --output json
+
+# Stream all files of a large job as NDJSON
+
+  smartling-cli jobs files list aabbccdd1122 --all --output json
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -48,12 +56,14 @@ func NewListCmd(initializer filescmd.SrvInitializer) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return run(ctx, initializer, params, output.Params{Format: format})
+			return run(ctx, initializer, params, all, output.Params{Format: format})
 		},
 	}
 
 	filesCmd.Flags().Uint32Var(&limit, "limit", srv.DefaultListPageLimit, "Maximum number of files to return.")
 	filesCmd.Flags().Uint32Var(&offset, "offset", 0, "Offset for pagination.")
+	filesCmd.Flags().BoolVar(&all, "all", false, "Fetch every page; --limit sets the page size.")
+	filesCmd.MarkFlagsMutuallyExclusive("all", "offset")
 
 	return filesCmd
 }
@@ -15,7 +15,10 @@ const fileFlag = "file"
 
 // NewJobFilesRemoveCmd returns new command to job file remove
 func NewJobFilesRemoveCmd(initializer filescmd.SrvInitializer) *cobra.Command {
-	var filePatterns []string
+	var (
+		filePatterns []string
+		reportFile   string
+	)
 	removeCmd := &cobra.Command{
 		Use:   "remove <translationJobUid|translationJobName>",
 		Short: "Remove files from a translation job.",
@@ -41,11 +44,12 @@ func NewJobFilesRemoveCmd(initializer filescmd.SrvInitializer) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return run(ctx, initializer, params, output.Params{Format: format})
+			return run(ctx, initializer, params, output.Params{Format: format}, reportFile)
 		},
 	}
 
 	removeCmd.Flags().StringArrayVar(&filePatterns, fileFlag, nil, "File URI glob pattern to remove (repeatable, required).")
+	removeCmd.Flags().StringVar(&reportFile, "report", "", "Write per-file results as JSON to specified file.")
 	if err := removeCmd.MarkFlagRequired(fileFlag); err != nil {
 		rlog.Errorf("failed to mark --%s required: %s", fileFlag, err)
 		os.Exit(1)
@@ -2,31 +2,34 @@ package findbystrings
 
 import (
 	"fmt"
-	"os"
 
 	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
 	"github.com/Smartling/smartling-cli/output"
-	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	"github.com/spf13/cobra"
 )
 
 const (
-	hashcodeFlag = "hashcode"
-	localeFlag   = "locale"
+	hashcodeFlag      = "hashcode"
+	hashcodesFromFlag = "hashcodes-from"
+	localeFlag        = "locale"
 )
 
 // NewFindByStringsCmd builds the `jobs find-by-strings` command.
 func NewFindByStringsCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
 	var (
-		hashcodes []string
-		locales   []string
+		hashcodes     []string
+		hashcodesFrom string
+		locales       []string
 	)
 	findCmd := &cobra.Command{
 		Use:   "find-by-strings",
 		Short: "Find jobs that contain specific strings in specific locales.",
 		Long: `Find the translation jobs that contain the given strings (by hashcode) in
-the given locales. Results are reported as one row per hashcode+locale match.`,
+the given locales. Results are reported as one row per hashcode+locale match.
+
+Long hashcode lists are split into requests of at most 20000 hashcode×locale
+records and the results are merged.`,
 		Example: `
 # Find jobs containing two strings
 
@@ -35,12 +38,16 @@ the given locales. Results are reported as one row per hashcode+locale match.`,
 # Restrict the search to specific locales
 
   smartling-cli jobs find-by-strings --hashcode h1 --locale fr-FR --locale de-DE
+
+# Find jobs for the strings of another job's JSON listing
+
+  smartling-cli jobs strings list aabbccdd1122 --all --output json | smartling-cli jobs find-by-strings --hashcodes-from -
 `,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			params, err := resolveParams(hashcodes, locales)
+			params, err := resolveParams(hashcodes, hashcodesFrom, locales)
 			if err != nil {
 				return fmt.Errorf("failed to resolve find-by-strings params: %w", err)
 			}
@@ -53,12 +60,13 @@ the given locales. Results are reported as one row per hashcode+locale match.`,
 		},
 	}
 
-	findCmd.Flags().StringArrayVar(&hashcodes, hashcodeFlag, nil, "String hashcode to search for (repeatable, required).")
+	findCmd.Flags().StringArrayVar(&hashcodes, hashcodeFlag, nil, "String hashcode to search for (repeatable).")
 	findCmd.Flags().StringArrayVar(&locales, localeFlag, nil, "Locale to restrict the search to (repeatable; default all locales).")
-	if err := findCmd.MarkFlagRequired(hashcodeFlag); err != nil {
-		rlog.Errorf("failed to mark --%s required: %s", hashcodeFlag, err)
-		os.Exit(1)
-	}
+	findCmd.Flags().StringVar(&hashcodesFrom, hashcodesFromFlag, "", `<file|->
+Read hashcodes from a file, or stdin with "-": separated by newlines or commas,
+CSV with a "hashcode" column, or JSON such as "jobs strings list" output.
+Combines with --hashcode.`)
+	findCmd.MarkFlagsOneRequired(hashcodeFlag, hashcodesFromFlag)
 
 	return findCmd
 }
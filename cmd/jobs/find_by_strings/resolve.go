@@ -2,12 +2,13 @@ package findbystrings
 
 import (
 	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
 	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 	srv "github.com/Smartling/smartling-cli/services/jobs"
 )
 
-func resolveParams(hashcodes, locales []string) (srv.FindByStringsParams, error) {
+func resolveParams(hashcodes []string, hashcodesFrom string, locales []string) (srv.FindByStringsParams, error) {
 	rlog.Debugf("resolving find-by-strings params")
 
 	cnf, err := rootcmd.Config()
@@ -18,6 +19,10 @@ func resolveParams(hashcodes, locales []string) (srv.FindByStringsParams, error)
 			Description: "failed to read config",
 		}
 	}
+	hashcodes, err = resolve.Hashcodes(hashcodes, hashcodesFrom)
+	if err != nil {
+		return srv.FindByStringsParams{}, err
+	}
 
 	return srv.FindByStringsParams{
 		ProjectUID: cnf.ProjectID,
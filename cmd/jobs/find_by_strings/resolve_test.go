@@ -34,7 +34,7 @@ func Test_resolveParams(t *testing.T) {
 	}
 	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
 
-	params, err := resolveParams([]string{"h1", "h2"}, []string{"fr-FR"})
+	params, err := resolveParams([]string{"h1", "h2"}, "", []string{"fr-FR"})
 	if err != nil {
 		t.Fatalf("resolveParams() error = %v", err)
 	}
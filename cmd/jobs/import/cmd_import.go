@@ -0,0 +1,69 @@
+package jobimport
+
+import (
+	"fmt"
+
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	intoFlag   = "into"
+	newJobFlag = "new-job"
+)
+
+// NewImportCmd builds the `jobs import` command.
+func NewImportCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	var (
+		into   string
+		newJob string
+	)
+	importCmd := &cobra.Command{
+		Use:   "import <file|-> [--into <job> | --new-job <name>]",
+		Short: "Restore job membership from a snapshot made by \"jobs export\".",
+		Long: `Replay a snapshot made by "jobs export" into a translation job: missing
+target locales are added first, then the files with their locales, then the
+strings which did not arrive with their files.
+
+By default the snapshot is restored into the job it was exported from.
+Use --into to restore it into another existing job, or --new-job to create a
+job with the snapshot's description, reference number, locales and (future)
+due date.
+
+The target job is listed again afterwards and a reconciliation report shows,
+per locales, files and strings, how many snapshot items were restored, which
+are missing (for example files deleted from the project) and which the job
+has beyond the snapshot. Missing items exit with the partial failure code.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Restore a job after a refactor
+
+  smartling-cli jobs import job.json
+
+# Restore into a new job and review the report as JSON
+
+  smartling-cli jobs import job.json --new-job "Website Q1 2026 (restored)" --output json
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(args[0], into, newJob)
+			if err != nil {
+				return fmt.Errorf("failed to resolve import params: %w", err)
+			}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	importCmd.Flags().StringVar(&into, intoFlag, "", "Existing job UID or name to import into (default the snapshot's job).")
+	importCmd.Flags().StringVar(&newJob, newJobFlag, "", "Name of a new job to create from the snapshot's metadata and import into.")
+	importCmd.MarkFlagsMutuallyExclusive(intoFlag, newJobFlag)
+
+	return importCmd
+}
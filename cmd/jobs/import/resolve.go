@@ -0,0 +1,36 @@
+package jobimport
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	srv "github.com/Smartling/smartling-cli/services/jobs/checkpoint"
+)
+
+func resolveParams(path, into, newJob string) (srv.ImportParams, error) {
+	rlog.Debugf("resolving import params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return srv.ImportParams{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+	snapshot, err := srv.ReadSnapshot(path)
+	if err != nil {
+		return srv.ImportParams{}, clierror.UIError{
+			Operation:   "read snapshot",
+			Err:         err,
+			Description: `expected a file written by "jobs export"`,
+		}
+	}
+
+	return srv.ImportParams{
+		ProjectID:        cnf.ProjectID,
+		Snapshot:         snapshot,
+		IntoJobUIDOrName: into,
+		NewJobName:       newJob,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package jobimport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	os.Exit(m.Run())
+}
+
+func Test_resolveParams(t *testing.T) {
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+
+	root := rootcmd.NewRootCmd()
+	cfgPath := filepath.Join(t.TempDir(), "smartling.yml")
+	if err := os.WriteFile(cfgPath, []byte("project_id: config-project-id\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if err := root.PersistentFlags().Set("config", cfgPath); err != nil {
+		t.Fatalf("set config flag: %v", err)
+	}
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+
+	path := filepath.Join(t.TempDir(), "job.json")
+	snapshot := `{"version": 1, "projectId": "config-project-id", "job": {"translationJobUid": "aabbccdd1122"}}`
+	if err := os.WriteFile(path, []byte(snapshot), 0o600); err != nil {
+		t.Fatalf("write snapshot: %v", err)
+	}
+
+	params, err := resolveParams(path, "", "Restored")
+	if err != nil {
+		t.Fatalf("resolveParams() error = %v", err)
+	}
+	if params.ProjectID != "config-project-id" || params.NewJobName != "Restored" {
+		t.Errorf("resolveParams() = %+v, want project from config and new job from input", params)
+	}
+	if params.Snapshot.Job.TranslationJobUID != "aabbccdd1122" {
+		t.Errorf("Snapshot.Job.TranslationJobUID = %q, want aabbccdd1122", params.Snapshot.Job.TranslationJobUID)
+	}
+
+	if _, err := resolveParams(filepath.Join(t.TempDir(), "missing.json"), "", ""); err == nil {
+		t.Error("resolveParams() with a missing snapshot: want error")
+	}
+}
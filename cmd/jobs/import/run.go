@@ -0,0 +1,61 @@
+package jobimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	srv "github.com/Smartling/smartling-cli/services/jobs/checkpoint"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+)
+
+func run(ctx context.Context,
+	initializer jobscmd.SrvInitializer,
+	params srv.ImportParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running jobs import into %q/%q", params.IntoJobUIDOrName, params.NewJobName)
+	checkpointSrv, err := initializer.InitCheckpointSrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Jobs service",
+		}
+	}
+
+	out, err := checkpointSrv.RunImport(ctx, params)
+	if err != nil && out.JSON == nil {
+		if errors.Is(err, jobapi.ErrNotFound) {
+			target := params.IntoJobUIDOrName
+			if target == "" {
+				target = params.Snapshot.Job.TranslationJobUID
+			}
+			return clierror.UIError{
+				Operation:   "find job",
+				Err:         err,
+				Description: fmt.Sprintf("no job found for %q; use --into or --new-job to import elsewhere", target),
+			}
+		}
+		return clierror.UIError{
+			Operation:   "import job",
+			Err:         err,
+			Description: "unable to import the snapshot",
+		}
+	}
+	static.GetOutputFormat[srv.ImportOutput](outputParams.Format).FormatAndRender(out)
+	if err != nil {
+		return clierror.Rendered(clierror.UIError{
+			Operation:   "import job",
+			Err:         err,
+			Description: fmt.Sprintf("%d snapshot item(s) are missing from job %s", out.Missing(), out.TranslationJobUID),
+		})
+	}
+	return nil
+}
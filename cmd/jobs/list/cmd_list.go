@@ -18,7 +18,11 @@ func NewListCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
 		Short: "List translation jobs in a project or account.",
 		Long: `List jobs within the configured project (default), across all projects
 in the account (--all-projects), or search jobs containing specific files or
-string hashcodes (--file / --hashcode).`,
+string hashcodes (--file / --hashcode).
+
+With --all every page is fetched, a few pages at a time, instead of a single
+--limit/--offset window; --limit then sets the page size. JSON output is
+streamed as one job per line (NDJSON).`,
 		Example: `
 # List jobs in the current project
 
@@ -32,6 +36,10 @@ string hashcodes (--file / --hashcode).`,
 
   smartling-cli --account <accountUid> jobs list --all-projects --with-priority
 
+# Stream every job of the account as NDJSON
+
+  smartling-cli jobs list --all-projects --all --output json | jq -r .translationJobUid
+
 # Search jobs that contain a file
 
   smartling-cli jobs list --file path/to/a.json
@@ -50,11 +58,15 @@ string hashcodes (--file / --hashcode).`,
 				return fmt.Errorf("failed to resolve list params: %w", err)
 			}
 
+			all, err := cmd.Flags().GetBool(allFlag)
+			if err != nil {
+				return err
+			}
 			format, err := cmd.Flags().GetString("output")
 			if err != nil {
 				return err
 			}
-			return run(ctx, initializer, params, output.Params{Format: format})
+			return run(ctx, initializer, params, all, output.Params{Format: format})
 		},
 	}
 
@@ -77,4 +89,6 @@ func registerListFlags(cmd *cobra.Command) {
 	cmd.Flags().String(sortDirectionFlag, "", "Sort direction (asc/desc).")
 	cmd.Flags().Uint32(limitFlag, srv.DefaultListPageLimit, "Maximum number of jobs to return.")
 	cmd.Flags().Uint32(offsetFlag, 0, "Offset for pagination.")
+	cmd.Flags().Bool(allFlag, false, "Fetch every page; --limit sets the page size.")
+	cmd.MarkFlagsMutuallyExclusive(allFlag, offsetFlag)
 }
@@ -23,6 +23,7 @@ const (
 	sortDirectionFlag = "sort-direction"
 	limitFlag         = "limit"
 	offsetFlag        = "offset"
+	allFlag           = "all"
 )
 
 // resolveParams resolves jobs-list params from flags with an env-var
@@ -14,6 +14,7 @@ import (
 func run(ctx context.Context,
 	initializer jobscmd.SrvInitializer,
 	params srv.ListParams,
+	all bool,
 	outputParams output.Params,
 ) error {
 	rlog.Debugf("running jobs list with params: %v", params)
@@ -26,6 +27,10 @@ func run(ctx context.Context,
 		}
 	}
 
+	if all {
+		return runAll(ctx, jobSrv, params, outputParams)
+	}
+
 	listOutput, err := jobSrv.RunList(ctx, params)
 	if err != nil {
 		return err
@@ -35,3 +40,13 @@ func run(ctx context.Context,
 	outputFormat.FormatAndRender(listOutput)
 	return nil
 }
+
+// runAll streams every job for json and simple output, and collects them
+// for table and csv.
+func runAll(ctx context.Context, jobSrv srv.Service, params srv.ListParams, outputParams output.Params) error {
+	return static.RenderAll(outputParams.Format,
+		func(emit func(srv.JobListItem) error) error { return jobSrv.RunListAll(ctx, params, emit) },
+		func(items []srv.JobListItem) srv.ListOutput {
+			return srv.ListOutput{Jobs: items, Account: params.Account, TotalCount: len(items)}
+		})
+}
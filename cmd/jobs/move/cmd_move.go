@@ -0,0 +1,91 @@
+package move
+
+import (
+	"fmt"
+	"os"
+
+	filescmd "github.com/Smartling/smartling-cli/cmd/jobs/files"
+	stringscmd "github.com/Smartling/smartling-cli/cmd/jobs/strings"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	fromFlag         = "from"
+	toFlag           = "to"
+	fileFlag         = "file"
+	hashcodeFlag     = "hashcode"
+	targetLocaleFlag = "target-locale"
+	dryRunFlag       = "dry-run"
+)
+
+// NewMoveCmd returns new command to move files or strings between jobs
+func NewMoveCmd(filesInitializer filescmd.SrvInitializer, stringsInitializer stringscmd.SrvInitializer) *cobra.Command {
+	var (
+		from          string
+		to            string
+		filePatterns  []string
+		hashcodes     []string
+		targetLocales []string
+		dryRun        bool
+	)
+	moveCmd := &cobra.Command{
+		Use:   "move --from <job> --to <job> (--file <pattern>|--hashcode <hashcode>)",
+		Short: "Move files or strings from one translation job to another.",
+		Long: `Move content from one translation job to another, for example to split an
+urgent subset out of a big job. Jobs are identified by UID or name.
+
+With --file, each glob pattern is matched against the files of the source job;
+every matched file is removed from the source job and added to the target job
+with the locales it had (or the --target-locale ones). If a file fails to move,
+the files moved so far are returned to the source job.
+
+With --hashcode, strings found in the source job are moved to the target job
+in a single call; hashcodes which are not in the source job are reported and
+skipped. If some strings fail to move, all of them are moved back.
+
+Use --dry-run to see what would be moved without changing anything.`,
+		Args: cobra.NoArgs,
+		Example: `
+# Preview moving the checkout files into an urgent job
+
+  smartling-cli jobs move --from "Website Q1 2026" --to "Urgent fixes" --file "checkout/**" --dry-run
+
+# Move two strings, for French only
+
+  smartling-cli jobs move --from aabbccdd1122 --to eeff00112233 --hashcode h1 --hashcode h2 --target-locale fr-FR
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			params, err := resolveParams(from, to, filePatterns, hashcodes, targetLocales, dryRun)
+			if err != nil {
+				return fmt.Errorf("failed to resolve move params: %w", err)
+			}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, filesInitializer, stringsInitializer, params, output.Params{Format: format})
+		},
+	}
+
+	moveCmd.Flags().StringVar(&from, fromFlag, "", "Source job UID or name (required).")
+	moveCmd.Flags().StringVar(&to, toFlag, "", "Target job UID or name (required).")
+	moveCmd.Flags().StringArrayVar(&filePatterns, fileFlag, nil, "Glob pattern of source job files to move (repeatable).")
+	moveCmd.Flags().StringArrayVar(&hashcodes, hashcodeFlag, nil, "Hashcode of a string to move (repeatable).")
+	moveCmd.Flags().StringArrayVar(&targetLocales, targetLocaleFlag, nil, "Target locale to move the content for (repeatable; default the locales it has in the source job).")
+	moveCmd.Flags().BoolVar(&dryRun, dryRunFlag, false, "Show what would be moved without changing anything.")
+	for _, flag := range []string{fromFlag, toFlag} {
+		if err := moveCmd.MarkFlagRequired(flag); err != nil {
+			rlog.Errorf("failed to mark --%s required: %s", flag, err)
+			os.Exit(1)
+		}
+	}
+	moveCmd.MarkFlagsOneRequired(fileFlag, hashcodeFlag)
+	moveCmd.MarkFlagsMutuallyExclusive(fileFlag, hashcodeFlag)
+
+	return moveCmd
+}
@@ -0,0 +1,66 @@
+package move
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	filesrv "github.com/Smartling/smartling-cli/services/jobs/files"
+	stringsrv "github.com/Smartling/smartling-cli/services/jobs/strings"
+)
+
+// params holds the move request; exactly one of FilePatterns and Hashcodes
+// is set.
+type params struct {
+	ProjectID       string
+	From            string
+	To              string
+	FilePatterns    []string
+	Hashcodes       []string
+	TargetLocaleIDs []string
+	DryRun          bool
+}
+
+func (p params) filesParams() filesrv.MoveParams {
+	return filesrv.MoveParams{
+		ProjectID:        p.ProjectID,
+		FromJobUIDOrName: p.From,
+		ToJobUIDOrName:   p.To,
+		FilePatterns:     p.FilePatterns,
+		TargetLocaleIDs:  p.TargetLocaleIDs,
+		DryRun:           p.DryRun,
+	}
+}
+
+func (p params) stringsParams() stringsrv.MoveParams {
+	return stringsrv.MoveParams{
+		ProjectID:        p.ProjectID,
+		FromJobUIDOrName: p.From,
+		ToJobUIDOrName:   p.To,
+		Hashcodes:        p.Hashcodes,
+		TargetLocaleIDs:  p.TargetLocaleIDs,
+		DryRun:           p.DryRun,
+	}
+}
+
+func resolveParams(from, to string, filePatterns, hashcodes, targetLocales []string, dryRun bool) (params, error) {
+	rlog.Debugf("resolving move params")
+
+	cnf, err := rootcmd.Config()
+	if err != nil {
+		return params{}, clierror.UIError{
+			Operation:   "config",
+			Err:         err,
+			Description: "failed to read config",
+		}
+	}
+
+	return params{
+		ProjectID:       cnf.ProjectID,
+		From:            from,
+		To:              to,
+		FilePatterns:    filePatterns,
+		Hashcodes:       hashcodes,
+		TargetLocaleIDs: targetLocales,
+		DryRun:          dryRun,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package move
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	os.Exit(m.Run())
+}
+
+func Test_resolveParams(t *testing.T) {
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+
+	root := rootcmd.NewRootCmd()
+	cfgPath := filepath.Join(t.TempDir(), "smartling.yml")
+	if err := os.WriteFile(cfgPath, []byte("project_id: config-project-id\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if err := root.PersistentFlags().Set("config", cfgPath); err != nil {
+		t.Fatalf("set config flag: %v", err)
+	}
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+
+	p, err := resolveParams("Big job", "aabbccdd1122", []string{"**/*.json"}, nil, []string{"fr-FR"}, true)
+	if err != nil {
+		t.Fatalf("resolveParams() error = %v", err)
+	}
+	files := p.filesParams()
+	if files.ProjectID != "config-project-id" || files.FromJobUIDOrName != "Big job" || files.ToJobUIDOrName != "aabbccdd1122" {
+		t.Errorf("filesParams() = %+v, want project and jobs from input", files)
+	}
+	if !files.DryRun || len(files.FilePatterns) != 1 || len(files.TargetLocaleIDs) != 1 {
+		t.Errorf("filesParams() = %+v, want dry run with one pattern and locale", files)
+	}
+	if strs := p.stringsParams(); strs.ProjectID != "config-project-id" || !strs.DryRun {
+		t.Errorf("stringsParams() = %+v, want project from config and dry run", strs)
+	}
+}
@@ -0,0 +1,104 @@
+package move
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	filescmd "github.com/Smartling/smartling-cli/cmd/jobs/files"
+	stringscmd "github.com/Smartling/smartling-cli/cmd/jobs/strings"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	filesrv "github.com/Smartling/smartling-cli/services/jobs/files"
+	stringsrv "github.com/Smartling/smartling-cli/services/jobs/strings"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+)
+
+func run(ctx context.Context,
+	filesInitializer filescmd.SrvInitializer,
+	stringsInitializer stringscmd.SrvInitializer,
+	params params,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running jobs move with params: %v", params)
+	if len(params.FilePatterns) > 0 {
+		return runFiles(ctx, filesInitializer, params.filesParams(), outputParams)
+	}
+	return runStrings(ctx, stringsInitializer, params.stringsParams(), outputParams)
+}
+
+func runFiles(ctx context.Context, initializer filescmd.SrvInitializer, params filesrv.MoveParams, outputParams output.Params) error {
+	filesSrv, err := initializer.InitJobFilesSrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Job Files service",
+		}
+	}
+
+	moveOutput, err := filesSrv.RunMove(ctx, params)
+	if err != nil && moveOutput.JSON == nil {
+		return moveError(err, params.FromJobUIDOrName, params.ToJobUIDOrName)
+	}
+	static.GetOutputFormat[filesrv.MoveOutput](outputParams.Format).FormatAndRender(moveOutput)
+	if err != nil {
+		return clierror.Rendered(moveError(err, params.FromJobUIDOrName, params.ToJobUIDOrName))
+	}
+	if len(moveOutput.Unmatched) > 0 {
+		return clierror.Rendered(clierror.UIError{
+			Operation:   "move files",
+			Err:         errors.New("some --file patterns matched no files of the source job"),
+			Description: fmt.Sprintf("no files matched: %s", strings.Join(moveOutput.Unmatched, ", ")),
+		})
+	}
+	return nil
+}
+
+func runStrings(ctx context.Context, initializer stringscmd.SrvInitializer, params stringsrv.MoveParams, outputParams output.Params) error {
+	stringsSrv, err := initializer.InitJobStringsSrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Job Strings service",
+		}
+	}
+
+	moveOutput, err := stringsSrv.RunMove(ctx, params)
+	if err != nil && moveOutput.JSON == nil {
+		return moveError(err, params.FromJobUIDOrName, params.ToJobUIDOrName)
+	}
+	static.GetOutputFormat[stringsrv.MoveOutput](outputParams.Format).FormatAndRender(moveOutput)
+	if err != nil {
+		return clierror.Rendered(moveError(err, params.FromJobUIDOrName, params.ToJobUIDOrName))
+	}
+	return nil
+}
+
+func moveError(err error, from, to string) error {
+	if errors.Is(err, jobapi.ErrNotFound) {
+		return clierror.UIError{
+			Operation:   "find job",
+			Err:         err,
+			Description: fmt.Sprintf("no job found for %q or %q", from, to),
+		}
+	}
+	var partial clierror.PartialFailureError
+	if errors.As(err, &partial) {
+		return clierror.UIError{
+			Operation:   "move",
+			Err:         err,
+			Description: "the move failed and could not be fully rolled back; check both jobs",
+		}
+	}
+	return clierror.UIError{
+		Operation:   "move",
+		Err:         err,
+		Description: "nothing was moved",
+	}
+}
@@ -16,10 +16,11 @@ import (
 
 // NewProgressCmd returns new progress command
 func NewProgressCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	var localeIDs []string
 	progressCmd := &cobra.Command{
 		Use:   "progress <translationJobUid|translationJobName>",
 		Short: "Track translation progress for a specific job.",
-		Long: `smartling-cli jobs progress <translationJobUid|translationJobName> [--output json]
+		Long: `smartling-cli jobs progress <translationJobUid|translationJobName> [--locale <locale>] [--output json]
 
 Retrieves real-time translation progress metrics for a specific translation job.
 This command is essential for monitoring active translations, estimating completion times,
@@ -36,20 +37,43 @@ The command accepts either:
 If multiple jobs share the same name, the most recent active job (not Canceled or Closed)
 will be selected.
 
+Use --locale (repeatable) to limit the per-locale breakdown to some target
+locales. Job totals always cover all locales.
+
 Output Formats:
 
   --output simple (default)
-    Displays key progress metrics in human-readable format:
-      - Total word count across all locales
-      - Overall completion percentage
+    Displays job totals followed by one section per locale:
+      - Total word count and overall completion percentage
+      - Per-locale completion percentage and word count
+      - Words and strings in each workflow step, marked as awaiting or
+        completed (published)
     Best for: Quick status checks, manual monitoring, terminal viewing
 
+  --output table
+    One row of job totals, then one row per locale and workflow step.
+
   --output json
-    Returns the complete API response as JSON, including:
-      - Per-locale progress breakdowns
-      - Workflow step details (authorized, awaiting, completed, etc.)
-      - String and word counts at each workflow stage
-      - Target locale descriptions
+    Returns a stable document:
+      {
+        "translationJobUid": "aabbccdd1122",
+        "totalWordCount": 120,
+        "percentComplete": 50,
+        "locales": [{
+          "localeId": "de-DE",
+          "description": "German (Germany)",
+          "percentComplete": 50,
+          "totalWordCount": 120,
+          "steps": [{
+            "workflowName": "Translation",
+            "stepName": "Published",
+            "stepType": "PUBLISH",
+            "wordCount": 60,
+            "stringCount": 4,
+            "completed": true
+          }]
+        }]
+      }
     Best for: Automation scripts, CI/CD pipelines, custom reporting tools
 
 Use Cases:
@@ -78,6 +102,10 @@ Available options:` + help.AuthenticationOptions,
 
   smartling-cli jobs progress "Mobile App Release" --output json
 
+# Show the workflow breakdown for German only
+
+  smartling-cli jobs progress aabbccdd1122 --locale de-DE --output table
+
 # Use with specific project
 
   smartling-cli jobs progress aabbccdd1122 --project 9876543210
@@ -114,6 +142,7 @@ Available options:` + help.AuthenticationOptions,
 			params := srv.ProgressParams{
 				ProjectUID:   cnf.ProjectID,
 				JobUIDOrName: idOrName,
+				LocaleIDs:    localeIDs,
 			}
 			format, err := cmd.Flags().GetString("output")
 			if err != nil {
@@ -124,5 +153,7 @@ Available options:` + help.AuthenticationOptions,
 		},
 	}
 
+	progressCmd.Flags().StringArrayVar(&localeIDs, "locale", nil, "Show the breakdown for this target locale only (repeatable).")
+
 	return progressCmd
 }
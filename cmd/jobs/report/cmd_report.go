@@ -0,0 +1,81 @@
+package jobreport
+
+import (
+	"fmt"
+	"strings"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	srv "github.com/Smartling/smartling-cli/services/jobs"
+
+	"github.com/spf13/cobra"
+)
+
+// NewReportCmd builds the `jobs report` command.
+func NewReportCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	reportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report overdue and at-risk jobs across all projects of the account.",
+		Long: `Aggregate the jobs of every project in the account, join each job with its
+translation progress and flag the ones that need attention:
+
+  overdue  the due date has passed and the job is not completed
+  at risk  progress lags more than --at-risk-margin percent points behind
+           schedule, assuming work is spread evenly between job creation
+           and the due date
+
+A job whose progress cannot be read is flagged "no progress" with the error
+instead of failing the report.
+
+Totals are given by project and status. By default jobs in ` + strings.Join(srv.DefaultReportStatuses, ", ") + `
+status are reported; use --status to choose others.
+
+Use --output table, json or csv. Table and CSV carry one row per job followed
+by one per total, told apart by the TYPE column.`,
+		Example: `
+# Report all active jobs of the account
+
+  smartling-cli --account <accountUid> jobs report
+
+# Export the report of two projects for a spreadsheet
+
+  smartling-cli jobs report --project-id 1a2b3c4d5 --project-id 6e7f8a9b0 --output csv > jobs.csv
+
+# Only flag jobs lagging more than 25 points behind schedule
+
+  smartling-cli jobs report --at-risk-margin 25 --output table
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cnf, err := rootcmd.Config()
+			if err != nil {
+				return err
+			}
+
+			params, err := resolveParams(cmd, cnf.AccountID, cnf.Threads)
+			if err != nil {
+				return fmt.Errorf("failed to resolve report params: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	registerReportFlags(reportCmd)
+	return reportCmd
+}
+
+// registerReportFlags adds all jobs-report flags to the command.
+func registerReportFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray(projectIDFlag, nil, "Report jobs of this project only (repeatable).")
+	cmd.Flags().StringArray(statusFlag, nil, "Report jobs in this status (repeatable; maps to translationJobStatus).")
+	cmd.Flags().Float64(atRiskMarginFlag, srv.DefaultAtRiskMargin, "Percent points a job may lag behind schedule before it is flagged at risk.")
+	cmd.Flags().Uint32(threadsFlag, 4, "Number of concurrent progress requests.")
+}
@@ -0,0 +1,50 @@
+package jobreport
+
+import (
+	"fmt"
+
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
+	srv "github.com/Smartling/smartling-cli/services/jobs"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	projectIDFlag    = "project-id"
+	statusFlag       = "status"
+	atRiskMarginFlag = "at-risk-margin"
+	threadsFlag      = "threads"
+)
+
+// resolveParams resolves jobs-report params from flags with an env-var
+// fallback (flag → env → config).
+func resolveParams(cmd *cobra.Command, accountIDConfig string, threadsConfig uint32) (srv.ReportParams, error) {
+	accountUID, err := resolve.FallbackAccount(cmd.Root().PersistentFlags().Lookup("account"), accountIDConfig)
+	if err != nil {
+		return srv.ReportParams{}, err
+	}
+
+	margin, err := cmd.Flags().GetFloat64(atRiskMarginFlag)
+	if err != nil {
+		return srv.ReportParams{}, err
+	}
+	if margin < 0 || margin > 100 {
+		return srv.ReportParams{}, fmt.Errorf("--%s must be between 0 and 100", atRiskMarginFlag)
+	}
+
+	threads := threadsConfig
+	if cmd.Flags().Changed(threadsFlag) || threads == 0 {
+		threads, err = cmd.Flags().GetUint32(threadsFlag)
+		if err != nil {
+			return srv.ReportParams{}, err
+		}
+	}
+
+	return srv.ReportParams{
+		AccountUID:   accountUID,
+		ProjectIDs:   resolve.FallbackStringArray(cmd, projectIDFlag, nil),
+		JobStatus:    resolve.FallbackStringArray(cmd, statusFlag, nil),
+		AtRiskMargin: margin,
+		Threads:      threads,
+	}, nil
+}
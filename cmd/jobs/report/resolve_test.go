@@ -0,0 +1,44 @@
+package jobreport
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveParams(t *testing.T) {
+	cmd := newTestCmd()
+	require.NoError(t, cmd.Flags().Set("status", "IN_PROGRESS"))
+	require.NoError(t, cmd.Flags().Set("project-id", "p1"))
+
+	params, err := resolveParams(cmd, "acc12345", 8)
+	require.NoError(t, err)
+	assert.Equal(t, "acc12345", string(params.AccountUID))
+	assert.Equal(t, []string{"IN_PROGRESS"}, params.JobStatus)
+	assert.Equal(t, []string{"p1"}, params.ProjectIDs)
+	assert.Equal(t, float64(10), params.AtRiskMargin)
+	assert.Equal(t, uint32(8), params.Threads, "config threads apply when the flag is not set")
+
+	require.NoError(t, cmd.Flags().Set("threads", "2"))
+	params, err = resolveParams(cmd, "acc12345", 8)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), params.Threads)
+}
+
+func TestResolveParams_InvalidMargin(t *testing.T) {
+	cmd := newTestCmd()
+	require.NoError(t, cmd.Flags().Set("at-risk-margin", "150"))
+
+	_, err := resolveParams(cmd, "acc12345", 0)
+	assert.Error(t, err)
+}
+
+// newTestCmd builds a command carrying the same flags as the real one so
+// resolveParams can read them.
+func newTestCmd() *cobra.Command {
+	c := &cobra.Command{Use: "report", RunE: func(*cobra.Command, []string) error { return nil }}
+	registerReportFlags(c)
+	return c
+}
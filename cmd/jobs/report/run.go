@@ -0,0 +1,36 @@
+package jobreport
+
+import (
+	"context"
+
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	"github.com/Smartling/smartling-cli/output/static"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	srv "github.com/Smartling/smartling-cli/services/jobs"
+)
+
+func run(ctx context.Context,
+	initializer jobscmd.SrvInitializer,
+	params srv.ReportParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running jobs report with params: %v", params)
+	jobSrv, err := initializer.InitJobSrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Jobs service",
+		}
+	}
+
+	reportOutput, err := jobSrv.RunReport(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	static.GetOutputFormat[srv.ReportOutput](outputParams.Format).FormatAndRender(reportOutput)
+	return nil
+}
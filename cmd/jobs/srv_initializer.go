@@ -5,13 +5,23 @@ import (
 
 	rootcmd "github.com/Smartling/smartling-cli/cmd"
 	srv "github.com/Smartling/smartling-cli/services/jobs"
+	"github.com/Smartling/smartling-cli/services/jobs/checkpoint"
+	jobsfiles "github.com/Smartling/smartling-cli/services/jobs/files"
+	"github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+	"github.com/Smartling/smartling-cli/services/jobs/locales"
+	jobstrings "github.com/Smartling/smartling-cli/services/jobs/strings"
 
 	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	jobfile "github.com/Smartling/api-sdk-go/api/job/file"
+	joblocale "github.com/Smartling/api-sdk-go/api/job/locale"
+	jobstring "github.com/Smartling/api-sdk-go/api/job/string"
 )
 
 // SrvInitializer defines jobs service initializer
 type SrvInitializer interface {
 	InitJobSrv(ctx context.Context) (srv.Service, error)
+	InitLifecycleSrv(ctx context.Context) (lifecycle.Service, error)
+	InitCheckpointSrv(ctx context.Context) (checkpoint.Service, error)
 }
 
 // NewSrvInitializer returns new SrvInitializer implementation
@@ -31,3 +41,29 @@ func (i srvInitializer) InitJobSrv(ctx context.Context) (srv.Service, error) {
 	jobSrv := srv.NewService(jobApi)
 	return jobSrv, nil
 }
+
+// InitLifecycleSrv initializes job `lifecycle` service with the client and configuration.
+func (i srvInitializer) InitLifecycleSrv(ctx context.Context) (lifecycle.Service, error) {
+	client, err := rootcmd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lifecycle.NewService(lifecycle.NewAPI(client.Client), jobapi.NewJob(client.Client)), nil
+}
+
+// InitCheckpointSrv initializes the job `checkpoint` service, which combines
+// the job, job files, job strings, job locales and lifecycle services.
+func (i srvInitializer) InitCheckpointSrv(ctx context.Context) (checkpoint.Service, error) {
+	client, err := rootcmd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	job := jobapi.NewJob(client.Client)
+	return checkpoint.NewService(
+		job,
+		jobsfiles.NewService(jobfile.NewJobFile(client.Client), job, client.ListAllFiles),
+		jobstrings.NewService(jobstring.NewJobString(client.Client), job),
+		locales.NewService(joblocale.NewJobLocale(client.Client), job),
+		lifecycle.NewService(lifecycle.NewAPI(client.Client), job),
+	), nil
+}
@@ -2,25 +2,25 @@ package add
 
 import (
 	"fmt"
-	"os"
 
 	stringscmd "github.com/Smartling/smartling-cli/cmd/jobs/strings"
 	"github.com/Smartling/smartling-cli/output"
-	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	"github.com/spf13/cobra"
 )
 
 const (
-	hashcodeFlag     = "hashcode"
-	targetLocaleFlag = "target-locale"
-	moveEnabledFlag  = "move-enabled"
+	hashcodeFlag      = "hashcode"
+	hashcodesFromFlag = "hashcodes-from"
+	targetLocaleFlag  = "target-locale"
+	moveEnabledFlag   = "move-enabled"
 )
 
 // NewJobStringsAddCmd returns new command to job string add
 func NewJobStringsAddCmd(initializer stringscmd.SrvInitializer) *cobra.Command {
 	var (
 		hashcodes     []string
+		hashcodesFrom string
 		targetLocales []string
 		moveEnabled   bool
 	)
@@ -37,11 +37,15 @@ func NewJobStringsAddCmd(initializer stringscmd.SrvInitializer) *cobra.Command {
 # Add a string for specific locales, moving it if it already belongs to another job
 
   smartling-cli jobs strings add "Website Q1 2026" --hashcode h1 --target-locale fr-FR --move-enabled
+
+# Add thousands of strings exported to a CSV file with a "hashcode" column
+
+  smartling-cli jobs strings add aabbccdd1122 --hashcodes-from export.csv
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			params, err := resolveParams(args[0], hashcodes, targetLocales, moveEnabled)
+			params, err := resolveParams(args[0], hashcodes, hashcodesFrom, targetLocales, moveEnabled)
 			if err != nil {
 				return fmt.Errorf("failed to resolve add params: %w", err)
 			}
@@ -53,13 +57,14 @@ func NewJobStringsAddCmd(initializer stringscmd.SrvInitializer) *cobra.Command {
 		},
 	}
 
-	addCmd.Flags().StringArrayVar(&hashcodes, hashcodeFlag, nil, "String hashcode to add (repeatable, required).")
+	addCmd.Flags().StringArrayVar(&hashcodes, hashcodeFlag, nil, "String hashcode to add (repeatable).")
 	addCmd.Flags().StringArrayVar(&targetLocales, targetLocaleFlag, nil, "Target locale to add the strings to (repeatable; default all job locales).")
 	addCmd.Flags().BoolVar(&moveEnabled, moveEnabledFlag, false, "Move the string into this job if it already belongs to another job for a locale.")
-	if err := addCmd.MarkFlagRequired(hashcodeFlag); err != nil {
-		rlog.Errorf("failed to mark --%s required: %s", hashcodeFlag, err)
-		os.Exit(1)
-	}
+	addCmd.Flags().StringVar(&hashcodesFrom, hashcodesFromFlag, "", `<file|->
+Read hashcodes from a file, or stdin with "-": separated by newlines or commas,
+CSV with a "hashcode" column, or JSON such as "jobs strings list" output.
+Combines with --hashcode.`)
+	addCmd.MarkFlagsOneRequired(hashcodeFlag, hashcodesFromFlag)
 
 	return addCmd
 }
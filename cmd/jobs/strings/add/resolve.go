@@ -2,12 +2,13 @@ package add
 
 import (
 	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
 	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 	srv "github.com/Smartling/smartling-cli/services/jobs/strings"
 )
 
-func resolveParams(jobUIDOrName string, hashcodes, targetLocales []string, moveEnabled bool) (srv.AddParams, error) {
+func resolveParams(jobUIDOrName string, hashcodes []string, hashcodesFrom string, targetLocales []string, moveEnabled bool) (srv.AddParams, error) {
 	rlog.Debugf("resolving add params")
 
 	cnf, err := rootcmd.Config()
@@ -18,6 +19,10 @@ func resolveParams(jobUIDOrName string, hashcodes, targetLocales []string, moveE
 			Description: "failed to read config",
 		}
 	}
+	hashcodes, err = resolve.Hashcodes(hashcodes, hashcodesFrom)
+	if err != nil {
+		return srv.AddParams{}, err
+	}
 
 	return srv.AddParams{
 		ProjectID:       cnf.ProjectID,
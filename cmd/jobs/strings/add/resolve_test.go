@@ -34,7 +34,7 @@ func Test_resolveParams(t *testing.T) {
 	}
 	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
 
-	params, err := resolveParams("aabbccdd1122", []string{"h1", "h2"}, []string{"fr-FR"}, true)
+	params, err := resolveParams("aabbccdd1122", []string{"h1", "h2"}, "", []string{"fr-FR"}, true)
 	if err != nil {
 		t.Fatalf("resolveParams() error = %v", err)
 	}
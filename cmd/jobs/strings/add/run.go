@@ -31,7 +31,7 @@ func run(ctx context.Context,
 	}
 
 	addOutput, err := stringsSrv.RunAdd(ctx, params)
-	if err != nil {
+	if err != nil && !errors.As(err, new(clierror.PartialFailureError)) {
 		if errors.Is(err, jobapi.ErrNotFound) {
 			return clierror.UIError{
 				Operation:   "find job",
@@ -43,5 +43,5 @@ func run(ctx context.Context,
 	}
 
 	static.GetOutputFormat[srv.MutateOutput](outputParams.Format).FormatAndRender(addOutput)
-	return nil
+	return clierror.Rendered(err)
 }
@@ -13,6 +13,7 @@ const (
 	targetLocaleFlag = "target-locale"
 	limitFlag        = "limit"
 	offsetFlag       = "offset"
+	allFlag          = "all"
 )
 
 // NewJobStringsListCmd returns new command to job string list
@@ -21,12 +22,16 @@ func NewJobStringsListCmd(initializer stringscmd.SrvInitializer) *cobra.Command
 		targetLocale string
 		limit        uint32
 		offset       uint32
+		all          bool
 	)
 	listCmd := &cobra.Command{
 		Use:   "list <translationJobUid|translationJobName>",
 		Short: "List the strings on a translation job.",
-		Long:  `List the strings (by hashcode and target locale) assigned to a translation job, identified by UID or name.`,
-		Args:  cobra.ExactArgs(1),
+		Long: `List the strings (by hashcode and target locale) assigned to a translation job, identified by UID or name.
+
+With --all every page is fetched, a few pages at a time; --limit then sets the
+page size. JSON output is streamed as one string per line (NDJSON).`,
+		Args: cobra.ExactArgs(1),
 		Example: `
 # List a job's strings
 
@@ -35,6 +40,10 @@ func NewJobStringsListCmd(initializer stringscmd.SrvInitializer) *cobra.Command
 # List strings for one locale as a table
 
   smartling-cli jobs strings list "Website Q1 2026" --target-locale fr-FR --output table
+
+# Stream every string of the job as NDJSON
+
+  smartling-cli jobs strings list aabbccdd1122 --all --output json
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -47,13 +56,15 @@ func NewJobStringsListCmd(initializer stringscmd.SrvInitializer) *cobra.Command
 			if err != nil {
 				return err
 			}
-			return run(ctx, initializer, params, output.Params{Format: format})
+			return run(ctx, initializer, params, all, output.Params{Format: format})
 		},
 	}
 
 	listCmd.Flags().StringVar(&targetLocale, targetLocaleFlag, "", "Filter strings by target locale.")
 	listCmd.Flags().Uint32Var(&limit, limitFlag, 0, "Maximum number of strings to return.")
 	listCmd.Flags().Uint32Var(&offset, offsetFlag, 0, "Number of strings to skip.")
+	listCmd.Flags().BoolVar(&all, allFlag, false, "Fetch every page; --limit sets the page size.")
+	listCmd.MarkFlagsMutuallyExclusive(allFlag, offsetFlag)
 
 	return listCmd
 }
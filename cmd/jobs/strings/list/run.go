@@ -18,6 +18,7 @@ import (
 func run(ctx context.Context,
 	initializer stringscmd.SrvInitializer,
 	params srv.ListParams,
+	all bool,
 	outputParams output.Params,
 ) error {
 	rlog.Debugf("running jobs strings list with params: %v", params)
@@ -30,7 +31,12 @@ func run(ctx context.Context,
 		}
 	}
 
-	listOutput, err := stringsSrv.RunList(ctx, params)
+	var listOutput srv.ListOutput
+	if all {
+		err = runAll(ctx, stringsSrv, params, outputParams)
+	} else {
+		listOutput, err = stringsSrv.RunList(ctx, params)
+	}
 	if err != nil {
 		if errors.Is(err, jobapi.ErrNotFound) {
 			return clierror.UIError{
@@ -42,6 +48,18 @@ func run(ctx context.Context,
 		return err
 	}
 
-	static.GetOutputFormat[srv.ListOutput](outputParams.Format).FormatAndRender(listOutput)
+	if !all {
+		static.GetOutputFormat[srv.ListOutput](outputParams.Format).FormatAndRender(listOutput)
+	}
 	return nil
 }
+
+// runAll streams every string for json and simple output, and collects them
+// for table and csv.
+func runAll(ctx context.Context, stringsSrv srv.Service, params srv.ListParams, outputParams output.Params) error {
+	return static.RenderAll(outputParams.Format,
+		func(emit func(srv.Item) error) error { return stringsSrv.RunListAll(ctx, params, emit) },
+		func(items []srv.Item) srv.ListOutput {
+			return srv.ListOutput{Items: items, TotalCount: uint32(len(items))}
+		})
+}
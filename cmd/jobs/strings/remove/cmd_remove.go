@@ -2,25 +2,25 @@ package remove
 
 import (
 	"fmt"
-	"os"
 
 	stringscmd "github.com/Smartling/smartling-cli/cmd/jobs/strings"
 	"github.com/Smartling/smartling-cli/output"
-	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	"github.com/spf13/cobra"
 )
 
 const (
-	hashcodeFlag = "hashcode"
-	localeFlag   = "locale"
+	hashcodeFlag      = "hashcode"
+	hashcodesFromFlag = "hashcodes-from"
+	localeFlag        = "locale"
 )
 
 // NewJobStringsRemoveCmd returns new command to job string remove
 func NewJobStringsRemoveCmd(initializer stringscmd.SrvInitializer) *cobra.Command {
 	var (
-		hashcodes []string
-		localeIDs []string
+		hashcodes     []string
+		hashcodesFrom string
+		localeIDs     []string
 	)
 	removeCmd := &cobra.Command{
 		Use:   "remove <translationJobUid|translationJobName>",
@@ -35,11 +35,15 @@ func NewJobStringsRemoveCmd(initializer stringscmd.SrvInitializer) *cobra.Comman
 # Remove a string from specific locales only
 
   smartling-cli jobs strings remove "Website Q1 2026" --hashcode h1 --locale fr-FR
+
+# Remove the strings listed by another command, one hashcode per line
+
+  cut -f1 stale.tsv | smartling-cli jobs strings remove aabbccdd1122 --hashcodes-from -
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			params, err := resolveParams(args[0], hashcodes, localeIDs)
+			params, err := resolveParams(args[0], hashcodes, hashcodesFrom, localeIDs)
 			if err != nil {
 				return fmt.Errorf("failed to resolve remove params: %w", err)
 			}
@@ -51,12 +55,13 @@ func NewJobStringsRemoveCmd(initializer stringscmd.SrvInitializer) *cobra.Comman
 		},
 	}
 
-	removeCmd.Flags().StringArrayVar(&hashcodes, hashcodeFlag, nil, "String hashcode to remove (repeatable, required).")
+	removeCmd.Flags().StringArrayVar(&hashcodes, hashcodeFlag, nil, "String hashcode to remove (repeatable).")
 	removeCmd.Flags().StringArrayVar(&localeIDs, localeFlag, nil, "Locale to remove the strings from (repeatable; default all job locales).")
-	if err := removeCmd.MarkFlagRequired(hashcodeFlag); err != nil {
-		rlog.Errorf("failed to mark --%s required: %s", hashcodeFlag, err)
-		os.Exit(1)
-	}
+	removeCmd.Flags().StringVar(&hashcodesFrom, hashcodesFromFlag, "", `<file|->
+Read hashcodes from a file, or stdin with "-": separated by newlines or commas,
+CSV with a "hashcode" column, or JSON such as "jobs strings list" output.
+Combines with --hashcode.`)
+	removeCmd.MarkFlagsOneRequired(hashcodeFlag, hashcodesFromFlag)
 
 	return removeCmd
 }
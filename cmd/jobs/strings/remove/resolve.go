@@ -2,12 +2,13 @@ package remove
 
 import (
 	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	"github.com/Smartling/smartling-cli/cmd/helpers/resolve"
 	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 	srv "github.com/Smartling/smartling-cli/services/jobs/strings"
 )
 
-func resolveParams(jobUIDOrName string, hashcodes, localeIDs []string) (srv.RemoveParams, error) {
+func resolveParams(jobUIDOrName string, hashcodes []string, hashcodesFrom string, localeIDs []string) (srv.RemoveParams, error) {
 	rlog.Debugf("resolving remove params")
 
 	cnf, err := rootcmd.Config()
@@ -18,6 +19,10 @@ func resolveParams(jobUIDOrName string, hashcodes, localeIDs []string) (srv.Remo
 			Description: "failed to read config",
 		}
 	}
+	hashcodes, err = resolve.Hashcodes(hashcodes, hashcodesFrom)
+	if err != nil {
+		return srv.RemoveParams{}, err
+	}
 
 	return srv.RemoveParams{
 		ProjectID:    cnf.ProjectID,
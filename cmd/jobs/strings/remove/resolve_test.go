@@ -34,7 +34,7 @@ func Test_resolveParams(t *testing.T) {
 	}
 	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
 
-	params, err := resolveParams("aabbccdd1122", []string{"h1"}, []string{"fr-FR"})
+	params, err := resolveParams("aabbccdd1122", []string{"h1"}, "", []string{"fr-FR"})
 	if err != nil {
 		t.Fatalf("resolveParams() error = %v", err)
 	}
@@ -0,0 +1,59 @@
+package jobupdate
+
+import (
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+
+	"github.com/spf13/cobra"
+)
+
+// NewUpdateCmd builds the `jobs update` command.
+func NewUpdateCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	updateCmd := &cobra.Command{
+		Use:   "update <translationJobUid|translationJobName>",
+		Short: "Update attributes of a translation job.",
+		Long: `Update name, description, due date, reference number or custom fields of a
+translation job, identified by UID or name. Only given attributes are changed.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Move the due date of a job
+
+  smartling-cli jobs update "Website Q1 2026" --due 2026-04-15T17:00:00Z
+
+# Rename a job and clear its description
+
+  smartling-cli jobs update aabbccdd1122 --name "Website Q2 2026" --description ""
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cnf, err := rootcmd.Config()
+			if err != nil {
+				return err
+			}
+
+			params, err := resolveParams(cmd, cnf.ProjectID, args[0])
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	registerUpdateFlags(updateCmd)
+
+	return updateCmd
+}
+
+func registerUpdateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(nameFlag, "", "New job name.")
+	cmd.Flags().String(descriptionFlag, "", "New job description.")
+	cmd.Flags().String(dueFlag, "", "New due date in RFC3339 format, e.g. 2026-03-31T17:00:00Z.")
+	cmd.Flags().String(referenceNumberFlag, "", "New reference number.")
+	cmd.Flags().StringArray(customFieldFlag, nil, "Custom field value as <fieldUid>=<value> (repeatable).")
+}
@@ -0,0 +1,53 @@
+package jobupdate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/helpers"
+	srv "github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	nameFlag            = "name"
+	descriptionFlag     = "description"
+	dueFlag             = "due"
+	referenceNumberFlag = "reference-number"
+	customFieldFlag     = "custom-field"
+)
+
+// resolveParams resolves jobs-update params. Only flags set on the command
+// line are applied, so an attribute can be cleared with an empty value.
+func resolveParams(cmd *cobra.Command, projectID, jobUIDOrName string) (srv.UpdateParams, error) {
+	params := srv.UpdateParams{
+		JobParams:       srv.JobParams{ProjectID: projectID, JobUIDOrName: jobUIDOrName},
+		JobName:         changedString(cmd, nameFlag),
+		Description:     changedString(cmd, descriptionFlag),
+		ReferenceNumber: changedString(cmd, referenceNumberFlag),
+	}
+	if raw := changedString(cmd, dueFlag); raw != nil {
+		due, err := time.Parse(time.RFC3339, *raw)
+		if err != nil {
+			return srv.UpdateParams{}, fmt.Errorf("invalid --%s (RFC3339): %w", dueFlag, err)
+		}
+		params.DueDate = &due
+	}
+	fields, err := cmd.Flags().GetStringArray(customFieldFlag)
+	if err != nil {
+		return srv.UpdateParams{}, err
+	}
+	if params.CustomFields, err = helpers.MKeyValueToMap(fields); err != nil {
+		return srv.UpdateParams{}, fmt.Errorf("invalid --%s: %w", customFieldFlag, err)
+	}
+	return params, nil
+}
+
+func changedString(cmd *cobra.Command, flagName string) *string {
+	flag := cmd.Flags().Lookup(flagName)
+	if flag == nil || !flag.Changed {
+		return nil
+	}
+	return new(flag.Value.String())
+}
@@ -0,0 +1,41 @@
+package jobupdate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveParams_OnlyChangedFlags(t *testing.T) {
+	cmd := newTestCmd()
+	require.NoError(t, cmd.Flags().Set("description", ""))
+	require.NoError(t, cmd.Flags().Set("due", "2026-04-15T17:00:00Z"))
+
+	params, err := resolveParams(cmd, "proj-1", "aabbccdd1122")
+	require.NoError(t, err)
+	require.Equal(t, "proj-1", params.ProjectID)
+	require.Equal(t, "aabbccdd1122", params.JobUIDOrName)
+	require.Nil(t, params.JobName)
+	require.Nil(t, params.ReferenceNumber)
+	require.NotNil(t, params.Description)
+	require.Empty(t, *params.Description)
+	require.Equal(t, time.Date(2026, 4, 15, 17, 0, 0, 0, time.UTC), *params.DueDate)
+}
+
+func TestResolveParams_InvalidCustomField(t *testing.T) {
+	cmd := newTestCmd()
+	require.NoError(t, cmd.Flags().Set("custom-field", "no-value"))
+
+	_, err := resolveParams(cmd, "proj-1", "aabbccdd1122")
+	require.ErrorContains(t, err, "invalid --custom-field")
+}
+
+// newTestCmd builds a command carrying the same flags as the real one so
+// resolveParams can read them.
+func newTestCmd() *cobra.Command {
+	c := &cobra.Command{Use: "update", RunE: func(*cobra.Command, []string) error { return nil }}
+	registerUpdateFlags(c)
+	return c
+}
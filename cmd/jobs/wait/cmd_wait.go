@@ -0,0 +1,72 @@
+package jobwait
+
+import (
+	"time"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	srv "github.com/Smartling/smartling-cli/services/jobs"
+
+	"github.com/spf13/cobra"
+)
+
+// NewWaitCmd builds the `jobs wait` command.
+func NewWaitCmd(initializer jobscmd.SrvInitializer) *cobra.Command {
+	var (
+		until    float64
+		timeout  time.Duration
+		interval time.Duration
+	)
+	waitCmd := &cobra.Command{
+		Use:   "wait <translationJobUid|translationJobName>",
+		Short: "Wait until a translation job reaches a progress threshold.",
+		Long: `Poll the progress of a translation job until it reaches --until percent,
+is completed or closed, is cancelled, or --timeout expires.
+
+In an interactive terminal a live progress view is shown. Otherwise per-locale
+progress changes are printed as they happen.
+
+The exit code tells how waiting ended:
+  0  the job reached the threshold or was completed
+  7  --timeout expired first
+  8  the job was cancelled`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Wait for a job to be fully translated, checking every minute for up to 2 hours
+
+  smartling-cli jobs wait "Release v2.0" --until 100 --timeout 2h --interval 1m
+
+# Gate a CI pipeline on 95% progress
+
+  smartling-cli jobs wait aabbccdd1122 --until 95 --timeout 30m --output json
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cnf, err := rootcmd.Config()
+			if err != nil {
+				return err
+			}
+
+			params := srv.WaitParams{
+				ProjectUID:   cnf.ProjectID,
+				JobUIDOrName: args[0],
+				Until:        until,
+				Timeout:      timeout,
+				Interval:     interval,
+			}
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return run(ctx, initializer, params, output.Params{Format: format})
+		},
+	}
+
+	waitCmd.Flags().Float64Var(&until, "until", 100, "Overall percent complete to wait for.")
+	waitCmd.Flags().DurationVar(&timeout, "timeout", 0, "Give up after this long (e.g. 2h). Waits indefinitely by default.")
+	waitCmd.Flags().DurationVar(&interval, "interval", time.Minute, "How often to check the job progress.")
+
+	return waitCmd
+}
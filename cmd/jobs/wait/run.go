@@ -0,0 +1,88 @@
+package jobwait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	jobsoutput "github.com/Smartling/smartling-cli/output/jobs"
+	"github.com/Smartling/smartling-cli/output/static"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	srv "github.com/Smartling/smartling-cli/services/jobs"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	"golang.org/x/term"
+)
+
+func run(ctx context.Context,
+	initializer jobscmd.SrvInitializer,
+	params srv.WaitParams,
+	outputParams output.Params,
+) error {
+	rlog.Debugf("running jobs wait with params: %v", params)
+	jobSrv, err := initializer.InitJobSrv(ctx)
+	if err != nil {
+		return clierror.UIError{
+			Operation:   "init",
+			Err:         err,
+			Description: "unable to initialize Jobs service",
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		waitOutput srv.WaitOutput
+		waitErr    error
+	)
+	updates := make(chan srv.WaitUpdate)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer close(updates)
+		waitOutput, waitErr = jobSrv.RunWait(ctx, params, updates)
+	}()
+
+	simple := outputParams.Format != "json" && outputParams.Format != "table"
+	switch {
+	case simple && term.IsTerminal(int(os.Stdout.Fd())):
+		if err := jobsoutput.RunWaitView(updates, params.Until, cancel); err != nil {
+			rlog.Debugf("progress view failed: %s", err)
+			cancel()
+		}
+	case simple:
+		jobsoutput.RenderWaitChanges(os.Stdout, updates)
+	default:
+		for range updates {
+		}
+	}
+	<-done
+
+	if waitErr != nil {
+		if errors.Is(waitErr, jobapi.ErrNotFound) {
+			return clierror.UIError{
+				Operation:   "find job",
+				Err:         waitErr,
+				Description: fmt.Sprintf("no job found for %q", params.JobUIDOrName),
+			}
+		}
+		if errors.Is(waitErr, context.Canceled) {
+			return clierror.UIError{
+				Operation:   "wait",
+				Err:         waitErr,
+				Description: "stopped waiting before the job reached the threshold",
+			}
+		}
+		if waitOutput.Result == "" {
+			return waitErr
+		}
+	}
+
+	static.GetOutputFormat[srv.WaitOutput](outputParams.Format).FormatAndRender(waitOutput)
+	return clierror.Rendered(waitErr)
+}
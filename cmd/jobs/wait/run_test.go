@@ -0,0 +1,91 @@
+package jobwait
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	jobscmd "github.com/Smartling/smartling-cli/cmd/jobs"
+	"github.com/Smartling/smartling-cli/output"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	srv "github.com/Smartling/smartling-cli/services/jobs"
+	jobmocks "github.com/Smartling/smartling-cli/services/jobs/sdkmocks"
+
+	"github.com/Smartling/api-sdk-go/api/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	rootcmd.ConfigureLogger()
+	os.Exit(m.Run())
+}
+
+type jobSrvInitializer struct {
+	jobscmd.SrvInitializer
+	jobSrv srv.Service
+}
+
+func (i jobSrvInitializer) InitJobSrv(context.Context) (srv.Service, error) {
+	return i.jobSrv, nil
+}
+
+// runJSON runs the command with JSON output and returns what it printed.
+func runJSON(t *testing.T, jobSrv srv.Service, params srv.WaitParams) ([]byte, error) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	runErr := run(t.Context(), jobSrvInitializer{jobSrv: jobSrv}, params, output.Params{Format: "json"})
+	_ = w.Close()
+	os.Stdout = stdout
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return out, runErr
+}
+
+func TestRun_JSONSingleDocument(t *testing.T) {
+	tests := []struct {
+		name      string
+		jobStatus string
+		result    string
+		exitCode  int
+	}{
+		{"timed out", "IN_PROGRESS", srv.WaitTimedOut, clierror.ExitTimeout},
+		{"cancelled", srv.StatusCancelled, srv.WaitCancelled, clierror.ExitCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := jobmocks.NewMockJob(t)
+			m.On("GetJob", mock.Anything, "proj", "aabbccdd1122").
+				Return(job.GetJobResponse{TranslationJobUID: "aabbccdd1122", JobStatus: tt.jobStatus}, nil)
+			m.On("Progress", mock.Anything, "proj", "aabbccdd1122").
+				Return(job.GetJobProgressResponse{TranslationJobUID: "aabbccdd1122", PercentComplete: 10}, nil)
+
+			out, err := runJSON(t, srv.NewService(m), srv.WaitParams{
+				ProjectUID:   "proj",
+				JobUIDOrName: "aabbccdd1122",
+				Until:        100,
+				Interval:     time.Hour,
+				Timeout:      10 * time.Millisecond,
+			})
+			assert.Equal(t, tt.exitCode, clierror.ExitCode(err))
+			// main prints no error envelope after an already rendered result.
+			assert.True(t, errors.As(err, new(clierror.RenderedError)))
+
+			decoder := json.NewDecoder(bytes.NewReader(out))
+			var waitOutput srv.WaitOutput
+			require.NoError(t, decoder.Decode(&waitOutput))
+			assert.Equal(t, tt.result, waitOutput.Result)
+			assert.False(t, decoder.More(), "stdout holds more than one JSON document: %s", out)
+		})
+	}
+}
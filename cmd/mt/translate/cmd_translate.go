@@ -21,6 +21,7 @@ const (
 	progressFlag         = "progress"
 	overrideFileTypeFlag = "type"
 	outputTemplateFlag   = "format"
+	threadsFlag          = "threads"
 )
 
 var (
@@ -32,6 +33,7 @@ var (
 	progress         bool
 	overrideFileType string
 	outputTemplate   string
+	threads          uint32
 )
 
 // NewTranslateCmd retutns new translate command
@@ -39,7 +41,12 @@ func NewTranslateCmd(initializer mtcmd.SrvInitializer) *cobra.Command {
 	translateCmd := &cobra.Command{
 		Use:   "translate <file|pattern>",
 		Short: "Translate files using Smartling's File Machine Translation API.",
-		Long:  `Translate files using Smartling's File Machine Translation API.`,
+		Long: `Translate files using Smartling's File Machine Translation API.
+
+Translated files are saved to the output directory by file name and locale,
+so the files of one run must have different file names. A file or locale
+which fails does not stop the others; the command then exits with the
+partial failure code.`,
 		Example: `
 # Translate with automatic language detection
 
@@ -49,6 +56,10 @@ func NewTranslateCmd(initializer mtcmd.SrvInitializer) *cobra.Command {
 
   smartling-cli mt translate document.txt --source-locale en --target-locale fr-FR
 
+# Translate a folder of files, eight at a time
+
+  smartling-cli mt translate "docs/**/*.md" --target-locale de-DE --target-locale fr-FR --threads 8
+
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -106,6 +117,8 @@ Default: `+output.DefaultTranslateTemplate+`
 {{dir .Directory}} - Directory path`)
 	translateCmd.Flags().StringArrayVar(&directive, directiveFlag, nil, "Smartling directive. Can be specified multiple times")
 	translateCmd.Flags().BoolVar(&progress, progressFlag, true, "Display progress")
+	translateCmd.Flags().Uint32Var(&threads, threadsFlag, 4, `Number of files translated at a time, and of translated
+files downloaded at a time. Falls back to the "threads" config value.`)
 
 	if err := translateCmd.MarkFlagRequired(targetLocaleFlag); err != nil {
 		output.RenderAndExitIfErr(clierror.UIError{
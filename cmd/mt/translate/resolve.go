@@ -3,6 +3,7 @@ package translate
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	rootcmd "github.com/Smartling/smartling-cli/cmd"
@@ -51,6 +52,21 @@ func resolveParams(cmd *cobra.Command, fileConfig mtcmd.FileConfig) (srv.Transla
 	if err != nil {
 		return srv.TranslateParams{}, err
 	}
+	var threadsCfg *string
+	if cnf.Threads > 0 {
+		threadsCfg = new(strconv.FormatUint(uint64(cnf.Threads), 10))
+	}
+	threadsParam := resolve.FallbackString(cmd.Flags().Lookup(threadsFlag), resolve.StringParam{
+		FlagName: threadsFlag,
+		Config:   threadsCfg,
+	})
+	threads, err := strconv.ParseUint(threadsParam, 10, 32)
+	if err != nil || threads == 0 {
+		return srv.TranslateParams{}, clierror.InvalidConfigValueError{
+			ValueName:   threadsFlag,
+			Description: "must be a positive number",
+		}
+	}
 	params := srv.TranslateParams{
 		SourceLocale:     sourceLocaleParam,
 		TargetLocales:    resolveTargetLocale(cmd, fileConfig),
@@ -59,6 +75,7 @@ func resolveParams(cmd *cobra.Command, fileConfig mtcmd.FileConfig) (srv.Transla
 		Progress:         progressParam,
 		OverrideFileType: overrideFileTypeParam,
 		AccountUID:       accountUID,
+		Threads:          uint32(threads),
 	}
 	params.Directives, err = resolveDirectives(cmd, fileConfig)
 	if err != nil {
@@ -0,0 +1,47 @@
+package translate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	rootcmd "github.com/Smartling/smartling-cli/cmd"
+	mtcmd "github.com/Smartling/smartling-cli/cmd/mt"
+	mtmocks "github.com/Smartling/smartling-cli/cmd/mt/mocks"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveParams_Threads(t *testing.T) {
+	rlog.Init()
+	t.Setenv("SMARTLING_USER_ID", "test-user")
+	t.Setenv("SMARTLING_SECRET", "test-secret")
+
+	root := rootcmd.NewRootCmd()
+	cfgPath := filepath.Join(t.TempDir(), "smartling.yml")
+	require.NoError(t, os.WriteFile(cfgPath, []byte("account_id: account\nthreads: 8\n"), 0o600))
+	require.NoError(t, root.PersistentFlags().Set("config", cfgPath))
+	t.Cleanup(func() { _ = root.PersistentFlags().Set("config", "") })
+	cmd := NewTranslateCmd(mtmocks.NewMockSrvInitializer(t))
+	root.AddCommand(cmd)
+
+	params, err := resolveParams(cmd, mtcmd.FileConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), params.Threads, "config threads apply when the flag is not set")
+
+	t.Setenv("SMARTLING_CLI_THREADS", "6")
+	params, err = resolveParams(cmd, mtcmd.FileConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(6), params.Threads, "environment overrides config")
+
+	require.NoError(t, cmd.Flags().Set(threadsFlag, "2"))
+	params, err = resolveParams(cmd, mtcmd.FileConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), params.Threads)
+
+	require.NoError(t, cmd.Flags().Set(threadsFlag, "0"))
+	_, err = resolveParams(cmd, mtcmd.FileConfig{})
+	assert.ErrorContains(t, err, `"threads" is specified but invalid`)
+}
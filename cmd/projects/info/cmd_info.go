@@ -5,6 +5,7 @@ import (
 
 	projectscmd "github.com/Smartling/smartling-cli/cmd/projects"
 	output "github.com/Smartling/smartling-cli/output/projects"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
@@ -34,16 +35,16 @@ Available options:` + help.AuthenticationOptions,
 			s, err := initializer.InitProjectsSrv(ctx)
 			if err != nil {
 				rlog.Errorf("failed to get project service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			infoOutput, err := s.RunInfo(ctx)
 			if err != nil {
 				rlog.Errorf("failed to run info: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 			if err := output.RenderTable(infoOutput); err != nil {
 				rlog.Errorf("failed to render info output: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
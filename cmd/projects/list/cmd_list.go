@@ -4,6 +4,7 @@ import (
 	"os"
 
 	projectscmd "github.com/Smartling/smartling-cli/cmd/projects"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
@@ -47,13 +48,13 @@ Available options:
 			s, err := initializer.InitProjectsSrv(ctx)
 			if err != nil {
 				rlog.Errorf("failed to get project service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			err = s.RunList(ctx, short)
 			if err != nil {
 				rlog.Errorf("failed to run list: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
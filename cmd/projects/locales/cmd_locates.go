@@ -4,6 +4,7 @@ import (
 	"os"
 
 	projectscmd "github.com/Smartling/smartling-cli/cmd/projects"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/format"
 	"github.com/Smartling/smartling-cli/services/helpers/help"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
@@ -57,7 +58,7 @@ Available options:
 			s, err := initializer.InitProjectsSrv(ctx)
 			if err != nil {
 				rlog.Errorf("failed to get project service: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 
 			params := projects.LocalesParams{
@@ -68,7 +69,7 @@ Available options:
 			err = s.RunLocales(ctx, params)
 			if err != nil {
 				rlog.Errorf("failed to run locales: %s", err)
-				os.Exit(1)
+				os.Exit(clierror.ExitCode(err))
 			}
 		},
 	}
@@ -3,6 +3,7 @@ package main
 import (
 	"github.com/Smartling/smartling-cli/cmd"
 	"github.com/Smartling/smartling-cli/cmd/build"
+	"github.com/Smartling/smartling-cli/cmd/devserver"
 	"github.com/Smartling/smartling-cli/cmd/docs"
 	"github.com/Smartling/smartling-cli/cmd/files"
 	deletecmd "github.com/Smartling/smartling-cli/cmd/files/delete"
@@ -13,27 +14,60 @@ import (
 	"github.com/Smartling/smartling-cli/cmd/files/rename"
 	"github.com/Smartling/smartling-cli/cmd/files/status"
 	"github.com/Smartling/smartling-cli/cmd/glossaries"
+	glarchive "github.com/Smartling/smartling-cli/cmd/glossaries/archive"
+	glcheck "github.com/Smartling/smartling-cli/cmd/glossaries/check"
+	glconvert "github.com/Smartling/smartling-cli/cmd/glossaries/convert"
 	glcreate "github.com/Smartling/smartling-cli/cmd/glossaries/create"
+	gldelete "github.com/Smartling/smartling-cli/cmd/glossaries/delete"
+	gldiff "github.com/Smartling/smartling-cli/cmd/glossaries/diff"
+	glentries "github.com/Smartling/smartling-cli/cmd/glossaries/entries"
+	glentryadd "github.com/Smartling/smartling-cli/cmd/glossaries/entries/add"
+	glentryarchive "github.com/Smartling/smartling-cli/cmd/glossaries/entries/archive"
+	glentryget "github.com/Smartling/smartling-cli/cmd/glossaries/entries/get"
+	glentrylist "github.com/Smartling/smartling-cli/cmd/glossaries/entries/list"
+	glentrysearch "github.com/Smartling/smartling-cli/cmd/glossaries/entries/search"
+	glentryupdate "github.com/Smartling/smartling-cli/cmd/glossaries/entries/update"
 	glexport "github.com/Smartling/smartling-cli/cmd/glossaries/export"
 	glimport "github.com/Smartling/smartling-cli/cmd/glossaries/import"
+	glimportcancel "github.com/Smartling/smartling-cli/cmd/glossaries/import/cancel"
+	glimportconfirm "github.com/Smartling/smartling-cli/cmd/glossaries/import/confirm"
+	gllabels "github.com/Smartling/smartling-cli/cmd/glossaries/labels"
+	gllabelcreate "github.com/Smartling/smartling-cli/cmd/glossaries/labels/create"
+	gllabeldelete "github.com/Smartling/smartling-cli/cmd/glossaries/labels/delete"
+	gllabellist "github.com/Smartling/smartling-cli/cmd/glossaries/labels/list"
 	gllist "github.com/Smartling/smartling-cli/cmd/glossaries/list"
+	glmirror "github.com/Smartling/smartling-cli/cmd/glossaries/mirror"
+	glupdate "github.com/Smartling/smartling-cli/cmd/glossaries/update"
+	glvalidate "github.com/Smartling/smartling-cli/cmd/glossaries/validate"
+	glview "github.com/Smartling/smartling-cli/cmd/glossaries/view"
 	initialize "github.com/Smartling/smartling-cli/cmd/init"
 	"github.com/Smartling/smartling-cli/cmd/jobs"
+	jobauthorize "github.com/Smartling/smartling-cli/cmd/jobs/authorize"
+	jobcancel "github.com/Smartling/smartling-cli/cmd/jobs/cancel"
+	jobclose "github.com/Smartling/smartling-cli/cmd/jobs/close"
+	jobcreate "github.com/Smartling/smartling-cli/cmd/jobs/create"
+	jobdelete "github.com/Smartling/smartling-cli/cmd/jobs/delete"
+	jobexport "github.com/Smartling/smartling-cli/cmd/jobs/export"
 	jobfiles "github.com/Smartling/smartling-cli/cmd/jobs/files"
 	jobfileadd "github.com/Smartling/smartling-cli/cmd/jobs/files/add"
 	jobfilelist "github.com/Smartling/smartling-cli/cmd/jobs/files/list"
 	jobfileremove "github.com/Smartling/smartling-cli/cmd/jobs/files/remove"
 	jobfindbystrings "github.com/Smartling/smartling-cli/cmd/jobs/find_by_strings"
+	jobimport "github.com/Smartling/smartling-cli/cmd/jobs/import"
 	joblist "github.com/Smartling/smartling-cli/cmd/jobs/list"
 	joblocales "github.com/Smartling/smartling-cli/cmd/jobs/locales"
 	joblocaleadd "github.com/Smartling/smartling-cli/cmd/jobs/locales/add"
 	joblocaleremove "github.com/Smartling/smartling-cli/cmd/jobs/locales/remove"
+	jobmove "github.com/Smartling/smartling-cli/cmd/jobs/move"
 	"github.com/Smartling/smartling-cli/cmd/jobs/progress"
+	jobreport "github.com/Smartling/smartling-cli/cmd/jobs/report"
 	jobstrings "github.com/Smartling/smartling-cli/cmd/jobs/strings"
 	jobstringadd "github.com/Smartling/smartling-cli/cmd/jobs/strings/add"
 	jobstringlist "github.com/Smartling/smartling-cli/cmd/jobs/strings/list"
 	jobstringremove "github.com/Smartling/smartling-cli/cmd/jobs/strings/remove"
+	jobupdate "github.com/Smartling/smartling-cli/cmd/jobs/update"
 	jobview "github.com/Smartling/smartling-cli/cmd/jobs/view"
+	jobwait "github.com/Smartling/smartling-cli/cmd/jobs/wait"
 	"github.com/Smartling/smartling-cli/cmd/mt"
 	"github.com/Smartling/smartling-cli/cmd/mt/detect"
 	"github.com/Smartling/smartling-cli/cmd/mt/translate"
@@ -41,7 +75,8 @@ import (
 	"github.com/Smartling/smartling-cli/cmd/projects/info"
 	listprojects "github.com/Smartling/smartling-cli/cmd/projects/list"
 	"github.com/Smartling/smartling-cli/cmd/projects/locales"
-	output "github.com/Smartling/smartling-cli/output/mt"
+	"github.com/Smartling/smartling-cli/output"
+	mtoutput "github.com/Smartling/smartling-cli/output/mt"
 )
 
 func main() {
@@ -55,6 +90,9 @@ func main() {
 	buildCmd := build.NewBuildCmd()
 	rootCmd.AddCommand(buildCmd)
 
+	devServerCmd := devserver.NewDevServerCmd()
+	rootCmd.AddCommand(devServerCmd)
+
 	initSrvInitializer := initialize.NewSrvInitializer()
 	initCmd := initialize.NewInitCmd(initSrvInitializer)
 	rootCmd.AddCommand(initCmd)
@@ -90,6 +128,14 @@ func main() {
 	jobsCmd.AddCommand(joblist.NewListCmd(jobInitializer))
 	jobsCmd.AddCommand(jobview.NewViewCmd(jobInitializer))
 	jobsCmd.AddCommand(jobfindbystrings.NewFindByStringsCmd(jobInitializer))
+	jobsCmd.AddCommand(jobcreate.NewCreateCmd(jobInitializer))
+	jobsCmd.AddCommand(jobupdate.NewUpdateCmd(jobInitializer))
+	jobsCmd.AddCommand(jobauthorize.NewAuthorizeCmd(jobInitializer))
+	jobsCmd.AddCommand(jobcancel.NewCancelCmd(jobInitializer))
+	jobsCmd.AddCommand(jobclose.NewCloseCmd(jobInitializer))
+	jobsCmd.AddCommand(jobdelete.NewDeleteCmd(jobInitializer))
+	jobsCmd.AddCommand(jobwait.NewWaitCmd(jobInitializer))
+	jobsCmd.AddCommand(jobreport.NewReportCmd(jobInitializer))
 	jobFiles := jobfiles.NewJobFilesCmd()
 	jobFilesInitializer := jobfiles.NewSrvInitializer()
 	jobFiles.AddCommand(jobfilelist.NewListCmd(jobFilesInitializer))
@@ -107,20 +153,53 @@ func main() {
 	jobStrings.AddCommand(jobstringremove.NewJobStringsRemoveCmd(jobStringsInitializer))
 	jobStrings.AddCommand(jobstringlist.NewJobStringsListCmd(jobStringsInitializer))
 	jobsCmd.AddCommand(jobStrings)
+	jobsCmd.AddCommand(jobmove.NewMoveCmd(jobFilesInitializer, jobStringsInitializer))
+	jobsCmd.AddCommand(jobexport.NewExportCmd(jobInitializer))
+	jobsCmd.AddCommand(jobimport.NewImportCmd(jobInitializer))
 
 	glossariesCmd := glossaries.NewGlossariesCmd()
 	rootCmd.AddCommand(glossariesCmd)
 	glossarySrvInitializer := glossaries.NewSrvInitializer()
 	glossaryImport := glimport.NewImportCmd(glossarySrvInitializer)
+	glossaryImport.AddCommand(glimportconfirm.NewConfirmCmd(glossarySrvInitializer))
+	glossaryImport.AddCommand(glimportcancel.NewCancelCmd(glossarySrvInitializer))
 	glossaryExport := glexport.NewExportCmd(glossarySrvInitializer)
 	glossaryCreate := glcreate.NewCreateCmd(glossarySrvInitializer)
 	glossaryList := gllist.NewListCmd(glossarySrvInitializer)
 	glossariesCmd.AddCommand(glossaryImport)
 	glossariesCmd.AddCommand(glossaryExport)
+	glossariesCmd.AddCommand(glmirror.NewMirrorCmd(glossarySrvInitializer))
 	glossariesCmd.AddCommand(glossaryCreate)
 	glossariesCmd.AddCommand(glossaryList)
+	glossariesCmd.AddCommand(glview.NewViewCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(glupdate.NewUpdateCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(glarchive.NewArchiveCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(gldelete.NewDeleteCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(glvalidate.NewValidateCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(gldiff.NewDiffCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(glcheck.NewCheckCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(glconvert.NewConvertCmd())
+	glossaryEntries := glentries.NewEntriesCmd()
+	glossaryEntries.AddCommand(glentrylist.NewListCmd(glossarySrvInitializer))
+	glossaryEntries.AddCommand(glentrysearch.NewSearchCmd(glossarySrvInitializer))
+	glossaryEntries.AddCommand(glentryget.NewGetCmd(glossarySrvInitializer))
+	glossaryEntries.AddCommand(glentryadd.NewAddCmd(glossarySrvInitializer))
+	glossaryEntries.AddCommand(glentryupdate.NewUpdateCmd(glossarySrvInitializer))
+	glossaryEntries.AddCommand(glentryarchive.NewArchiveCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(glossaryEntries)
+	glossaryLabels := gllabels.NewLabelsCmd()
+	glossaryLabels.AddCommand(gllabellist.NewListCmd(glossarySrvInitializer))
+	glossaryLabels.AddCommand(gllabelcreate.NewCreateCmd(glossarySrvInitializer))
+	glossaryLabels.AddCommand(gllabeldelete.NewDeleteCmd(glossarySrvInitializer))
+	glossariesCmd.AddCommand(glossaryLabels)
 
-	if err := rootCmd.Execute(); err != nil {
-		output.RenderAndExitIfErr(err)
+	executedCmd, err := rootCmd.ExecuteC()
+	if err != nil {
+		if executedCmd != nil {
+			if format, _ := executedCmd.Flags().GetString("output"); format == "json" {
+				output.RenderErrorJSONAndExit(err)
+			}
+		}
+		mtoutput.RenderAndExitIfErr(err)
 	}
 }
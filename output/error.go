@@ -0,0 +1,79 @@
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+)
+
+// ErrorEnvelope is the machine-readable failure printed instead of the
+// human-readable error when JSON output is requested.
+type ErrorEnvelope struct {
+	Error ErrorObject `json:"error"`
+}
+
+// ErrorObject describes a failure. Code is stable and matches ExitCode,
+// see clierror.ExitCode for the table.
+type ErrorObject struct {
+	Code        string            `json:"code"`
+	ExitCode    int               `json:"exitCode"`
+	Message     string            `json:"message"`
+	Operation   string            `json:"operation,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
+}
+
+// NewErrorEnvelope builds envelope from err, taking operation, description
+// and fields from the outermost clierror.UIError or clierror.Error in the chain.
+func NewErrorEnvelope(err error) ErrorEnvelope {
+	object := ErrorObject{
+		Code:     clierror.Code(err),
+		ExitCode: clierror.ExitCode(err),
+		Message:  err.Error(),
+	}
+
+	var uiErr clierror.UIError
+	var cliErr clierror.Error
+	switch {
+	case errors.As(err, &uiErr):
+		object.Operation = uiErr.Operation
+		object.Description = uiErr.Description
+		object.Fields = uiErr.Fields
+		if uiErr.Err != nil {
+			object.Message = uiErr.Err.Error()
+		}
+	case errors.As(err, &cliErr):
+		object.Description = fmt.Sprintf(cliErr.Description, cliErr.Args...)
+		if cliErr.Cause != nil {
+			object.Message = cliErr.Cause.Error()
+		}
+	}
+
+	return ErrorEnvelope{Error: object}
+}
+
+// RenderErrorJSONAndExit prints err as ErrorEnvelope to stdout and exits
+// with the code mapped from err. An error marked by clierror.Rendered follows
+// a result already printed to stdout, so only its message is printed, to
+// stderr, keeping stdout a single JSON document.
+func RenderErrorJSONAndExit(err error) {
+	writeErrorJSON(os.Stdout, os.Stderr, err)
+	os.Exit(clierror.ExitCode(err))
+}
+
+func writeErrorJSON(stdout, stderr io.Writer, err error) {
+	if errors.As(err, new(clierror.RenderedError)) {
+		_, _ = fmt.Fprintln(stderr, err)
+		return
+	}
+	data, marshalErr := json.MarshalIndent(NewErrorEnvelope(err), "", "  ")
+	if marshalErr != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return
+	}
+	_, _ = fmt.Fprintln(stdout, string(data))
+}
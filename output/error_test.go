@@ -0,0 +1,53 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewErrorEnvelope(t *testing.T) {
+	err := fmt.Errorf("run: %w", clierror.UIError{
+		Err:         smerror.NotFoundError{},
+		Operation:   "GetJob",
+		Description: "job does not exist",
+		Fields:      map[string]string{"job": "abc"},
+	})
+	envelope := NewErrorEnvelope(err)
+	assert.Equal(t, ErrorObject{
+		Code:        clierror.CodeNotFound,
+		ExitCode:    clierror.ExitNotFound,
+		Message:     smerror.NotFoundError{}.Error(),
+		Operation:   "GetJob",
+		Description: "job does not exist",
+		Fields:      map[string]string{"job": "abc"},
+	}, envelope.Error)
+
+	envelope = NewErrorEnvelope(clierror.NewError(errors.New("no config"), "Create %s.", "smartling.yml"))
+	assert.Equal(t, clierror.CodeGeneral, envelope.Error.Code)
+	assert.Equal(t, "no config", envelope.Error.Message)
+	assert.Equal(t, "Create smartling.yml.", envelope.Error.Description)
+}
+
+func TestWriteErrorJSON(t *testing.T) {
+	err := clierror.UIError{Err: clierror.PartialFailureError{Failed: 1, Total: 2}, Operation: "import job"}
+
+	var stdout, stderr bytes.Buffer
+	writeErrorJSON(&stdout, &stderr, err)
+	var envelope ErrorEnvelope
+	assert.NoError(t, json.Unmarshal(stdout.Bytes(), &envelope))
+	assert.Equal(t, clierror.CodePartial, envelope.Error.Code)
+	assert.Empty(t, stderr.String())
+
+	stdout.Reset()
+	writeErrorJSON(&stdout, &stderr, clierror.Rendered(err))
+	assert.Empty(t, stdout.String())
+	assert.Equal(t, "1 of 2 items failed\n", stderr.String())
+}
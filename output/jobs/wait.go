@@ -0,0 +1,143 @@
+package jobs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	srv "github.com/Smartling/smartling-cli/services/jobs"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const waitBarWidth = 40
+
+// WaitChanges returns the lines describing what changed between two
+// consecutive snapshots. A nil prev describes the initial snapshot in full.
+func WaitChanges(prev *srv.WaitUpdate, next srv.WaitUpdate) []string {
+	if prev == nil {
+		lines := []string{fmt.Sprintf("Job %q (%s): %s, %.1f%% complete",
+			next.JobName, next.TranslationJobUID, next.JobStatus, next.PercentComplete)}
+		for _, locale := range next.Locales {
+			lines = append(lines, fmt.Sprintf("  %s: %.1f%%", locale.LocaleID, locale.PercentComplete))
+		}
+		return lines
+	}
+
+	stamp := next.CheckedAt.Format("15:04:05")
+	var lines []string
+	if prev.JobStatus != next.JobStatus {
+		lines = append(lines, fmt.Sprintf("%s status: %s → %s", stamp, prev.JobStatus, next.JobStatus))
+	}
+	before := make(map[string]float64, len(prev.Locales))
+	for _, locale := range prev.Locales {
+		before[locale.LocaleID] = locale.PercentComplete
+	}
+	for _, locale := range next.Locales {
+		was, ok := before[locale.LocaleID]
+		if ok && was == locale.PercentComplete {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s %s: %.1f%% → %.1f%%", stamp, locale.LocaleID, was, locale.PercentComplete))
+	}
+	if prev.PercentComplete != next.PercentComplete {
+		lines = append(lines, fmt.Sprintf("%s overall: %.1f%% → %.1f%%", stamp, prev.PercentComplete, next.PercentComplete))
+	}
+	return lines
+}
+
+// RenderWaitChanges prints progress changes as they arrive, until updates is closed.
+func RenderWaitChanges(w io.Writer, updates <-chan srv.WaitUpdate) {
+	var prev *srv.WaitUpdate
+	for update := range updates {
+		for _, line := range WaitChanges(prev, update) {
+			fmt.Fprintln(w, line)
+		}
+		prev = &update
+	}
+}
+
+// RunWaitView shows a live-updating progress view until updates is closed.
+// Quitting the view calls cancel, which should stop the producer.
+func RunWaitView(updates <-chan srv.WaitUpdate, until float64, cancel func()) error {
+	program := tea.NewProgram(newWaitModel(until, cancel))
+	go func() {
+		for update := range updates {
+			program.Send(update)
+		}
+		program.Send(waitDoneMsg{})
+	}()
+	_, err := program.Run()
+	return err
+}
+
+type waitDoneMsg struct{}
+
+type waitModel struct {
+	until  float64
+	cancel func()
+	bar    progress.Model
+	last   *srv.WaitUpdate
+}
+
+func newWaitModel(until float64, cancel func()) waitModel {
+	return waitModel{
+		until:  until,
+		cancel: cancel,
+		bar:    progress.New(progress.WithDefaultGradient(), progress.WithWidth(waitBarWidth)),
+	}
+}
+
+// Init inits waitModel
+func (m waitModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles progress snapshots and key presses
+func (m waitModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case srv.WaitUpdate:
+		m.last = &msg
+	case waitDoneMsg:
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			m.cancel()
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// View renders the current progress
+func (m waitModel) View() string {
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	if m.last == nil {
+		return dim.Render("Fetching job progress...") + "\n"
+	}
+
+	var b strings.Builder
+	bold := lipgloss.NewStyle().Bold(true)
+	fmt.Fprintf(&b, "%s %s\n", bold.Render(fmt.Sprintf("Waiting for %q", m.last.JobName)),
+		dim.Render(fmt.Sprintf("(%s) to reach %v%%", m.last.TranslationJobUID, m.until)))
+	fmt.Fprintf(&b, "Status: %s\n\n", m.last.JobStatus)
+
+	width := len("overall")
+	for _, locale := range m.last.Locales {
+		width = max(width, len(locale.LocaleID))
+	}
+	row := func(name string, percent float64) {
+		fmt.Fprintf(&b, "  %-*s %s\n", width, name, m.bar.ViewAs(percent/100))
+	}
+	row("overall", m.last.PercentComplete)
+	for _, locale := range m.last.Locales {
+		row(locale.LocaleID, locale.PercentComplete)
+	}
+
+	fmt.Fprintf(&b, "\n%s\n", dim.Render(fmt.Sprintf("Last checked %s. Press 'q' to stop waiting.",
+		m.last.CheckedAt.Format("15:04:05"))))
+	return b.String()
+}
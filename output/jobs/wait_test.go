@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	srv "github.com/Smartling/smartling-cli/services/jobs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitChanges(t *testing.T) {
+	checked := time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC)
+	first := srv.WaitUpdate{
+		TranslationJobUID: "aabbccdd1122",
+		JobName:           "Release",
+		JobStatus:         "AWAITING_AUTHORIZATION",
+		PercentComplete:   25,
+		Locales: []srv.LocaleProgress{
+			{LocaleID: "de-DE", PercentComplete: 50},
+			{LocaleID: "fr-FR", PercentComplete: 0},
+		},
+		CheckedAt: checked,
+	}
+	assert.Equal(t, []string{
+		`Job "Release" (aabbccdd1122): AWAITING_AUTHORIZATION, 25.0% complete`,
+		"  de-DE: 50.0%",
+		"  fr-FR: 0.0%",
+	}, WaitChanges(nil, first))
+
+	second := first
+	second.JobStatus = "IN_PROGRESS"
+	second.PercentComplete = 50
+	second.Locales = []srv.LocaleProgress{
+		{LocaleID: "de-DE", PercentComplete: 50},
+		{LocaleID: "fr-FR", PercentComplete: 50},
+	}
+	assert.Equal(t, []string{
+		"10:30:00 status: AWAITING_AUTHORIZATION → IN_PROGRESS",
+		"10:30:00 fr-FR: 0.0% → 50.0%",
+		"10:30:00 overall: 25.0% → 50.0%",
+	}, WaitChanges(&first, second))
+
+	assert.Empty(t, WaitChanges(&second, second))
+}
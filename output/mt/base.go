@@ -23,7 +23,7 @@ func RenderAndExitIfErr(err error) {
 		return
 	}
 	fmt.Println(RenderError(err))
-	os.Exit(1)
+	os.Exit(clierror.ExitCode(err))
 }
 
 // RenderError renders error
@@ -31,6 +31,9 @@ func RenderError(err error) string {
 	if err == nil {
 		return ""
 	}
+	if rendered, ok := err.(clierror.RenderedError); ok {
+		err = rendered.Err
+	}
 	uiErr, isUIError := err.(clierror.UIError)
 	if !isUIError {
 		errorStyle := lipgloss.NewStyle().
@@ -1,9 +1,12 @@
 // Package static provides generic format strategies for rendering
-// command results as JSON, human-readable text, or an ASCII table.
+// command results as JSON, human-readable text, an ASCII table, or CSV.
 package static
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
@@ -31,6 +34,8 @@ func GetOutputFormat[T Renderable](name string) OutputFormat[T] {
 		return JSONOutputFormat[T]{}
 	case "table":
 		return TableOutputFormat[T]{}
+	case "csv":
+		return CSVOutputFormat[T]{}
 	default:
 		return SimpleOutputFormat[T]{}
 	}
@@ -69,3 +74,72 @@ func (TableOutputFormat[T]) FormatAndRender(data T) {
 	}
 	fmt.Println(tbl)
 }
+
+// CSVRecords is implemented by payloads whose CSV form differs from their
+// table, e.g. when the table carries summary rows.
+type CSVRecords interface {
+	CSVData() (headers []string, records [][]string)
+}
+
+// CSVOutputFormat prints the payload as CSV. It uses CSVData when the
+// payload implements CSVRecords and TableData otherwise.
+type CSVOutputFormat[T Renderable] struct{}
+
+// FormatAndRender writes the payload as CSV to stdout.
+func (CSVOutputFormat[T]) FormatAndRender(data T) {
+	var headers []string
+	var rows [][]string
+	if records, ok := any(data).(CSVRecords); ok {
+		headers, rows = records.CSVData()
+	} else {
+		headers, rows = data.TableData()
+	}
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write(headers)
+	_ = w.WriteAll(rows)
+}
+
+// Line is implemented by list items which render on a single line.
+type Line interface {
+	SimpleLine() string
+}
+
+// Stream returns an emit function which renders items as soon as they are
+// listed: one JSON document per line (NDJSON) for the json format and one
+// simple line per item for the simple format. For table and csv, which need
+// every row before rendering, it returns false and the caller collects items.
+func Stream[T Line](name string) (func(T) error, bool) {
+	switch name {
+	case "table", "csv":
+		return nil, false
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		return func(item T) error { return enc.Encode(item) }, true
+	default:
+		return func(item T) error {
+			_, err := fmt.Println(item.SimpleLine())
+			return err
+		}, true
+	}
+}
+
+// RenderAll renders every item which walk lists. It streams them for the
+// json and simple formats and, for table and csv, collects them and renders
+// the payload which collect builds from them.
+func RenderAll[T Line, R Renderable](name string, walk func(emit func(T) error) error, collect func([]T) R) error {
+	emit, streamed := Stream[T](name)
+	var items []T
+	if !streamed {
+		emit = func(item T) error {
+			items = append(items, item)
+			return nil
+		}
+	}
+	if err := walk(emit); err != nil {
+		return err
+	}
+	if !streamed {
+		GetOutputFormat[R](name).FormatAndRender(collect(items))
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package devserver
+
+import (
+	"net/http"
+)
+
+func (s *Server) registerAuth() {
+	s.mux.HandleFunc("POST /auth-api/v2/authenticate", s.authenticate)
+	s.mux.HandleFunc("POST /auth-api/v2/authenticate/refresh", s.refresh)
+}
+
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserIdentifier string `json:"userIdentifier"`
+		UserSecret     string `json:"userSecret"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if req.UserIdentifier == "" || req.UserSecret == "" {
+		writeError(w, http.StatusUnauthorized, "AUTHENTICATION_ERROR", "user identifier and secret are required")
+		return
+	}
+	if s.params.UserID != "" && (req.UserIdentifier != s.params.UserID || req.UserSecret != s.params.Secret) {
+		writeError(w, http.StatusUnauthorized, "AUTHENTICATION_ERROR", "invalid credentials")
+		return
+	}
+	s.issueTokens(w)
+}
+
+func (s *Server) refresh(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		RefreshToken string `json:"refreshToken"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	s.mu.Lock()
+	_, ok := s.tokens[req.RefreshToken]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "AUTHENTICATION_ERROR", "invalid refresh token")
+		return
+	}
+	s.issueTokens(w)
+}
+
+func (s *Server) issueTokens(w http.ResponseWriter) {
+	access := "access-" + s.nextUID(24)
+	refresh := "refresh-" + s.nextUID(24)
+
+	s.mu.Lock()
+	s.tokens[access] = struct{}{}
+	s.tokens[refresh] = struct{}{}
+	s.mu.Unlock()
+
+	writeData(w, http.StatusOK, map[string]any{
+		"accessToken":      access,
+		"expiresIn":        3600,
+		"refreshToken":     refresh,
+		"refreshExpiresIn": 86400,
+		"tokenType":        "Bearer",
+	})
+}
@@ -0,0 +1,160 @@
+package devserver
+
+import (
+	"net/http"
+
+	api "github.com/Smartling/api-sdk-go/api/batches"
+)
+
+type storedBatch struct {
+	UID       string
+	ProjectID string
+	JobUID    string
+	Authorize bool
+	FileURIs  []string
+	Uploaded  map[string]bool
+}
+
+func (s *Server) registerBatches() {
+	base := "/job-batches-api/v2/projects/{projectID}"
+	s.mux.HandleFunc("POST "+base+"/jobs", s.createBatchJob)
+	s.mux.HandleFunc("POST "+base+"/batches", s.createBatch)
+	s.mux.HandleFunc("POST "+base+"/batches/{batchUID}/file", s.uploadBatchFile)
+	s.mux.HandleFunc("GET "+base+"/batches/{batchUID}", s.batchStatus)
+}
+
+func (s *Server) createBatchJob(w http.ResponseWriter, r *http.Request) {
+	var req api.CreateJobPayload
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if req.NameTemplate == "" {
+		writeValidation(w, "nameTemplate is required")
+		return
+	}
+	projectID := r.PathValue("projectID")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.project(projectID); !ok {
+		writeNotFound(w, "project")
+		return
+	}
+
+	var job *storedJob
+	if req.Mode == api.ReuseExistingMode {
+		for _, existing := range s.jobs {
+			if existing.ProjectID == projectID && existing.Name == req.NameTemplate {
+				job = existing
+				break
+			}
+		}
+	}
+	if job == nil {
+		job = s.newJob(projectID, req.NameTemplate, req.Description, req.TargetLocaleIds)
+	}
+	writeData(w, http.StatusOK, job.toData())
+}
+
+func (s *Server) createBatch(w http.ResponseWriter, r *http.Request) {
+	var req api.CreateBatchPayload
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	projectID := r.PathValue("projectID")
+
+	s.mu.Lock()
+	job, ok := s.jobs[req.TranslationJobUID]
+	s.mu.Unlock()
+	if !ok || job.ProjectID != projectID {
+		writeNotFound(w, "job")
+		return
+	}
+
+	batch := &storedBatch{
+		UID:       s.nextUID(12),
+		ProjectID: projectID,
+		JobUID:    req.TranslationJobUID,
+		Authorize: req.Authorize,
+		FileURIs:  req.FileUris,
+		Uploaded:  make(map[string]bool),
+	}
+	s.mu.Lock()
+	s.batches[batch.UID] = batch
+	s.mu.Unlock()
+
+	writeData(w, http.StatusOK, map[string]string{"batchUid": batch.UID})
+}
+
+func (s *Server) uploadBatchFile(w http.ResponseWriter, r *http.Request) {
+	uri, fileType, content, ok := readUploadForm(w, r, "fileUri")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batch, ok := s.batches[r.PathValue("batchUID")]
+	if !ok || batch.ProjectID != r.PathValue("projectID") {
+		writeNotFound(w, "batch")
+		return
+	}
+	if !contains(batch.FileURIs, uri) {
+		writeValidation(w, "file "+uri+" is not registered in batch")
+		return
+	}
+
+	s.storeFile(s.files[batch.ProjectID], uri, fileType, content)
+	batch.Uploaded[uri] = true
+
+	job := s.jobs[batch.JobUID]
+	if !contains(job.Files, uri) {
+		job.Files = append(job.Files, uri)
+	}
+	if batch.Authorize || len(r.MultipartForm.Value["localeIdsToAuthorize[]"]) > 0 {
+		job.Status = jobStatusInProgress
+	}
+	job.Modified = s.now()
+
+	writeData(w, http.StatusAccepted, nil)
+}
+
+func (s *Server) batchStatus(w http.ResponseWriter, r *http.Request) {
+	type fileStatus struct {
+		FileURI     string `json:"fileUri"`
+		Status      string `json:"status"`
+		UpdatedDate string `json:"updatedDate"`
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batch, ok := s.batches[r.PathValue("batchUID")]
+	if !ok || batch.ProjectID != r.PathValue("projectID") {
+		writeNotFound(w, "batch")
+		return
+	}
+
+	status := "COMPLETED"
+	files := []fileStatus{}
+	now := formatTime(s.now())
+	for _, uri := range batch.FileURIs {
+		fileState := "COMPLETED"
+		if !batch.Uploaded[uri] {
+			fileState = "UPLOADING"
+			status = "UPLOADING"
+		}
+		files = append(files, fileStatus{FileURI: uri, Status: fileState, UpdatedDate: now})
+	}
+
+	writeData(w, http.StatusOK, map[string]any{
+		"authorized":        batch.Authorize,
+		"files":             files,
+		"generalErrors":     "",
+		"projectId":         batch.ProjectID,
+		"status":            status,
+		"translationJobUid": batch.JobUID,
+		"updatedDate":       now,
+	})
+}
@@ -0,0 +1,320 @@
+package devserver
+
+import (
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	sdkfile "github.com/Smartling/api-sdk-go/helpers/sm_file"
+	"github.com/Smartling/api-sdk-go/helpers/utc"
+)
+
+// maxUploadMemory bounds multipart parsing for uploads.
+const maxUploadMemory = 32 << 20
+
+type storedFile struct {
+	URI          string
+	FileType     sdkfile.FileType
+	Content      []byte
+	LastUploaded time.Time
+	// Translations holds imported translations by locale.
+	Translations map[string][]byte
+}
+
+func (f *storedFile) toFile() sdkfile.File {
+	return sdkfile.File{
+		FileURI:      f.URI,
+		FileType:     f.FileType,
+		LastUploaded: utc.UTC{Time: f.LastUploaded.UTC().Truncate(time.Second)},
+	}
+}
+
+func (s *Server) registerFiles() {
+	base := "/files-api/v2/projects/{projectID}"
+	s.mux.HandleFunc("GET "+base+"/files/list", s.listFiles)
+	s.mux.HandleFunc("POST "+base+"/file", s.uploadFile)
+	s.mux.HandleFunc("GET "+base+"/file", s.downloadFile)
+	s.mux.HandleFunc("GET "+base+"/file/status", s.fileStatus)
+	s.mux.HandleFunc("POST "+base+"/file/rename", s.renameFile)
+	s.mux.HandleFunc("POST "+base+"/file/delete", s.deleteFile)
+	s.mux.HandleFunc("GET "+base+"/locales/{localeID}/file", s.downloadTranslation)
+	s.mux.HandleFunc("POST "+base+"/locales/{localeID}/file/import", s.importTranslation)
+}
+
+// projectFiles returns files of a project; must be called with s.mu held.
+func (s *Server) projectFiles(w http.ResponseWriter, r *http.Request) (map[string]*storedFile, bool) {
+	files, ok := s.files[r.PathValue("projectID")]
+	if !ok {
+		writeNotFound(w, "project")
+	}
+	return files, ok
+}
+
+func (s *Server) listFiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	mask := strings.ToLower(query.Get("uriMask"))
+	fileTypes := query["fileTypes[]"]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, ok := s.projectFiles(w, r)
+	if !ok {
+		return
+	}
+
+	items := []sdkfile.File{}
+	for _, file := range files {
+		if mask != "" && !strings.Contains(strings.ToLower(file.URI), mask) {
+			continue
+		}
+		if len(fileTypes) > 0 && !contains(fileTypes, string(file.FileType)) {
+			continue
+		}
+		items = append(items, file.toFile())
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].FileURI < items[j].FileURI })
+
+	offset, end := page(r, len(items))
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": len(items),
+		"items":      items[offset:end],
+	})
+}
+
+func (s *Server) uploadFile(w http.ResponseWriter, r *http.Request) {
+	uri, fileType, content, ok := readUploadForm(w, r, "fileUri")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, ok := s.projectFiles(w, r)
+	if !ok {
+		return
+	}
+	_, overwritten := files[uri]
+	s.storeFile(files, uri, fileType, content)
+
+	writeData(w, http.StatusOK, sdkfile.FileUploadResult{
+		Overwritten: overwritten,
+		StringCount: countStrings(content),
+		WordCount:   countWords(content),
+	})
+}
+
+// storeFile puts file content into the project; must be called with s.mu held.
+func (s *Server) storeFile(files map[string]*storedFile, uri string, fileType sdkfile.FileType, content []byte) {
+	file, ok := files[uri]
+	if !ok {
+		file = &storedFile{URI: uri, Translations: make(map[string][]byte)}
+		files[uri] = file
+	}
+	file.FileType = fileType
+	file.Content = content
+	file.LastUploaded = s.now()
+}
+
+func (s *Server) downloadFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	files, ok := s.projectFiles(w, r)
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	file, ok := files[r.URL.Query().Get("fileUri")]
+	var content []byte
+	if ok {
+		content = file.Content
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(content)
+}
+
+func (s *Server) downloadTranslation(w http.ResponseWriter, r *http.Request) {
+	locale := r.PathValue("localeID")
+
+	s.mu.Lock()
+	files, ok := s.projectFiles(w, r)
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	file, ok := files[r.URL.Query().Get("fileUri")]
+	var content []byte
+	if ok {
+		content = file.Content
+		if translation, translated := file.Translations[locale]; translated {
+			content = translation
+		}
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(content)
+}
+
+func (s *Server) fileStatus(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, ok := s.projectFiles(w, r)
+	if !ok {
+		return
+	}
+	file, ok := files[r.URL.Query().Get("fileUri")]
+	if !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	details, _ := s.project(r.PathValue("projectID"))
+
+	stringCount := countStrings(file.Content)
+	wordCount := countWords(file.Content)
+	status := sdkfile.FileStatus{
+		File:             file.toFile(),
+		TotalStringCount: stringCount,
+		TotalWordCount:   wordCount,
+	}
+	for _, locale := range details.TargetLocales {
+		translation := sdkfile.FileStatusTranslation{LocaleID: locale.LocaleID}
+		if _, translated := file.Translations[locale.LocaleID]; translated {
+			translation.CompletedStringCount = stringCount
+			translation.CompletedWordCount = wordCount
+		} else {
+			translation.AuthorizedStringCount = stringCount
+			translation.AuthorizedWordCount = wordCount
+		}
+		status.Items = append(status.Items, translation)
+	}
+	status.TotalCount = len(status.Items)
+
+	writeData(w, http.StatusOK, status)
+}
+
+func (s *Server) renameFile(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	oldURI, newURI := r.FormValue("fileUri"), r.FormValue("newFileUri")
+	if oldURI == "" || newURI == "" {
+		writeValidation(w, "fileUri and newFileUri are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, ok := s.projectFiles(w, r)
+	if !ok {
+		return
+	}
+	file, ok := files[oldURI]
+	if !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	if _, exists := files[newURI]; exists {
+		writeValidation(w, "file "+newURI+" already exists")
+		return
+	}
+	delete(files, oldURI)
+	file.URI = newURI
+	files[newURI] = file
+	writeData(w, http.StatusOK, nil)
+}
+
+func (s *Server) deleteFile(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	uri := r.FormValue("fileUri")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, ok := s.projectFiles(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := files[uri]; !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	delete(files, uri)
+	writeData(w, http.StatusAccepted, nil)
+}
+
+func (s *Server) importTranslation(w http.ResponseWriter, r *http.Request) {
+	uri, _, content, ok := readUploadForm(w, r, "fileUri")
+	if !ok {
+		return
+	}
+	locale := r.PathValue("localeID")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, ok := s.projectFiles(w, r)
+	if !ok {
+		return
+	}
+	file, ok := files[uri]
+	if !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	if _, translated := file.Translations[locale]; translated && r.FormValue("overwrite") != "true" {
+		writeValidation(w, "translation for "+locale+" already exists")
+		return
+	}
+	file.Translations[locale] = content
+
+	writeData(w, http.StatusOK, map[string]any{
+		"wordCount":               countWords(content),
+		"stringCount":             countStrings(content),
+		"translationImportErrors": []string{},
+	})
+}
+
+// readUploadForm parses multipart form with a "file" part and a "fileType" field.
+func readUploadForm(w http.ResponseWriter, r *http.Request, uriField string) (string, sdkfile.FileType, []byte, bool) {
+	if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+		writeValidation(w, err.Error())
+		return "", "", nil, false
+	}
+	uri := r.FormValue(uriField)
+	if uri == "" {
+		writeValidation(w, uriField+" is required")
+		return "", "", nil, false
+	}
+	part, _, err := r.FormFile("file")
+	if err != nil {
+		writeValidation(w, "file is required")
+		return "", "", nil, false
+	}
+	defer func() { _ = part.Close() }()
+	content, err := io.ReadAll(part)
+	if err != nil {
+		writeValidation(w, err.Error())
+		return "", "", nil, false
+	}
+	return uri, sdkfile.FileType(r.FormValue("fileType")), content, true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,394 @@
+package devserver
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/Smartling/api-sdk-go/api/glossary"
+)
+
+type storedGlossary struct {
+	UID              string
+	AccountUID       string
+	Name             string
+	Description      string
+	VerificationMode bool
+	Archived         bool
+	LocaleIDs        []string
+	FallbackLocales  []api.FallbackLocale
+	Created          time.Time
+	Modified         time.Time
+	// Content holds the last confirmed import file; it is dropped when an
+	// entry is edited, and exports are then rendered from Entries.
+	Content   []byte
+	MediaType string
+	FileName  string
+	Entries   []*storedEntry
+}
+
+// canceledImportStatus marks an import discarded before confirmation.
+const canceledImportStatus = "CANCELED"
+
+type storedImport struct {
+	UID         string
+	GlossaryUID string
+	Status      string
+	Content     []byte
+	MediaType   string
+	FileName    string
+}
+
+type glossaryData struct {
+	GlossaryUID      string               `json:"glossaryUid"`
+	AccountUID       string               `json:"accountUid"`
+	GlossaryName     string               `json:"glossaryName"`
+	Description      string               `json:"description"`
+	VerificationMode bool                 `json:"verificationMode"`
+	Archived         bool                 `json:"archived"`
+	CreatedDate      string               `json:"createdDate"`
+	ModifiedDate     string               `json:"modifiedDate"`
+	LocaleIDs        []string             `json:"localeIds"`
+	FallbackLocales  []api.FallbackLocale `json:"fallbackLocales"`
+}
+
+func (g *storedGlossary) toData() glossaryData {
+	return glossaryData{
+		GlossaryUID:      g.UID,
+		AccountUID:       g.AccountUID,
+		GlossaryName:     g.Name,
+		Description:      g.Description,
+		VerificationMode: g.VerificationMode,
+		Archived:         g.Archived,
+		CreatedDate:      formatTime(g.Created),
+		ModifiedDate:     formatTime(g.Modified),
+		LocaleIDs:        append([]string{}, g.LocaleIDs...),
+		FallbackLocales:  append([]api.FallbackLocale{}, g.FallbackLocales...),
+	}
+}
+
+func (s *Server) registerGlossaries() {
+	base := "/glossary-api/v3/accounts/{accountUID}/glossaries"
+	s.mux.HandleFunc("POST "+base, s.createGlossary)
+	s.mux.HandleFunc("POST "+base+"/search", s.searchGlossaries)
+	s.mux.HandleFunc("POST "+base+"/archive", s.archiveGlossaries)
+	s.mux.HandleFunc("GET "+base+"/{glossaryUID}", s.getGlossary)
+	s.mux.HandleFunc("PUT "+base+"/{glossaryUID}", s.updateGlossary)
+	s.mux.HandleFunc("DELETE "+base+"/{glossaryUID}", s.deleteGlossary)
+	s.mux.HandleFunc("POST "+base+"/{glossaryUID}/import", s.importGlossary)
+	s.mux.HandleFunc("GET "+base+"/{glossaryUID}/import/{importUID}", s.glossaryImportStatus)
+	s.mux.HandleFunc("POST "+base+"/{glossaryUID}/import/{importUID}/confirm", s.confirmGlossaryImport)
+	s.mux.HandleFunc("POST "+base+"/{glossaryUID}/import/{importUID}/cancel", s.cancelGlossaryImport)
+	s.mux.HandleFunc("POST "+base+"/{glossaryUID}/entries/download", s.exportGlossary)
+	s.registerGlossaryEntries(base + "/{glossaryUID}/entries")
+}
+
+// findGlossary looks up a glossary in the account; must be called with s.mu held.
+func (s *Server) findGlossary(w http.ResponseWriter, r *http.Request) (*storedGlossary, bool) {
+	glossary, ok := s.glossaries[r.PathValue("glossaryUID")]
+	if !ok || glossary.AccountUID != r.PathValue("accountUID") {
+		writeNotFound(w, "glossary")
+		return nil, false
+	}
+	return glossary, true
+}
+
+func (s *Server) createGlossary(w http.ResponseWriter, r *http.Request) {
+	var req api.CreateGlossaryRequest
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if req.GlossaryName == "" {
+		writeValidation(w, "glossaryName is required")
+		return
+	}
+
+	uid := s.nextUUID()
+	now := s.now()
+	glossary := &storedGlossary{
+		UID:              uid,
+		AccountUID:       r.PathValue("accountUID"),
+		Name:             req.GlossaryName,
+		Description:      req.Description,
+		VerificationMode: req.VerificationMode,
+		LocaleIDs:        req.LocaleIDs,
+		FallbackLocales:  req.FallbackLocales,
+		Created:          now,
+		Modified:         now,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.glossaries[uid] = glossary
+	writeData(w, http.StatusOK, glossary.toData())
+}
+
+func (s *Server) searchGlossaries(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Query string `json:"query"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	accountUID := r.PathValue("accountUID")
+	query := strings.ToLower(req.Query)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := []glossaryData{}
+	for _, glossary := range s.glossaries {
+		if glossary.AccountUID != accountUID {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(glossary.Name), query) {
+			continue
+		}
+		items = append(items, glossary.toData())
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].GlossaryUID < items[j].GlossaryUID })
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": len(items),
+		"items":      items,
+	})
+}
+
+func (s *Server) getGlossary(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	glossary, ok := s.findGlossary(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, glossary.toData())
+}
+
+func (s *Server) updateGlossary(w http.ResponseWriter, r *http.Request) {
+	var req api.CreateGlossaryRequest
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if req.GlossaryName == "" {
+		writeValidation(w, "glossaryName is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	glossary, ok := s.findGlossary(w, r)
+	if !ok {
+		return
+	}
+	glossary.Name = req.GlossaryName
+	glossary.Description = req.Description
+	glossary.VerificationMode = req.VerificationMode
+	glossary.LocaleIDs = req.LocaleIDs
+	glossary.FallbackLocales = req.FallbackLocales
+	glossary.Modified = s.now()
+	writeData(w, http.StatusOK, glossary.toData())
+}
+
+func (s *Server) archiveGlossaries(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		GlossaryUIDs []string `json:"glossaryUids"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	accountUID := r.PathValue("accountUID")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, glossaryUID := range req.GlossaryUIDs {
+		if glossary, ok := s.glossaries[glossaryUID]; !ok || glossary.AccountUID != accountUID {
+			writeNotFound(w, "glossary")
+			return
+		}
+	}
+	now := s.now()
+	for _, glossaryUID := range req.GlossaryUIDs {
+		s.glossaries[glossaryUID].Archived = true
+		s.glossaries[glossaryUID].Modified = now
+	}
+	writeData(w, http.StatusOK, nil)
+}
+
+func (s *Server) deleteGlossary(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	glossary, ok := s.findGlossary(w, r)
+	if !ok {
+		return
+	}
+	delete(s.glossaries, glossary.UID)
+	writeData(w, http.StatusOK, nil)
+}
+
+func (s *Server) importGlossary(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	part, _, err := r.FormFile("importFile")
+	if err != nil {
+		writeValidation(w, "importFile is required")
+		return
+	}
+	defer func() { _ = part.Close() }()
+	content, err := io.ReadAll(part)
+	if err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	glossary, ok := s.findGlossary(w, r)
+	if !ok {
+		return
+	}
+	s.seq++
+	imp := &storedImport{
+		UID:         encodeUID(s.seq, 36),
+		GlossaryUID: glossary.UID,
+		Status:      api.PendingImportStatus,
+		Content:     content,
+		MediaType:   r.FormValue("importFileMediaType"),
+		FileName:    r.FormValue("importFileName"),
+	}
+	s.imports[imp.UID] = imp
+
+	entries := max(countStrings(content)-1, 0)
+	writeData(w, http.StatusOK, map[string]any{
+		"glossaryImport": map[string]string{
+			"glossaryUid":  glossary.UID,
+			"importUid":    imp.UID,
+			"importStatus": imp.Status,
+		},
+		"entryChanges": map[string]int{
+			"newEntries":           entries,
+			"existingEntryUpdates": 0,
+			"notMatchedEntries":    0,
+			"entriesToArchive":     0,
+		},
+		"translationChanges": []any{},
+		"warnings":           []any{},
+	})
+}
+
+// findImport looks up an import of the glossary; must be called with s.mu held.
+func (s *Server) findImport(w http.ResponseWriter, r *http.Request) (*storedImport, bool) {
+	if _, ok := s.findGlossary(w, r); !ok {
+		return nil, false
+	}
+	imp, ok := s.imports[r.PathValue("importUID")]
+	if !ok || imp.GlossaryUID != r.PathValue("glossaryUID") {
+		writeNotFound(w, "import")
+		return nil, false
+	}
+	return imp, true
+}
+
+func (s *Server) glossaryImportStatus(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	imp, ok := s.findImport(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, map[string]string{
+		"glossaryUid":  imp.GlossaryUID,
+		"importUid":    imp.UID,
+		"importStatus": imp.Status,
+	})
+}
+
+func (s *Server) confirmGlossaryImport(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	imp, ok := s.findImport(w, r)
+	if !ok {
+		return
+	}
+	if imp.Status != api.PendingImportStatus {
+		writeValidation(w, "import is not pending")
+		return
+	}
+	glossary := s.glossaries[imp.GlossaryUID]
+	glossary.Content = imp.Content
+	glossary.MediaType = imp.MediaType
+	glossary.FileName = imp.FileName
+	glossary.Modified = s.now()
+	s.importEntries(glossary, imp)
+	imp.Status = api.SuccessfulImportStatus
+	writeData(w, http.StatusOK, nil)
+}
+
+func (s *Server) cancelGlossaryImport(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	imp, ok := s.findImport(w, r)
+	if !ok {
+		return
+	}
+	if imp.Status != api.PendingImportStatus {
+		writeValidation(w, "import is not pending")
+		return
+	}
+	imp.Status = canceledImportStatus
+	imp.Content = nil
+	writeData(w, http.StatusOK, nil)
+}
+
+// exportRequest is the body of the export call with the full entry filter.
+type exportRequest struct {
+	api.ExportGlossaryRequest
+	Filter entryFilter `json:"filter"`
+}
+
+func (s *Server) exportGlossary(w http.ResponseWriter, r *http.Request) {
+	var req exportRequest
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = "csv"
+	}
+
+	s.mu.Lock()
+	glossary, ok := s.findGlossary(w, r)
+	var content []byte
+	var mediaType string
+	if ok {
+		content, mediaType = glossary.Content, glossary.MediaType
+		if len(content) == 0 || !strings.EqualFold(strings.TrimPrefix(filepath.Ext(glossary.FileName), "."), format) ||
+			len(req.LocaleIds) > 0 && !slices.Equal(req.LocaleIds, glossary.LocaleIDs) || req.Filter.Labels != nil {
+			content, mediaType = glossary.renderEntries(format, req, s.labelNames)
+		}
+	}
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+glossary.UID+`.`+format+`"`)
+	_, _ = w.Write(content)
+}
+
+func emptyGlossaryCSV(locales []string) string {
+	columns := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		columns = append(columns, "Term ("+locale+")")
+	}
+	return strings.Join(columns, ",") + "\n"
+}
@@ -0,0 +1,393 @@
+package devserver
+
+import (
+	"bytes"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+
+	api "github.com/Smartling/api-sdk-go/api/glossary"
+)
+
+// Entry states of the Glossary Entries API.
+const (
+	activeEntryState   = "ACTIVE"
+	archivedEntryState = "ARCHIVED"
+)
+
+type entryTranslation struct {
+	LocaleID       string `json:"localeId"`
+	Term           string `json:"term"`
+	Notes          string `json:"notes,omitempty"`
+	DoNotTranslate bool   `json:"doNotTranslate,omitempty"`
+}
+
+type storedEntry struct {
+	UID          string
+	Definition   string
+	PartOfSpeech string
+	LabelUIDs    []string
+	State        string
+	Translations []entryTranslation
+	Created      time.Time
+	Modified     time.Time
+}
+
+type entryData struct {
+	EntryUID     string             `json:"entryUid"`
+	Definition   string             `json:"definition,omitempty"`
+	PartOfSpeech string             `json:"partOfSpeech,omitempty"`
+	LabelUIDs    []string           `json:"labelUids"`
+	EntryState   string             `json:"entryState"`
+	Translations []entryTranslation `json:"translations"`
+	CreatedDate  string             `json:"createdDate"`
+	ModifiedDate string             `json:"modifiedDate"`
+}
+
+type entryRequest struct {
+	Definition   string             `json:"definition"`
+	PartOfSpeech string             `json:"partOfSpeech"`
+	LabelUIDs    []string           `json:"labelUids"`
+	Translations []entryTranslation `json:"translations"`
+}
+
+// entryFilter is the entry filter of the search and export calls. Its labels
+// object carries the label UIDs the SDK filter has no field for.
+type entryFilter struct {
+	api.ExportGlossaryFilter
+	Labels *labelsFilter `json:"labels,omitempty"`
+}
+
+type labelsFilter struct {
+	Type      string   `json:"type,omitempty"`
+	LabelUIDs []string `json:"labelUids,omitempty"`
+}
+
+func (e *storedEntry) toData() entryData {
+	return entryData{
+		EntryUID:     e.UID,
+		Definition:   e.Definition,
+		PartOfSpeech: e.PartOfSpeech,
+		LabelUIDs:    append([]string{}, e.LabelUIDs...),
+		EntryState:   e.State,
+		Translations: append([]entryTranslation{}, e.Translations...),
+		CreatedDate:  formatTime(e.Created),
+		ModifiedDate: formatTime(e.Modified),
+	}
+}
+
+func (e *storedEntry) translation(localeID string) (entryTranslation, bool) {
+	for _, t := range e.Translations {
+		if t.LocaleID == localeID {
+			return t, true
+		}
+	}
+	return entryTranslation{}, false
+}
+
+func (s *Server) registerGlossaryEntries(base string) {
+	s.mux.HandleFunc("POST "+base, s.createGlossaryEntry)
+	s.mux.HandleFunc("POST "+base+"/search", s.searchGlossaryEntries)
+	s.mux.HandleFunc("POST "+base+"/archive", s.archiveGlossaryEntries)
+	s.mux.HandleFunc("GET "+base+"/{entryUID}", s.getGlossaryEntry)
+	s.mux.HandleFunc("PUT "+base+"/{entryUID}", s.updateGlossaryEntry)
+}
+
+// findEntry looks up an entry of the glossary; must be called with s.mu held.
+func (s *Server) findEntry(w http.ResponseWriter, r *http.Request) (*storedGlossary, *storedEntry, bool) {
+	glossary, ok := s.findGlossary(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	for _, entry := range glossary.Entries {
+		if entry.UID == r.PathValue("entryUID") {
+			return glossary, entry, true
+		}
+	}
+	writeNotFound(w, "entry")
+	return nil, nil, false
+}
+
+func (s *Server) searchGlossaryEntries(w http.ResponseWriter, r *http.Request) {
+	var filter entryFilter
+	if err := readJSON(r, &filter); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	glossary, ok := s.findGlossary(w, r)
+	if !ok {
+		return
+	}
+	items := []entryData{}
+	for _, entry := range glossary.Entries {
+		if matchesEntryFilter(entry, filter) {
+			items = append(items, entry.toData())
+		}
+	}
+	total := len(items)
+	offset := min(max(filter.Paging.Offset, 0), total)
+	end := total
+	if filter.Paging.Limit > 0 {
+		end = min(offset+filter.Paging.Limit, total)
+	}
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": total,
+		"items":      items[offset:end],
+	})
+}
+
+// matchesEntryFilter applies the filter fields the dev server understands:
+// query, entry UIDs, entry state (ACTIVE unless set), the missing, present
+// and DNT translation locales, and label UIDs (any of them, or all of them
+// for the ALL labels type).
+func matchesEntryFilter(entry *storedEntry, filter entryFilter) bool {
+	state := filter.EntryState
+	if state == "" {
+		state = activeEntryState
+	}
+	if !strings.EqualFold(entry.State, state) {
+		return false
+	}
+	if len(filter.EntryUids) > 0 && !slices.Contains(filter.EntryUids, entry.UID) {
+		return false
+	}
+	if filter.MissingTranslationLocaleId != "" {
+		if _, ok := entry.translation(filter.MissingTranslationLocaleId); ok {
+			return false
+		}
+	}
+	if filter.PresentTranslationLocaleId != "" {
+		if _, ok := entry.translation(filter.PresentTranslationLocaleId); !ok {
+			return false
+		}
+	}
+	if filter.DntLocaleId != "" {
+		if t, ok := entry.translation(filter.DntLocaleId); !ok || !t.DoNotTranslate {
+			return false
+		}
+	}
+	if filter.Labels != nil && len(filter.Labels.LabelUIDs) > 0 {
+		matched := 0
+		for _, labelUID := range filter.Labels.LabelUIDs {
+			if slices.Contains(entry.LabelUIDs, labelUID) {
+				matched++
+			}
+		}
+		if matched == 0 || strings.EqualFold(filter.Labels.Type, "ALL") && matched < len(filter.Labels.LabelUIDs) {
+			return false
+		}
+	}
+	if filter.Query == "" {
+		return true
+	}
+	query := strings.ToLower(filter.Query)
+	if strings.Contains(strings.ToLower(entry.Definition), query) {
+		return true
+	}
+	for _, t := range entry.Translations {
+		if strings.Contains(strings.ToLower(t.Term), query) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) getGlossaryEntry(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, entry, ok := s.findEntry(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, entry.toData())
+}
+
+func (s *Server) createGlossaryEntry(w http.ResponseWriter, r *http.Request) {
+	var req entryRequest
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	glossary, ok := s.findGlossary(w, r)
+	if !ok {
+		return
+	}
+	if message := validateEntryRequest(glossary, req); message != "" {
+		writeValidation(w, message)
+		return
+	}
+	s.seq++
+	now := s.now()
+	entry := &storedEntry{UID: encodeUUID(s.seq), State: activeEntryState, Created: now}
+	entry.apply(req, now)
+	glossary.Entries = append(glossary.Entries, entry)
+	glossary.entriesEdited(now)
+	writeData(w, http.StatusOK, entry.toData())
+}
+
+func (s *Server) updateGlossaryEntry(w http.ResponseWriter, r *http.Request) {
+	var req entryRequest
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	glossary, entry, ok := s.findEntry(w, r)
+	if !ok {
+		return
+	}
+	if message := validateEntryRequest(glossary, req); message != "" {
+		writeValidation(w, message)
+		return
+	}
+	now := s.now()
+	entry.apply(req, now)
+	glossary.entriesEdited(now)
+	writeData(w, http.StatusOK, entry.toData())
+}
+
+func (s *Server) archiveGlossaryEntries(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Filter api.ExportGlossaryFilter `json:"filter"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if len(req.Filter.EntryUids) == 0 {
+		writeValidation(w, "filter.entryUids is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	glossary, ok := s.findGlossary(w, r)
+	if !ok {
+		return
+	}
+	now := s.now()
+	for _, entry := range glossary.Entries {
+		if slices.Contains(req.Filter.EntryUids, entry.UID) {
+			entry.State = archivedEntryState
+			entry.Modified = now
+		}
+	}
+	glossary.entriesEdited(now)
+	writeData(w, http.StatusOK, nil)
+}
+
+func validateEntryRequest(glossary *storedGlossary, req entryRequest) string {
+	if len(req.Translations) == 0 {
+		return "translations are required"
+	}
+	for _, t := range req.Translations {
+		if !slices.Contains(glossary.LocaleIDs, t.LocaleID) {
+			return "locale " + t.LocaleID + " is not a glossary locale"
+		}
+		if t.Term == "" {
+			return "term of locale " + t.LocaleID + " is empty"
+		}
+	}
+	return ""
+}
+
+func (e *storedEntry) apply(req entryRequest, now time.Time) {
+	e.Definition = req.Definition
+	e.PartOfSpeech = req.PartOfSpeech
+	e.LabelUIDs = append([]string{}, req.LabelUIDs...)
+	e.Translations = append([]entryTranslation{}, req.Translations...)
+	e.Modified = now
+}
+
+// entriesEdited drops the imported file so that exports reflect the edit.
+func (g *storedGlossary) entriesEdited(now time.Time) {
+	g.Content, g.MediaType, g.FileName = nil, "", ""
+	g.Modified = now
+}
+
+// importEntries replaces the entries of the glossary with the entries of a
+// confirmed import, keeping the entries whose UIDs the file names. Names in a
+// Labels column are mapped to the labels of the account, otherwise kept
+// entries keep their labels; must be called with s.mu held.
+func (s *Server) importEntries(glossary *storedGlossary, imp *storedImport) {
+	f, err := glossaryfile.Parse(imp.Content, glossaryfile.FormatFromMediaType(imp.MediaType))
+	if err != nil {
+		return
+	}
+	now := s.now()
+	entries := make([]*storedEntry, 0, len(f.Entries))
+	for _, e := range f.Entries {
+		entry := &storedEntry{UID: e.EntryUID, State: activeEntryState, Created: now}
+		if i := slices.IndexFunc(glossary.Entries, func(old *storedEntry) bool { return old.UID == e.EntryUID }); e.EntryUID == "" || i < 0 {
+			s.seq++
+			entry.UID = encodeUUID(s.seq)
+		} else {
+			entry.Created = glossary.Entries[i].Created
+			entry.LabelUIDs = glossary.Entries[i].LabelUIDs
+		}
+		if f.HasLabels {
+			entry.LabelUIDs = s.labelUIDs(glossary.AccountUID, e.Labels)
+		}
+		entry.Definition = e.Definition
+		entry.PartOfSpeech = e.PartOfSpeech
+		entry.Modified = now
+		for _, t := range e.Terms {
+			entry.Translations = append(entry.Translations, entryTranslation{LocaleID: t.LocaleID, Term: t.Text, Notes: t.Notes, DoNotTranslate: t.DNT})
+		}
+		entries = append(entries, entry)
+	}
+	glossary.Entries = entries
+}
+
+// renderEntries renders the entries matching the filter of the request in
+// the export format: TBX for "tbx", CSV otherwise. Only the locales of the
+// request are rendered; all when empty. labelNames maps label UIDs to the
+// names of the Labels column.
+func (g *storedGlossary) renderEntries(format string, req exportRequest, labelNames func(uids []string) []string) ([]byte, string) {
+	f := glossaryfile.File{Locales: g.LocaleIDs, HasDefinitions: true, HasNotes: true, HasDNT: true}
+	if len(req.LocaleIds) > 0 {
+		f.Locales = slices.DeleteFunc(slices.Clone(g.LocaleIDs), func(localeID string) bool {
+			return !slices.Contains(req.LocaleIds, localeID)
+		})
+	}
+	for _, entry := range g.Entries {
+		if !matchesEntryFilter(entry, req.Filter) {
+			continue
+		}
+		e := glossaryfile.Entry{EntryUID: entry.UID, Definition: entry.Definition, PartOfSpeech: entry.PartOfSpeech, Labels: labelNames(entry.LabelUIDs)}
+		f.HasLabels = f.HasLabels || len(e.Labels) > 0
+		for _, t := range entry.Translations {
+			if !slices.Contains(f.Locales, t.LocaleID) {
+				continue
+			}
+			e.Terms = append(e.Terms, glossaryfile.Term{LocaleID: t.LocaleID, Text: t.Term, Notes: t.Notes, DNT: t.DoNotTranslate})
+		}
+		f.Entries = append(f.Entries, e)
+	}
+
+	var buf bytes.Buffer
+	if format == "tbx" {
+		version := glossaryfile.TBXVersion3
+		if strings.HasSuffix(req.TbxVersion, "2") {
+			version = glossaryfile.TBXVersion2
+		}
+		_ = glossaryfile.WriteTBX(&buf, f, version)
+		return buf.Bytes(), glossaryfile.MediaTypeTBX
+	}
+	if len(f.Entries) == 0 {
+		return []byte(emptyGlossaryCSV(f.Locales)), "text/csv"
+	}
+	_ = glossaryfile.WriteCSV(&buf, f)
+	return buf.Bytes(), glossaryfile.MediaTypeCSV
+}
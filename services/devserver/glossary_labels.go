@@ -0,0 +1,149 @@
+package devserver
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+type storedLabel struct {
+	UID        string
+	AccountUID string
+	Name       string
+	Created    time.Time
+	Modified   time.Time
+}
+
+type labelData struct {
+	LabelUID     string `json:"labelUid"`
+	LabelName    string `json:"labelName"`
+	CreatedDate  string `json:"createdDate"`
+	ModifiedDate string `json:"modifiedDate"`
+}
+
+func (l *storedLabel) toData() labelData {
+	return labelData{
+		LabelUID:     l.UID,
+		LabelName:    l.Name,
+		CreatedDate:  formatTime(l.Created),
+		ModifiedDate: formatTime(l.Modified),
+	}
+}
+
+func (s *Server) registerGlossaryLabels() {
+	base := "/glossary-api/v3/accounts/{accountUID}/labels"
+	s.mux.HandleFunc("GET "+base, s.listGlossaryLabels)
+	s.mux.HandleFunc("POST "+base, s.createGlossaryLabel)
+	s.mux.HandleFunc("DELETE "+base+"/{labelUID}", s.deleteGlossaryLabel)
+}
+
+// accountLabels returns the labels of the account ordered by name; must be
+// called with s.mu held.
+func (s *Server) accountLabels(accountUID string) []*storedLabel {
+	var labels []*storedLabel
+	for _, label := range s.labels {
+		if label.AccountUID == accountUID {
+			labels = append(labels, label)
+		}
+	}
+	slices.SortFunc(labels, func(a, b *storedLabel) int { return strings.Compare(a.Name, b.Name) })
+	return labels
+}
+
+func (s *Server) listGlossaryLabels(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := []labelData{}
+	for _, label := range s.accountLabels(r.PathValue("accountUID")) {
+		items = append(items, label.toData())
+	}
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": len(items),
+		"items":      items,
+	})
+}
+
+func (s *Server) createGlossaryLabel(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		LabelName string `json:"labelName"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if strings.TrimSpace(req.LabelName) == "" {
+		writeValidation(w, "labelName is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	accountUID := r.PathValue("accountUID")
+	for _, label := range s.accountLabels(accountUID) {
+		if strings.EqualFold(label.Name, req.LabelName) {
+			writeValidation(w, "label "+label.Name+" already exists")
+			return
+		}
+	}
+	s.seq++
+	now := s.now()
+	label := &storedLabel{UID: encodeUUID(s.seq), AccountUID: accountUID, Name: req.LabelName, Created: now, Modified: now}
+	s.labels[label.UID] = label
+	writeData(w, http.StatusOK, label.toData())
+}
+
+// deleteGlossaryLabel deletes the label and takes it off the entries of all
+// glossaries of the account.
+func (s *Server) deleteGlossaryLabel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	label, ok := s.labels[r.PathValue("labelUID")]
+	if !ok || label.AccountUID != r.PathValue("accountUID") {
+		writeNotFound(w, "label")
+		return
+	}
+	delete(s.labels, label.UID)
+	now := s.now()
+	for _, glossary := range s.glossaries {
+		if glossary.AccountUID != label.AccountUID {
+			continue
+		}
+		edited := false
+		for _, entry := range glossary.Entries {
+			if i := slices.Index(entry.LabelUIDs, label.UID); i >= 0 {
+				entry.LabelUIDs = slices.Delete(entry.LabelUIDs, i, i+1)
+				edited = true
+			}
+		}
+		if edited {
+			glossary.entriesEdited(now)
+		}
+	}
+	writeData(w, http.StatusOK, nil)
+}
+
+// labelUIDs maps label names to the UIDs of the labels of the account,
+// ignoring case; unknown names are skipped. Must be called with s.mu held.
+func (s *Server) labelUIDs(accountUID string, names []string) []string {
+	labels := s.accountLabels(accountUID)
+	var uids []string
+	for _, name := range names {
+		i := slices.IndexFunc(labels, func(l *storedLabel) bool { return strings.EqualFold(l.Name, name) })
+		if i >= 0 && !slices.Contains(uids, labels[i].UID) {
+			uids = append(uids, labels[i].UID)
+		}
+	}
+	return uids
+}
+
+// labelNames maps label UIDs to label names; must be called with s.mu held.
+func (s *Server) labelNames(uids []string) []string {
+	var names []string
+	for _, uid := range uids {
+		if label, ok := s.labels[uid]; ok {
+			names = append(names, label.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,745 @@
+package devserver
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	jobstring "github.com/Smartling/api-sdk-go/api/job/string"
+)
+
+// Job statuses used by the jobs API.
+const (
+	jobStatusAwaitingAuthorization = "AWAITING_AUTHORIZATION"
+	jobStatusInProgress            = "IN_PROGRESS"
+	jobStatusCompleted             = "COMPLETED"
+	jobStatusCancelled             = "CANCELLED"
+	jobStatusClosed                = "CLOSED"
+)
+
+type storedJob struct {
+	UID             string
+	ProjectID       string
+	Name            string
+	Number          string
+	Description     string
+	ReferenceNumber string
+	Status          string
+	TargetLocaleIDs []string
+	Due             time.Time
+	Created         time.Time
+	Modified        time.Time
+	// Files keeps job file URIs in the order they were added.
+	Files   []string
+	Strings []jobstring.StringHashcode
+}
+
+type jobData struct {
+	TranslationJobUID string      `json:"translationJobUid"`
+	JobName           string      `json:"jobName"`
+	JobNumber         string      `json:"jobNumber"`
+	Description       string      `json:"description"`
+	ReferenceNumber   string      `json:"referenceNumber"`
+	JobStatus         string      `json:"jobStatus"`
+	DueDate           string      `json:"dueDate"`
+	CreatedDate       string      `json:"createdDate"`
+	ModifiedDate      string      `json:"modifiedDate"`
+	TargetLocaleIDs   []string    `json:"targetLocaleIds"`
+	ProjectID         string      `json:"projectId"`
+	SourceFiles       []jobSource `json:"sourceFiles"`
+}
+
+type jobSource struct {
+	Name string `json:"name"`
+	URI  string `json:"uri"`
+}
+
+func (j *storedJob) toData() jobData {
+	data := jobData{
+		TranslationJobUID: j.UID,
+		JobName:           j.Name,
+		JobNumber:         j.Number,
+		Description:       j.Description,
+		ReferenceNumber:   j.ReferenceNumber,
+		JobStatus:         j.Status,
+		DueDate:           formatTime(j.Due),
+		CreatedDate:       formatTime(j.Created),
+		ModifiedDate:      formatTime(j.Modified),
+		TargetLocaleIDs:   append([]string{}, j.TargetLocaleIDs...),
+		ProjectID:         j.ProjectID,
+		SourceFiles:       []jobSource{},
+	}
+	for _, uri := range j.Files {
+		name := uri
+		if i := strings.LastIndex(uri, "/"); i >= 0 {
+			name = uri[i+1:]
+		}
+		data.SourceFiles = append(data.SourceFiles, jobSource{Name: name, URI: uri})
+	}
+	return data
+}
+
+func (s *Server) registerJobs() {
+	base := "/jobs-api/v3/projects/{projectID}/jobs"
+	s.mux.HandleFunc("POST "+base, s.createJob)
+	s.mux.HandleFunc("GET "+base, s.listProjectJobs)
+	s.mux.HandleFunc("POST "+base+"/search", s.searchJobs)
+	s.mux.HandleFunc("POST "+base+"/find-jobs-by-strings", s.findJobsByStrings)
+	s.mux.HandleFunc("GET "+base+"/{jobUID}", s.getJob)
+	s.mux.HandleFunc("PUT "+base+"/{jobUID}", s.updateJob)
+	s.mux.HandleFunc("DELETE "+base+"/{jobUID}", s.deleteJob)
+	s.mux.HandleFunc("POST "+base+"/{jobUID}/authorize", s.authorizeJob)
+	s.mux.HandleFunc("POST "+base+"/{jobUID}/cancel", s.cancelJob)
+	s.mux.HandleFunc("POST "+base+"/{jobUID}/close", s.closeJob)
+	s.mux.HandleFunc("GET "+base+"/{jobUID}/progress", s.jobProgress)
+	s.mux.HandleFunc("GET "+base+"/{jobUID}/files", s.listJobFiles)
+	s.mux.HandleFunc("POST "+base+"/{jobUID}/file/add", s.addJobFile)
+	s.mux.HandleFunc("POST "+base+"/{jobUID}/file/remove", s.removeJobFile)
+	s.mux.HandleFunc("GET "+base+"/{jobUID}/strings", s.listJobStrings)
+	s.mux.HandleFunc("POST "+base+"/{jobUID}/strings/add", s.addJobStrings)
+	s.mux.HandleFunc("POST "+base+"/{jobUID}/strings/remove", s.removeJobStrings)
+	s.mux.HandleFunc("POST "+base+"/{jobUID}/locales/{localeID}", s.addJobLocale)
+	s.mux.HandleFunc("DELETE "+base+"/{jobUID}/locales/{localeID}", s.removeJobLocale)
+	s.mux.HandleFunc("GET /jobs-api/v3/accounts/{accountUID}/jobs", s.listAccountJobs)
+}
+
+// newJob registers a job in the project; must be called with s.mu held.
+func (s *Server) newJob(projectID, name, description string, locales []string) *storedJob {
+	s.seq++
+	uid := encodeUID(s.seq, 12)
+	now := s.now()
+	job := &storedJob{
+		UID:             uid,
+		ProjectID:       projectID,
+		Name:            name,
+		Number:          "SMTL" + strings.ToUpper(uid[7:]),
+		Description:     description,
+		Status:          jobStatusAwaitingAuthorization,
+		TargetLocaleIDs: append([]string{}, locales...),
+		Created:         now,
+		Modified:        now,
+	}
+	s.jobs[job.UID] = job
+	return job
+}
+
+// findJob looks up a job in the project; must be called with s.mu held.
+func (s *Server) findJob(w http.ResponseWriter, r *http.Request) (*storedJob, bool) {
+	job, ok := s.jobs[r.PathValue("jobUID")]
+	if !ok || job.ProjectID != r.PathValue("projectID") {
+		writeNotFound(w, "job")
+		return nil, false
+	}
+	return job, true
+}
+
+func (s *Server) createJob(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		JobName         string    `json:"jobName"`
+		Description     string    `json:"description"`
+		ReferenceNumber string    `json:"referenceNumber"`
+		TargetLocaleIDs []string  `json:"targetLocaleIds"`
+		DueDate         time.Time `json:"dueDate"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if req.JobName == "" {
+		writeValidation(w, "jobName is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	projectID := r.PathValue("projectID")
+	if _, ok := s.project(projectID); !ok {
+		writeNotFound(w, "project")
+		return
+	}
+	for _, job := range s.jobs {
+		if job.ProjectID == projectID && job.Name == req.JobName {
+			writeValidation(w, "job with name "+req.JobName+" already exists")
+			return
+		}
+	}
+	job := s.newJob(projectID, req.JobName, req.Description, req.TargetLocaleIDs)
+	job.ReferenceNumber = req.ReferenceNumber
+	job.Due = req.DueDate
+	writeData(w, http.StatusOK, job.toData())
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, job.toData())
+}
+
+func (s *Server) updateJob(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		JobName         string     `json:"jobName"`
+		Description     string     `json:"description"`
+		ReferenceNumber string     `json:"referenceNumber"`
+		DueDate         *time.Time `json:"dueDate"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if req.JobName == "" {
+		writeValidation(w, "jobName is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	for _, other := range s.jobs {
+		if other != job && other.ProjectID == job.ProjectID && other.Name == req.JobName {
+			writeValidation(w, "job with name "+req.JobName+" already exists")
+			return
+		}
+	}
+	job.Name = req.JobName
+	job.Description = req.Description
+	job.ReferenceNumber = req.ReferenceNumber
+	job.Due = time.Time{}
+	if req.DueDate != nil {
+		job.Due = *req.DueDate
+	}
+	job.Modified = s.now()
+	writeData(w, http.StatusOK, job.toData())
+}
+
+// transitionJob moves a job to status unless it is in one of the final states.
+func (s *Server) transitionJob(w http.ResponseWriter, r *http.Request, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	if job.Status == jobStatusCancelled || job.Status == jobStatusClosed {
+		writeValidation(w, "job is "+strings.ToLower(job.Status))
+		return
+	}
+	job.Status = status
+	job.Modified = s.now()
+	writeData(w, http.StatusOK, nil)
+}
+
+func (s *Server) authorizeJob(w http.ResponseWriter, r *http.Request) {
+	s.transitionJob(w, r, jobStatusInProgress)
+}
+
+func (s *Server) cancelJob(w http.ResponseWriter, r *http.Request) {
+	s.transitionJob(w, r, jobStatusCancelled)
+}
+
+// closeJob does not require the job to be completed; the stand-in server
+// has no translation workflow.
+func (s *Server) closeJob(w http.ResponseWriter, r *http.Request) {
+	s.transitionJob(w, r, jobStatusClosed)
+}
+
+func (s *Server) deleteJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	delete(s.jobs, job.UID)
+	writeData(w, http.StatusOK, nil)
+}
+
+func (s *Server) listProjectJobs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	projectID := r.PathValue("projectID")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeJobs(w, r, func(job *storedJob) bool {
+		return job.ProjectID == projectID &&
+			matchJob(job, query.Get("jobName"), query["translationJobStatus"]) &&
+			(query.Get("jobNumber") == "" || job.Number == query.Get("jobNumber")) &&
+			(len(query["translationJobUids"]) == 0 || contains(query["translationJobUids"], job.UID))
+	})
+}
+
+func (s *Server) listAccountJobs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	accountUID := r.PathValue("accountUID")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeJobs(w, r, func(job *storedJob) bool {
+		details, ok := s.project(job.ProjectID)
+		return ok && details.AccountUID == accountUID &&
+			matchJob(job, query.Get("jobName"), query["translationJobStatus"]) &&
+			(len(query["projectIds"]) == 0 || contains(query["projectIds"], job.ProjectID))
+	})
+}
+
+func (s *Server) searchJobs(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		FileURIs           []string `json:"fileUris"`
+		Hashcodes          []string `json:"hashcodes"`
+		TranslationJobUIDs []string `json:"translationJobUids"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	projectID := r.PathValue("projectID")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeJobs(w, r, func(job *storedJob) bool {
+		if job.ProjectID != projectID {
+			return false
+		}
+		if len(req.TranslationJobUIDs) > 0 && !contains(req.TranslationJobUIDs, job.UID) {
+			return false
+		}
+		if len(req.FileURIs) > 0 && !intersects(req.FileURIs, job.Files) {
+			return false
+		}
+		if len(req.Hashcodes) > 0 && !intersects(req.Hashcodes, job.hashcodes()) {
+			return false
+		}
+		return true
+	})
+}
+
+// writeJobs renders jobs matching filter sorted by creation; must be called with s.mu held.
+func (s *Server) writeJobs(w http.ResponseWriter, r *http.Request, filter func(*storedJob) bool) {
+	var jobs []*storedJob
+	for _, job := range s.jobs {
+		if filter(job) {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].UID < jobs[j].UID })
+
+	items := []jobData{}
+	offset, end := page(r, len(jobs))
+	for _, job := range jobs[offset:end] {
+		items = append(items, job.toData())
+	}
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": len(jobs),
+		"items":      items,
+	})
+}
+
+func matchJob(job *storedJob, name string, statuses []string) bool {
+	if name != "" && !strings.Contains(strings.ToLower(job.Name), strings.ToLower(name)) {
+		return false
+	}
+	return len(statuses) == 0 || contains(statuses, job.Status)
+}
+
+func (s *Server) findJobsByStrings(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Hashcodes []string `json:"hashcodes"`
+		LocaleIDs []string `json:"localeIds"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if len(req.Hashcodes) == 0 {
+		writeValidation(w, "hashcodes are required")
+		return
+	}
+	projectID := r.PathValue("projectID")
+
+	type byLocale struct {
+		LocaleID  string   `json:"localeId"`
+		Hashcodes []string `json:"hashcodes"`
+	}
+	type item struct {
+		TranslationJobUID string     `json:"translationJobUid"`
+		JobName           string     `json:"jobName"`
+		DueDate           string     `json:"dueDate"`
+		HashcodesByLocale []byLocale `json:"hashcodesByLocale"`
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var uids []string
+	for uid, job := range s.jobs {
+		if job.ProjectID == projectID {
+			uids = append(uids, uid)
+		}
+	}
+	sort.Strings(uids)
+
+	items := []item{}
+	for _, uid := range uids {
+		job := s.jobs[uid]
+		found := map[string][]string{}
+		for _, str := range job.Strings {
+			if !contains(req.Hashcodes, str.Hashcode) {
+				continue
+			}
+			if len(req.LocaleIDs) > 0 && !contains(req.LocaleIDs, str.TargetLocaleID) {
+				continue
+			}
+			found[str.TargetLocaleID] = append(found[str.TargetLocaleID], str.Hashcode)
+		}
+		if len(found) == 0 {
+			continue
+		}
+		it := item{TranslationJobUID: job.UID, JobName: job.Name, DueDate: formatTime(job.Due)}
+		for _, locale := range job.TargetLocaleIDs {
+			if hashcodes, ok := found[locale]; ok {
+				it.HashcodesByLocale = append(it.HashcodesByLocale, byLocale{LocaleID: locale, Hashcodes: hashcodes})
+			}
+		}
+		items = append(items, it)
+	}
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": len(items),
+		"items":      items,
+	})
+}
+
+func (s *Server) jobProgress(w http.ResponseWriter, r *http.Request) {
+	type stepItem struct {
+		StringCount      int    `json:"stringCount"`
+		WordCount        int    `json:"wordCount"`
+		WorkflowStepName string `json:"workflowStepName"`
+		WorkflowStepType string `json:"workflowStepType"`
+		WorkflowStepUID  string `json:"workflowStepUid"`
+	}
+	type workflow struct {
+		WorkflowName                      string     `json:"workflowName"`
+		WorkflowUID                       string     `json:"workflowUid"`
+		WorkflowStepSummaryReportItemList []stepItem `json:"workflowStepSummaryReportItemList"`
+	}
+	type progress struct {
+		PercentComplete float64 `json:"percentComplete"`
+		TotalWordCount  int     `json:"totalWordCount"`
+	}
+	type localeReport struct {
+		Progress                   progress   `json:"progress"`
+		TargetLocaleDescription    string     `json:"targetLocaleDescription"`
+		TargetLocaleID             string     `json:"targetLocaleId"`
+		WorkflowProgressReportList []workflow `json:"workflowProgressReportList"`
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	files := s.files[job.ProjectID]
+
+	reports := []localeReport{}
+	var totalWords, completedWords int
+	for _, locale := range job.TargetLocaleIDs {
+		var words, strs, doneWords, doneStrings int
+		for _, uri := range job.Files {
+			file, ok := files[uri]
+			if !ok {
+				continue
+			}
+			fileWords, fileStrings := countWords(file.Content), countStrings(file.Content)
+			words += fileWords
+			strs += fileStrings
+			if _, translated := file.Translations[locale]; translated || job.Status == jobStatusCompleted {
+				doneWords += fileWords
+				doneStrings += fileStrings
+			}
+		}
+		totalWords += words
+		completedWords += doneWords
+
+		reports = append(reports, localeReport{
+			Progress:                progress{PercentComplete: percent(doneWords, words), TotalWordCount: words},
+			TargetLocaleDescription: locale,
+			TargetLocaleID:          locale,
+			WorkflowProgressReportList: []workflow{{
+				WorkflowName: "Translation",
+				WorkflowUID:  "devworkflow1",
+				WorkflowStepSummaryReportItemList: []stepItem{
+					{StringCount: strs - doneStrings, WordCount: words - doneWords, WorkflowStepName: "Translation", WorkflowStepType: "TRANSLATION", WorkflowStepUID: "devstep00001"},
+					{StringCount: doneStrings, WordCount: doneWords, WorkflowStepName: "Published", WorkflowStepType: "PUBLISH", WorkflowStepUID: "devstep00002"},
+				},
+			}},
+		})
+	}
+
+	writeData(w, http.StatusOK, map[string]any{
+		"contentProgressReport": reports,
+		"progress": progress{
+			PercentComplete: percent(completedWords, totalWords),
+			TotalWordCount:  totalWords,
+		},
+		"summaryReport": []any{},
+	})
+}
+
+func percent(done, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(100*done) / float64(total)
+}
+
+func (s *Server) listJobFiles(w http.ResponseWriter, r *http.Request) {
+	type item struct {
+		URI       string   `json:"uri"`
+		LocaleIDs []string `json:"localeIds"`
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	items := []item{}
+	offset, end := page(r, len(job.Files))
+	for _, uri := range job.Files[offset:end] {
+		items = append(items, item{URI: uri, LocaleIDs: append([]string{}, job.TargetLocaleIDs...)})
+	}
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": len(job.Files),
+		"items":      items,
+	})
+}
+
+func (s *Server) addJobFile(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		FileURI         string   `json:"fileUri"`
+		TargetLocaleIDs []string `json:"targetLocaleIds"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := s.files[job.ProjectID][req.FileURI]; !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	if !contains(job.Files, req.FileURI) {
+		job.Files = append(job.Files, req.FileURI)
+	}
+	for _, locale := range req.TargetLocaleIDs {
+		if !contains(job.TargetLocaleIDs, locale) {
+			job.TargetLocaleIDs = append(job.TargetLocaleIDs, locale)
+		}
+	}
+	job.Modified = s.now()
+	writeData(w, http.StatusOK, map[string]int{"successCount": 1, "failCount": 0})
+}
+
+func (s *Server) removeJobFile(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		FileURI string `json:"fileUri"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	result := map[string]int{"successCount": 0, "failCount": 1}
+	for i, uri := range job.Files {
+		if uri == req.FileURI {
+			job.Files = append(job.Files[:i], job.Files[i+1:]...)
+			result = map[string]int{"successCount": 1, "failCount": 0}
+			job.Modified = s.now()
+			break
+		}
+	}
+	writeData(w, http.StatusOK, result)
+}
+
+func (s *Server) listJobStrings(w http.ResponseWriter, r *http.Request) {
+	locale := r.URL.Query().Get("targetLocaleId")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	items := []jobstring.StringHashcode{}
+	for _, str := range job.Strings {
+		if locale == "" || str.TargetLocaleID == locale {
+			items = append(items, str)
+		}
+	}
+	total := len(items)
+	offset, end := page(r, total)
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": total,
+		"items":      items[offset:end],
+	})
+}
+
+func (s *Server) addJobStrings(w http.ResponseWriter, r *http.Request) {
+	var req jobstring.AddRequest
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	locales := req.TargetLocaleIDs
+	if len(locales) == 0 {
+		locales = job.TargetLocaleIDs
+	}
+	var success, fail int
+	for _, hashcode := range req.Hashcodes {
+		for _, locale := range locales {
+			str := jobstring.StringHashcode{TargetLocaleID: locale, Hashcode: hashcode}
+			if job.hasString(str) {
+				fail++
+				continue
+			}
+			if req.MoveEnabled {
+				s.releaseString(job, str)
+			}
+			job.Strings = append(job.Strings, str)
+			success++
+		}
+	}
+	job.Modified = s.now()
+	writeData(w, http.StatusOK, map[string]int{"successCount": success, "failCount": fail})
+}
+
+func (s *Server) removeJobStrings(w http.ResponseWriter, r *http.Request) {
+	var req jobstring.RemoveRequest
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	kept := job.Strings[:0]
+	removed := 0
+	for _, str := range job.Strings {
+		if contains(req.Hashcodes, str.Hashcode) && (len(req.LocaleIDs) == 0 || contains(req.LocaleIDs, str.TargetLocaleID)) {
+			removed++
+			continue
+		}
+		kept = append(kept, str)
+	}
+	job.Strings = kept
+	job.Modified = s.now()
+	writeData(w, http.StatusOK, map[string]int{"successCount": removed, "failCount": 0})
+}
+
+func (s *Server) addJobLocale(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	locale := r.PathValue("localeID")
+	if !contains(job.TargetLocaleIDs, locale) {
+		job.TargetLocaleIDs = append(job.TargetLocaleIDs, locale)
+		job.Modified = s.now()
+	}
+	writeData(w, http.StatusOK, nil)
+}
+
+func (s *Server) removeJobLocale(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.findJob(w, r)
+	if !ok {
+		return
+	}
+	locale := r.PathValue("localeID")
+	for i, id := range job.TargetLocaleIDs {
+		if id == locale {
+			job.TargetLocaleIDs = append(job.TargetLocaleIDs[:i], job.TargetLocaleIDs[i+1:]...)
+			job.Modified = s.now()
+			writeData(w, http.StatusOK, nil)
+			return
+		}
+	}
+	writeNotFound(w, "locale")
+}
+
+func (j *storedJob) hashcodes() []string {
+	var hashcodes []string
+	for _, str := range j.Strings {
+		hashcodes = append(hashcodes, str.Hashcode)
+	}
+	return hashcodes
+}
+
+// releaseString removes the string from the other jobs of the project, as
+// the API does for moveEnabled; must be called with s.mu held.
+func (s *Server) releaseString(target *storedJob, str jobstring.StringHashcode) {
+	for _, other := range s.jobs {
+		if other == target || other.ProjectID != target.ProjectID || !other.hasString(str) {
+			continue
+		}
+		kept := other.Strings[:0]
+		for _, existing := range other.Strings {
+			if existing != str {
+				kept = append(kept, existing)
+			}
+		}
+		other.Strings = kept
+		other.Modified = s.now()
+	}
+}
+
+func (j *storedJob) hasString(str jobstring.StringHashcode) bool {
+	for _, existing := range j.Strings {
+		if existing == str {
+			return true
+		}
+	}
+	return false
+}
+
+func intersects(a, b []string) bool {
+	for _, value := range a {
+		if contains(b, value) {
+			return true
+		}
+	}
+	return false
+}
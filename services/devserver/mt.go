@@ -0,0 +1,194 @@
+package devserver
+
+import (
+	"io"
+	"net/http"
+
+	api "github.com/Smartling/api-sdk-go/api/mt"
+)
+
+type storedMTFile struct {
+	UID        string
+	AccountUID string
+	Content    []byte
+	// Translations holds requested target locales by MT UID.
+	Translations map[string][]string
+	Cancelled    map[string]bool
+}
+
+func (s *Server) registerMT() {
+	base := "/file-translations-api/v2/accounts/{accountUID}/files"
+	s.mux.HandleFunc("POST "+base, s.uploadMTFile)
+	s.mux.HandleFunc("POST "+base+"/{fileUID}/mt", s.startMT)
+	s.mux.HandleFunc("GET "+base+"/{fileUID}/mt/{mtUID}/status", s.mtStatus)
+	s.mux.HandleFunc("POST "+base+"/{fileUID}/mt/{mtUID}/cancel", s.cancelMT)
+	s.mux.HandleFunc("GET "+base+"/{fileUID}/mt/{mtUID}/locales/{localeID}/file", s.downloadMT)
+	s.mux.HandleFunc("POST "+base+"/{fileUID}/language-detection", s.detectLanguage)
+	s.mux.HandleFunc("GET "+base+"/{fileUID}/language-detection/{detectionUID}/status", s.detectionStatus)
+}
+
+// findMTFile looks up an uploaded file in the account; must be called with s.mu held.
+func (s *Server) findMTFile(w http.ResponseWriter, r *http.Request) (*storedMTFile, bool) {
+	file, ok := s.mtFiles[r.PathValue("fileUID")]
+	if !ok || file.AccountUID != r.PathValue("accountUID") {
+		writeNotFound(w, "file")
+		return nil, false
+	}
+	return file, true
+}
+
+func (s *Server) uploadMTFile(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	part, _, err := r.FormFile("file")
+	if err != nil {
+		writeValidation(w, "file is required")
+		return
+	}
+	defer func() { _ = part.Close() }()
+	content, err := io.ReadAll(part)
+	if err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+
+	file := &storedMTFile{
+		UID:          s.nextUID(12),
+		AccountUID:   r.PathValue("accountUID"),
+		Content:      content,
+		Translations: make(map[string][]string),
+		Cancelled:    make(map[string]bool),
+	}
+	s.mu.Lock()
+	s.mtFiles[file.UID] = file
+	s.mu.Unlock()
+
+	writeData(w, http.StatusOK, map[string]string{"fileUid": file.UID})
+}
+
+func (s *Server) startMT(w http.ResponseWriter, r *http.Request) {
+	var req api.StartParams
+	if err := readJSON(r, &req); err != nil {
+		writeValidation(w, err.Error())
+		return
+	}
+	if len(req.TargetLocaleIDs) == 0 {
+		writeValidation(w, "targetLocaleIds are required")
+		return
+	}
+
+	mtUID := s.nextUID(12)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, ok := s.findMTFile(w, r)
+	if !ok {
+		return
+	}
+	file.Translations[mtUID] = req.TargetLocaleIDs
+	writeData(w, http.StatusOK, map[string]string{"mtUid": mtUID})
+}
+
+func (s *Server) mtStatus(w http.ResponseWriter, r *http.Request) {
+	type localeStatus struct {
+		LocaleID             string `json:"localeId"`
+		State                string `json:"state"`
+		ProcessedStringCount int    `json:"processedStringCount"`
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, ok := s.findMTFile(w, r)
+	if !ok {
+		return
+	}
+	mtUID := r.PathValue("mtUID")
+	locales, ok := file.Translations[mtUID]
+	if !ok {
+		writeNotFound(w, "translation")
+		return
+	}
+
+	state := api.CompletedTranslatedState
+	if file.Cancelled[mtUID] {
+		state = api.CanceledTranslatedState
+	}
+	count := countStrings(file.Content)
+	statuses := []localeStatus{}
+	for _, locale := range locales {
+		statuses = append(statuses, localeStatus{LocaleID: locale, State: state, ProcessedStringCount: count})
+	}
+	writeData(w, http.StatusOK, map[string]any{
+		"state":                 state,
+		"requestedStringCount":  count,
+		"localeProcessStatuses": statuses,
+	})
+}
+
+func (s *Server) cancelMT(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, ok := s.findMTFile(w, r)
+	if !ok {
+		return
+	}
+	mtUID := r.PathValue("mtUID")
+	if _, ok := file.Translations[mtUID]; !ok {
+		writeNotFound(w, "translation")
+		return
+	}
+	file.Cancelled[mtUID] = true
+	writeData(w, http.StatusOK, nil)
+}
+
+// downloadMT serves the original content as translation; the stand-in
+// server does not translate anything.
+func (s *Server) downloadMT(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	file, ok := s.findMTFile(w, r)
+	var content []byte
+	if ok {
+		if !contains(file.Translations[r.PathValue("mtUID")], r.PathValue("localeID")) {
+			s.mu.Unlock()
+			writeNotFound(w, "translation")
+			return
+		}
+		content = file.Content
+	}
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(content)
+}
+
+func (s *Server) detectLanguage(w http.ResponseWriter, r *http.Request) {
+	detectionUID := s.nextUID(12)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.findMTFile(w, r); !ok {
+		return
+	}
+	writeData(w, http.StatusOK, map[string]string{"languageDetectionUid": detectionUID})
+}
+
+func (s *Server) detectionStatus(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.findMTFile(w, r); !ok {
+		return
+	}
+	source := s.params.SourceLocaleID
+	language := source
+	if len(source) > 2 {
+		language = source[:2]
+	}
+	writeData(w, http.StatusOK, map[string]any{
+		"state": api.CompletedTranslatedState,
+		"detectedSourceLanguages": []map[string]string{
+			{"languageId": language, "defaultLocaleId": source},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package devserver
+
+import (
+	"net/http"
+
+	sdk "github.com/Smartling/api-sdk-go"
+)
+
+func (s *Server) registerProjects() {
+	s.mux.HandleFunc("GET /projects-api/v2/projects/{projectID}", s.getProject)
+	s.mux.HandleFunc("GET /accounts-api/v2/accounts/{accountUID}/projects", s.listProjects)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	details, ok := s.project(r.PathValue("projectID"))
+	if !ok {
+		writeNotFound(w, "project")
+		return
+	}
+	writeData(w, http.StatusOK, details)
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	accountUID := r.PathValue("accountUID")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := []sdk.Project{}
+	for _, details := range s.projects {
+		if details.AccountUID == accountUID {
+			items = append(items, details.Project)
+		}
+	}
+	offset, end := page(r, len(items))
+	writeData(w, http.StatusOK, map[string]any{
+		"totalCount": len(items),
+		"items":      items[offset:end],
+	})
+}
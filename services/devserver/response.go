@@ -0,0 +1,134 @@
+package devserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const uidAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+type envelope struct {
+	Response apiResponse `json:"response"`
+}
+
+type apiResponse struct {
+	Code   string     `json:"code"`
+	Data   any        `json:"data,omitempty"`
+	Errors []apiError `json:"errors,omitempty"`
+}
+
+type apiError struct {
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+func writeData(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(envelope{Response: apiResponse{Code: "SUCCESS", Data: data}})
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(envelope{Response: apiResponse{
+		Code:   code,
+		Errors: []apiError{{Key: strings.ToLower(code), Message: message}},
+	}})
+}
+
+func writeNotFound(w http.ResponseWriter, what string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND_ERROR", what+" not found")
+}
+
+func writeValidation(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", message)
+}
+
+func readJSON(r *http.Request, v any) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, v)
+}
+
+// nextUID returns a deterministic lowercase alphanumeric identifier of given length.
+func (s *Server) nextUID(length int) string {
+	s.mu.Lock()
+	s.seq++
+	n := s.seq
+	s.mu.Unlock()
+	return encodeUID(n, length)
+}
+
+func encodeUID(n, length int) string {
+	uid := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		uid[i] = uidAlphabet[n%len(uidAlphabet)]
+		n /= len(uidAlphabet)
+	}
+	return string(uid)
+}
+
+// nextUUID returns a deterministic identifier in canonical 8-4-4-4-12 form.
+func (s *Server) nextUUID() string {
+	s.mu.Lock()
+	s.seq++
+	n := s.seq
+	s.mu.Unlock()
+	return encodeUUID(n)
+}
+
+func encodeUUID(n int) string {
+	hex := fmt.Sprintf("%032x", n)
+	return hex[0:8] + "-" + hex[8:12] + "-" + hex[12:16] + "-" + hex[16:20] + "-" + hex[20:32]
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func queryInt(r *http.Request, name string, fallback int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
+
+// page applies limit/offset query parameters to a slice length.
+func page(r *http.Request, total int) (int, int) {
+	offset := min(queryInt(r, "offset", 0), total)
+	limit := queryInt(r, "limit", 0)
+	end := total
+	if limit > 0 {
+		end = min(offset+limit, total)
+	}
+	return offset, end
+}
+
+func countStrings(content []byte) int {
+	count := 0
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.TrimSpace(line) != "" {
+			count++
+		}
+	}
+	return count
+}
+
+func countWords(content []byte) int {
+	return len(strings.Fields(string(content)))
+}
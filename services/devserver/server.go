@@ -0,0 +1,118 @@
+package devserver
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/Smartling/api-sdk-go"
+)
+
+// Params configures the in-memory state the server starts with.
+type Params struct {
+	AccountUID     string
+	ProjectID      string
+	ProjectName    string
+	SourceLocaleID string
+	TargetLocales  []string
+	// UserID and Secret, when set, are the only credentials accepted by
+	// the authentication endpoint. Any non-empty credentials are accepted otherwise.
+	UserID string
+	Secret string
+}
+
+// Server is an in-memory stand-in for the subset of Smartling APIs used by the CLI.
+// It is safe for concurrent use.
+type Server struct {
+	params Params
+	mux    *http.ServeMux
+	now    func() time.Time
+
+	mu         sync.Mutex
+	seq        int
+	tokens     map[string]struct{}
+	projects   map[string]*sdk.ProjectDetails
+	files      map[string]map[string]*storedFile
+	jobs       map[string]*storedJob
+	batches    map[string]*storedBatch
+	glossaries map[string]*storedGlossary
+	imports    map[string]*storedImport
+	labels     map[string]*storedLabel
+	mtFiles    map[string]*storedMTFile
+}
+
+// NewServer returns a Server seeded with one project built from params.
+func NewServer(params Params) *Server {
+	s := &Server{
+		params:     params,
+		mux:        http.NewServeMux(),
+		now:        time.Now,
+		tokens:     make(map[string]struct{}),
+		projects:   make(map[string]*sdk.ProjectDetails),
+		files:      make(map[string]map[string]*storedFile),
+		jobs:       make(map[string]*storedJob),
+		batches:    make(map[string]*storedBatch),
+		glossaries: make(map[string]*storedGlossary),
+		imports:    make(map[string]*storedImport),
+		labels:     make(map[string]*storedLabel),
+		mtFiles:    make(map[string]*storedMTFile),
+	}
+
+	details := &sdk.ProjectDetails{
+		Project: sdk.Project{
+			ProjectID:               params.ProjectID,
+			ProjectName:             params.ProjectName,
+			AccountUID:              params.AccountUID,
+			SourceLocaleID:          params.SourceLocaleID,
+			SourceLocaleDescription: params.SourceLocaleID,
+		},
+	}
+	for _, locale := range params.TargetLocales {
+		details.TargetLocales = append(details.TargetLocales, sdk.Locale{
+			LocaleID:    locale,
+			Description: locale,
+			Enabled:     true,
+		})
+	}
+	s.projects[params.ProjectID] = details
+	s.files[params.ProjectID] = make(map[string]*storedFile)
+
+	s.registerAuth()
+	s.registerProjects()
+	s.registerFiles()
+	s.registerBatches()
+	s.registerJobs()
+	s.registerGlossaries()
+	s.registerGlossaryLabels()
+	s.registerMT()
+
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-SL-RequestID", s.nextUID(16))
+	if !strings.HasPrefix(r.URL.Path, "/auth-api/") && !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "AUTHENTICATION_ERROR", "Invalid token")
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok = s.tokens[token]
+	return ok
+}
+
+// project returns project details; must be called with s.mu held.
+func (s *Server) project(projectID string) (*sdk.ProjectDetails, bool) {
+	details, ok := s.projects[projectID]
+	return details, ok
+}
@@ -0,0 +1,163 @@
+package devserver
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	"github.com/Smartling/api-sdk-go/api/batches"
+	"github.com/Smartling/api-sdk-go/api/glossary"
+	smfile "github.com/Smartling/api-sdk-go/helpers/sm_file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	rlog.Init()
+	os.Exit(m.Run())
+}
+
+func newTestClient(t *testing.T, userID, secret string) *sdk.HttpAPIClient {
+	t.Helper()
+	server := httptest.NewServer(NewServer(Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		ProjectName:    "Project",
+		SourceLocaleID: "en-US",
+		TargetLocales:  []string{"de-DE", "fr-FR"},
+		UserID:         "user",
+		Secret:         "secret",
+	}))
+	t.Cleanup(server.Close)
+
+	client := sdk.NewHttpAPIClient(server.Client(), userID, secret)
+	client.Client.BaseURL = server.URL
+	return client
+}
+
+func TestServer_Authentication(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestClient(t, "user", "wrong")
+	_, err := client.GetProjectDetails(ctx, "project")
+	assert.Error(t, err)
+
+	client = newTestClient(t, "user", "secret")
+	details, err := client.GetProjectDetails(ctx, "project")
+	require.NoError(t, err)
+	assert.Equal(t, "en-US", details.SourceLocaleID)
+	assert.Len(t, details.TargetLocales, 2)
+}
+
+func TestServer_Files(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "user", "secret")
+
+	request := smfile.FileUploadRequest{File: []byte("a=1\nb=2\n"), FileType: smfile.FileTypeJavaProperties}
+	request.FileURI = "strings.properties"
+	_, err := client.UploadFile(ctx, "project", request)
+	require.NoError(t, err)
+
+	files, err := client.ListAllFiles(ctx, "project", smfile.FilesListRequest{})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "strings.properties", files[0].FileURI)
+
+	reader, err := client.DownloadFile(ctx, "project", "strings.properties")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	_ = reader.Close()
+	assert.Equal(t, "a=1\nb=2\n", string(content))
+
+	status, err := client.GetFileStatus(ctx, "project", "strings.properties")
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.TotalStringCount)
+	assert.Len(t, status.Items, 2)
+
+	require.NoError(t, client.RenameFile(ctx, "project", "strings.properties", "renamed.properties"))
+	require.NoError(t, client.DeleteFile(ctx, "project", "renamed.properties"))
+	files, err = client.ListAllFiles(ctx, "project", smfile.FilesListRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestServer_Batches(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "user", "secret")
+	api := batches.NewBatch(client.Client)
+
+	job, err := api.CreateJob(ctx, "project", batches.CreateJobPayload{
+		NameTemplate:    "release",
+		TargetLocaleIds: []string{"de-DE"},
+		Mode:            batches.ReuseExistingMode,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, job.TranslationJobUID)
+
+	again, err := api.CreateJob(ctx, "project", batches.CreateJobPayload{
+		NameTemplate: "release",
+		Mode:         batches.ReuseExistingMode,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, job.TranslationJobUID, again.TranslationJobUID)
+
+	batch, err := api.Create(ctx, "project", batches.CreateBatchPayload{
+		Authorize:         true,
+		TranslationJobUID: job.TranslationJobUID,
+		FileUris:          []string{"a.json"},
+	})
+	require.NoError(t, err)
+
+	_, err = api.UploadFile(ctx, "project", batch.BatchUID, batches.UploadFilePayload{
+		Filename: "a.json",
+		File:     []byte(`{"a": "b"}`),
+		FileType: batches.JSON,
+	})
+	require.NoError(t, err)
+
+	status, err := api.GetStatus(ctx, "project", batch.BatchUID)
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETED", status.Status)
+	assert.True(t, status.Authorized)
+}
+
+func TestServer_Glossaries(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "user", "secret")
+	api := glossary.NewGlossary(client.Client)
+
+	created, err := api.Create(ctx, "account", glossary.CreateGlossaryRequest{
+		GlossaryName: "Terms",
+		LocaleIDs:    []string{"en-US", "de-DE"},
+	})
+	require.NoError(t, err)
+
+	csv := []byte("Term (en-US),Term (de-DE)\nhello,hallo\n")
+	imported, err := api.Import(ctx, "account", created.GlossaryUID, glossary.ImportGlossaryRequest{
+		File:      csv,
+		FileName:  "terms.csv",
+		MediaType: "text/csv",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, imported.EntryChanges.NewEntries)
+
+	confirmed, err := api.ImportConfirm(ctx, "account", created.GlossaryUID, imported.ImportUID)
+	require.NoError(t, err)
+	assert.True(t, confirmed)
+
+	exported, err := api.Export(ctx, "account", created.GlossaryUID, glossary.ExportGlossaryRequest{Format: "csv"})
+	require.NoError(t, err)
+	content, err := io.ReadAll(exported.Data)
+	require.NoError(t, err)
+	_ = exported.Data.Close()
+	assert.Equal(t, csv, content)
+
+	_, err = api.Get(ctx, "other", created.GlossaryUID)
+	assert.Error(t, err)
+}
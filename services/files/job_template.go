@@ -0,0 +1,113 @@
+package files
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"os/user"
+	"slices"
+	"time"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/config"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	"github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+)
+
+// reusableJobStatuses are the statuses of a job which can still take more
+// files, matching the batch API reuse mode.
+var reusableJobStatuses = []string{"AWAITING_AUTHORIZATION", "IN_PROGRESS", "COMPLETED"}
+
+// templateJob resolves the job of the push job template: an open job with
+// the rendered name is reused, otherwise a new job is created from the
+// template. It returns the job UID, the job name and the locales of the push.
+func (s service) templateJob(ctx context.Context, projectID string, params PushParams) (string, string, []string, error) {
+	template, err := s.Config.JobTemplate(params.JobTemplate)
+	if err != nil {
+		return "", "", nil, clierror.UIError{
+			Err:         err,
+			Operation:   "job template",
+			Description: "Check the jobs section of the config file.",
+		}
+	}
+
+	now := time.Now()
+	vars := config.JobTemplateVars{Branch: params.Branch, User: currentUser(), Now: now}
+	if vars.Branch == "" && template.UsesBranch() {
+		vars.Branch, err = getGitBranch()
+		if err != nil {
+			return "", "", nil, clierror.UIError{
+				Err:         err,
+				Operation:   "job template",
+				Description: "job name uses {branch}: specify --branch or run inside a git repository",
+			}
+		}
+	}
+	jobName := template.JobName(vars)
+	dueDate, err := template.DueDate(now)
+	if err != nil {
+		return "", "", nil, clierror.UIError{
+			Err:         err,
+			Operation:   "job template",
+			Description: fmt.Sprintf("Check due_in of job template %q.", params.JobTemplate),
+		}
+	}
+	locales := params.Locales
+	if len(locales) == 0 {
+		locales = template.Locales
+	}
+
+	resp, err := s.JobApi.ListProjectJobs(ctx, projectID, jobapi.ListProjectJobsParams{
+		JobName:   jobName,
+		JobStatus: reusableJobStatuses,
+	})
+	if err != nil {
+		return "", "", nil, fmt.Errorf("search jobs by name %q: %w", jobName, err)
+	}
+	if existing, found := jobapi.FindFirstJobByName(resp.Items, jobName); found {
+		rlog.Debugf("reusing job %s for template %q", existing.TranslationJobUID, params.JobTemplate)
+		return existing.TranslationJobUID, existing.JobName, locales, nil
+	}
+
+	req := lifecycle.CreateRequest{
+		JobName:         jobName,
+		TargetLocaleIDs: locales,
+		Description:     template.Description,
+		ReferenceNumber: template.ReferenceNumber,
+		CallbackURL:     template.CallbackURL,
+	}
+	if template.CallbackURL != "" {
+		req.CallbackMethod = "GET"
+	}
+	if !dueDate.IsZero() {
+		req.DueDate = &dueDate
+	}
+	for _, fieldUID := range slices.Sorted(maps.Keys(template.CustomFields)) {
+		req.CustomFields = append(req.CustomFields, lifecycle.CustomField{
+			FieldUID:   fieldUID,
+			FieldValue: template.CustomFields[fieldUID],
+		})
+	}
+	jobUID, err := s.JobLifecycle.Create(ctx, projectID, req)
+	if err != nil {
+		return "", "", nil, clierror.UIError{
+			Err:         err,
+			Operation:   "Create",
+			Description: fmt.Sprintf("Unable to create job from template %q", params.JobTemplate),
+			Fields: map[string]string{
+				"jobName": jobName,
+			},
+		}
+	}
+	return jobUID, jobName, locales, nil
+}
+
+func currentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
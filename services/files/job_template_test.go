@@ -0,0 +1,74 @@
+package files
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	"github.com/Smartling/smartling-cli/services/helpers/config"
+	"github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateJob_CreatesThenReuses(t *testing.T) {
+	server := httptest.NewServer(devserver.NewServer(devserver.Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		ProjectName:    "Project",
+		SourceLocaleID: "en-US",
+		TargetLocales:  []string{"de-DE", "fr-FR"},
+	}))
+	t.Cleanup(server.Close)
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret")
+	client.Client.BaseURL = server.URL
+
+	jobAPI := jobapi.NewJob(client.Client)
+	s := service{
+		JobApi:       jobAPI,
+		JobLifecycle: lifecycle.NewAPI(client.Client),
+		Config: config.Config{
+			ProjectID: "project",
+			Jobs: map[string]config.JobTemplate{
+				"release": {
+					Name:            "Release {branch}",
+					Description:     "release strings",
+					DueIn:           "3d",
+					Locales:         []string{"fr-FR"},
+					ReferenceNumber: "REL",
+				},
+			},
+		},
+	}
+	params := PushParams{JobTemplate: "release", Branch: "main"}
+	ctx := context.Background()
+
+	jobUID, jobName, locales, err := s.templateJob(ctx, "project", params)
+	require.NoError(t, err)
+	assert.Equal(t, "Release main", jobName)
+	assert.Equal(t, []string{"fr-FR"}, locales)
+
+	created, err := jobAPI.GetJob(ctx, "project", jobUID)
+	require.NoError(t, err)
+	assert.Equal(t, "release strings", created.Description)
+	assert.Equal(t, "REL", created.ReferenceNumber)
+	assert.Equal(t, []string{"fr-FR"}, created.TargetLocaleIDs)
+	listed, err := jobAPI.ListProjectJobs(ctx, "project", jobapi.ListProjectJobsParams{JobName: jobName})
+	require.NoError(t, err)
+	require.Len(t, listed.Items, 1)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 3), listed.Items[0].Dates.Due, time.Minute)
+
+	params.Locales = []string{"de-DE"}
+	reusedUID, _, locales, err := s.templateJob(ctx, "project", params)
+	require.NoError(t, err)
+	assert.Equal(t, jobUID, reusedUID)
+	assert.Equal(t, []string{"de-DE"}, locales, "--locale overrides template locales")
+
+	_, _, _, err = s.templateJob(ctx, "project", PushParams{JobTemplate: "nightly"})
+	assert.ErrorContains(t, err, "available: release")
+}
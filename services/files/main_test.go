@@ -0,0 +1,15 @@
+package files
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+// TestMain initializes the global logger; RunPull/RunPush/RunDelete call
+// rlog, which panics on a nil logger without Init.
+func TestMain(m *testing.M) {
+	rlog.Init()
+	os.Exit(m.Run())
+}
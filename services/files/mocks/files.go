@@ -39,16 +39,16 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 }
 
 // RunDelete provides a mock function for the type MockService
-func (_mock *MockService) RunDelete(ctx context.Context, uri string) error {
-	ret := _mock.Called(ctx, uri)
+func (_mock *MockService) RunDelete(ctx context.Context, params files.DeleteParams) error {
+	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RunDelete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, uri)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, files.DeleteParams) error); ok {
+		r0 = returnFunc(ctx, params)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -62,20 +62,20 @@ type MockService_RunDelete_Call struct {
 
 // RunDelete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - uri string
-func (_e *MockService_Expecter) RunDelete(ctx interface{}, uri interface{}) *MockService_RunDelete_Call {
-	return &MockService_RunDelete_Call{Call: _e.mock.On("RunDelete", ctx, uri)}
+//   - params files.DeleteParams
+func (_e *MockService_Expecter) RunDelete(ctx interface{}, params interface{}) *MockService_RunDelete_Call {
+	return &MockService_RunDelete_Call{Call: _e.mock.On("RunDelete", ctx, params)}
 }
 
-func (_c *MockService_RunDelete_Call) Run(run func(ctx context.Context, uri string)) *MockService_RunDelete_Call {
+func (_c *MockService_RunDelete_Call) Run(run func(ctx context.Context, params files.DeleteParams)) *MockService_RunDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 files.DeleteParams
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(files.DeleteParams)
 		}
 		run(
 			arg0,
@@ -90,7 +90,7 @@ func (_c *MockService_RunDelete_Call) Return(err error) *MockService_RunDelete_C
 	return _c
 }
 
-func (_c *MockService_RunDelete_Call) RunAndReturn(run func(ctx context.Context, uri string) error) *MockService_RunDelete_Call {
+func (_c *MockService_RunDelete_Call) RunAndReturn(run func(ctx context.Context, params files.DeleteParams) error) *MockService_RunDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,154 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	jobfile "github.com/Smartling/api-sdk-go/api/job/file"
+)
+
+// jobDelta is the difference between the files of a translation job and the
+// local files pushed into it.
+type jobDelta struct {
+	Added     []string
+	Updated   []string
+	Unchanged []string
+	Removed   []string
+}
+
+// lines returns the summary printed after a job-scoped push.
+func (d jobDelta) lines() []string {
+	lines := []string{fmt.Sprintf(
+		"Job delta: %d added, %d updated, %d unchanged, %d removed",
+		len(d.Added), len(d.Updated), len(d.Unchanged), len(d.Removed),
+	)}
+	for _, group := range []struct {
+		status string
+		uris   []string
+	}{
+		{"added", d.Added},
+		{"updated", d.Updated},
+		{"removed", d.Removed},
+	} {
+		for _, uri := range group.uris {
+			lines = append(lines, fmt.Sprintf("  %-8s %s", group.status, uri))
+		}
+	}
+	return lines
+}
+
+// diffJobFiles compares the local files with the files of the job. A file
+// missing from the job is added; a file already in the job is updated only
+// when its content differs from the original uploaded to Smartling. It
+// returns the files and URIs which need uploading. With removeMissing, job
+// files which are not among the local ones are listed as removed.
+func (s service) diffJobFiles(ctx context.Context, projectID, jobUID string, files, uris []string, removeMissing bool) (jobDelta, []string, []string, error) {
+	jobFiles, err := listAllJobFiles(ctx, s.ListJobFiles, projectID, jobUID)
+	if err != nil {
+		return jobDelta{}, nil, nil, fmt.Errorf("unable to list files of job %q: %w", jobUID, err)
+	}
+	inJob := make(map[string]bool, len(jobFiles))
+	for _, f := range jobFiles {
+		inJob[f.FileURI] = true
+	}
+
+	var (
+		delta        jobDelta
+		changedFiles []string
+		changedURIs  []string
+	)
+	local := make(map[string]bool, len(uris))
+	for i, file := range files {
+		uri := uris[i]
+		local[uri] = true
+		if inJob[uri] {
+			changed, err := s.contentChanged(ctx, projectID, file, uri)
+			if err != nil {
+				return jobDelta{}, nil, nil, err
+			}
+			if !changed {
+				delta.Unchanged = append(delta.Unchanged, uri)
+				continue
+			}
+			delta.Updated = append(delta.Updated, uri)
+		} else {
+			delta.Added = append(delta.Added, uri)
+		}
+		changedFiles = append(changedFiles, file)
+		changedURIs = append(changedURIs, uri)
+	}
+	if removeMissing {
+		for _, f := range jobFiles {
+			if !local[f.FileURI] {
+				delta.Removed = append(delta.Removed, f.FileURI)
+			}
+		}
+	}
+	return delta, changedFiles, changedURIs, nil
+}
+
+// contentChanged reports whether the local file differs from the original
+// file stored in Smartling under uri.
+func (s service) contentChanged(ctx context.Context, projectID, file, uri string) (bool, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return false, clierror.UIError{
+			Err:       err,
+			Operation: "ReadFile",
+			Description: `Unable to read file contents.
+Check that file exists and readable by current user.`,
+			Fields: map[string]string{
+				"file": file,
+			},
+		}
+	}
+	reader, err := s.APIClient.DownloadFile(ctx, projectID, uri)
+	if err != nil {
+		return false, clierror.UIError{
+			Err:         err,
+			Operation:   "DownloadFile",
+			Description: "unable to download the uploaded original to compare with the local file",
+			Fields: map[string]string{
+				"file": uri,
+			},
+		}
+	}
+	defer func() { _ = reader.Close() }()
+	uploaded, err := io.ReadAll(reader)
+	if err != nil {
+		return false, fmt.Errorf("unable to read original file %q: %w", uri, err)
+	}
+	return !bytes.Equal(content, uploaded), nil
+}
+
+// finishDelta removes the delta's removed files from the job and prints the
+// delta summary. Files which fail to be removed are reported and make the
+// push a partial failure.
+func (s service) finishDelta(ctx context.Context, projectID, jobUID string, delta jobDelta) error {
+	var removed, failed []string
+	for _, uri := range delta.Removed {
+		_, err := s.RemoveJobFile(ctx, projectID, jobUID, jobfile.RemoveRequest{FileURI: uri})
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("unable to remove %q from job: %s", uri, err))
+			continue
+		}
+		removed = append(removed, uri)
+	}
+	delta.Removed = removed
+
+	for _, line := range delta.lines() {
+		fmt.Println(line)
+	}
+	for _, line := range failed {
+		_, _ = fmt.Fprintln(os.Stderr, line)
+	}
+	if len(failed) > 0 {
+		return clierror.PartialFailureError{Failed: len(failed), Total: len(failed) + len(removed)}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package files
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	"github.com/Smartling/smartling-cli/services/helpers/config"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	batchapi "github.com/Smartling/api-sdk-go/api/batches"
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	jobfile "github.com/Smartling/api-sdk-go/api/job/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPush_ChangedOnly(t *testing.T) {
+	interval := pollingInterval
+	pollingInterval = time.Millisecond
+	t.Cleanup(func() { pollingInterval = interval })
+
+	server := httptest.NewServer(devserver.NewServer(devserver.Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		ProjectName:    "Project",
+		SourceLocaleID: "en-US",
+		TargetLocales:  []string{"fr-FR"},
+	}))
+	t.Cleanup(server.Close)
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret")
+	client.Client.BaseURL = server.URL
+	jobAPI := jobapi.NewJob(client.Client)
+	jobFileAPI := jobfile.NewJobFile(client.Client)
+
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	write("a.json", `{"a": "A"}`)
+	write("b.json", `{"b": "B"}`)
+	write("c.json", `{"c": "C"}`)
+
+	batchAPI := batchapi.NewBatch(client.Client)
+	ctx := context.Background()
+	// The job is created upfront, as creating it on push looks up the local
+	// time zone online.
+	job, err := batchAPI.CreateJob(ctx, "project", batchapi.CreateJobPayload{
+		NameTemplate:    "Release",
+		TargetLocaleIds: []string{"fr-FR"},
+		TimeZoneName:    "UTC",
+	})
+	require.NoError(t, err)
+	jobUID := job.TranslationJobUID
+	s := service{
+		APIClient:     client,
+		BatchApi:      batchAPI,
+		JobApi:        jobAPI,
+		ListJobFiles:  jobFileAPI.List,
+		RemoveJobFile: jobFileAPI.Remove,
+		Config:        config.Config{Path: filepath.Join(dir, "smartling.yml"), ProjectID: "project"},
+	}
+	params := PushParams{
+		File:        "*.json",
+		Directory:   dir,
+		JobIDOrName: jobUID,
+		Locales:     []string{"fr-FR"},
+		ChangedOnly: true,
+	}
+	require.NoError(t, s.RunPush(ctx, params))
+
+	write("a.json", `{"a": "A2"}`)
+	require.NoError(t, os.Remove(filepath.Join(dir, "c.json")))
+	write("d.json", `{"d": "D"}`)
+	files := []string{filepath.Join(dir, "a.json"), filepath.Join(dir, "b.json"), filepath.Join(dir, "d.json")}
+	delta, changed, changedURIs, err := s.diffJobFiles(ctx, "project", jobUID, files, []string{"a.json", "b.json", "d.json"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, jobDelta{
+		Added:     []string{"d.json"},
+		Updated:   []string{"a.json"},
+		Unchanged: []string{"b.json"},
+		Removed:   []string{"c.json"},
+	}, delta)
+	assert.Equal(t, []string{files[0], files[2]}, changed)
+	assert.Equal(t, []string{"a.json", "d.json"}, changedURIs)
+
+	params.RemoveMissing = true
+	params.ReportFile = filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, s.RunPush(ctx, params))
+
+	report, err := os.ReadFile(params.ReportFile)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"operation": "push",
+		"summary": {"total": 3, "ok": 2, "skipped": 1, "failed": 0},
+		"items": [
+			{"file": "a.json", "status": "ok"},
+			{"file": "b.json", "status": "skipped", "reason": "unchanged"},
+			{"file": "d.json", "status": "ok"}
+		]
+	}`, string(report))
+
+	listed, err := jobFileAPI.List(ctx, "project", jobUID, 100, 0)
+	require.NoError(t, err)
+	var uris []string
+	for _, f := range listed.Items {
+		uris = append(uris, f.FileURI)
+	}
+	slices.Sort(uris)
+	assert.Equal(t, []string{"a.json", "b.json", "d.json"}, uris)
+}
+
+func TestPushParams_RemoveMissingRequiresChangedOnly(t *testing.T) {
+	assert.Error(t, PushParams{RemoveMissing: true}.Validate())
+	assert.Error(t, PushParams{NoJob: true, ChangedOnly: true}.Validate())
+	assert.NoError(t, PushParams{ChangedOnly: true, RemoveMissing: true}.Validate())
+}
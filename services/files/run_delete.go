@@ -3,18 +3,28 @@ package files
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	globfiles "github.com/Smartling/smartling-cli/services/helpers/glob_files"
 	"github.com/Smartling/smartling-cli/services/helpers/reader"
+	"github.com/Smartling/smartling-cli/services/helpers/result"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	sdkfile "github.com/Smartling/api-sdk-go/helpers/sm_file"
 	"github.com/reconquest/hierr-go"
 )
 
+// DeleteParams is the parameters for the RunDelete method.
+type DeleteParams struct {
+	URI        string
+	ReportFile string
+}
+
 // RunDelete deletes files from the Smartling project based on the provided URI.
-func (s service) RunDelete(ctx context.Context, uri string) error {
+func (s service) RunDelete(ctx context.Context, params DeleteParams) error {
 	projectID := s.Config.ProjectID
+	uri := params.URI
 	var (
 		err   error
 		files []sdkfile.File
@@ -39,18 +49,27 @@ func (s service) RunDelete(ctx context.Context, uri string) error {
 		)
 	}
 
+	report := result.NewReport("delete")
 	for _, file := range files {
 		err := s.APIClient.DeleteFile(ctx, projectID, file.FileURI)
 		if err != nil {
-			return hierr.Errorf(
+			err = hierr.Errorf(
 				err,
 				`unable to delete file "%s"`,
 				file.FileURI,
 			)
+			rlog.Error(err.Error())
+			report.Failed(file.FileURI, "", err)
+			continue
 		}
+		report.OK(file.FileURI, "")
 
+		rlog.WithFields(rlog.Fields{
+			"operation": "DeleteFile",
+			"file":      file.FileURI,
+		}).Debugf("deleted file")
 		fmt.Printf("%s deleted\n", file.FileURI)
 	}
 
-	return nil
+	return report.Finish(os.Stdout, params.ReportFile)
 }
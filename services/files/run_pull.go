@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
-	"sync/atomic"
 
 	"github.com/Smartling/smartling-cli/services/helpers"
 	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
@@ -16,6 +15,7 @@ import (
 	"github.com/Smartling/smartling-cli/services/helpers/format"
 	globfiles "github.com/Smartling/smartling-cli/services/helpers/glob_files"
 	"github.com/Smartling/smartling-cli/services/helpers/reader"
+	"github.com/Smartling/smartling-cli/services/helpers/result"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
 
@@ -46,6 +46,7 @@ type PullParams struct {
 	Progress     string
 	Retrieve     string
 	Threads      uint32
+	ReportFile   string
 	jobUID       string
 }
 
@@ -135,24 +136,22 @@ func (s service) RunPull(ctx context.Context, params PullParams) error {
 	if params.Threads > 0 {
 		group.SetLimit(int(params.Threads))
 	}
-	var failed atomic.Int32
+	report := result.NewReport("pull")
 	for _, file := range files {
 		group.Go(func() error {
 			if err := groupCtx.Err(); err != nil {
+				report.Skipped(file.FileURI, "", "cancelled")
 				return nil
 			}
-			if err := s.downloadFileTranslations(groupCtx, params, file); err != nil {
-				failed.Add(1)
+			if err := s.downloadFileTranslations(groupCtx, params, file, report); err != nil {
+				report.Failed(file.FileURI, "", err)
 				rlog.Error(err)
 			}
 			return nil
 		})
 	}
 	_ = group.Wait()
-	if n := failed.Load(); n > 0 {
-		return fmt.Errorf("%d file(s) failed to download; see log for details", n)
-	}
-	return nil
+	return report.Finish(os.Stdout, params.ReportFile)
 }
 
 // printDryRun writes the resolved file × locale matrix to stdout without
@@ -196,7 +195,9 @@ func (s service) renderPullPath(file sdkfile.File, locale string, params PullPar
 	)
 }
 
-func (s service) downloadFileTranslations(ctx context.Context, params PullParams, file sdkfile.File) error {
+// downloadFileTranslations downloads every requested locale of file, recording
+// each locale in report. Returned error means the file as a whole failed.
+func (s service) downloadFileTranslations(ctx context.Context, params PullParams, file sdkfile.File, report *result.Report) error {
 	progress := strings.TrimSpace(params.Progress)
 	progress = strings.TrimSpace(strings.TrimSuffix(progress, "%"))
 	if progress == "" {
@@ -242,22 +243,26 @@ func (s service) downloadFileTranslations(ctx context.Context, params PullParams
 
 		path, err := s.renderPullPath(file, locale.LocaleID, params)
 		if err != nil {
-			return err
+			report.Failed(file.FileURI, locale.LocaleID, err)
+			continue
 		}
 
 		progressPercent, err := locale.ProgressPercent(status.TotalStringCount)
 		if err != nil {
-			return err
+			report.Failed(file.FileURI, locale.LocaleID, err)
+			continue
 		}
 		path = filepath.Join(params.Directory, path)
 		if progressThreshold > 0 && progressPercent < int(progressThreshold) {
 			fmt.Printf("skipped %s %d%% (threshold: %s%%)\n", path, progressPercent, params.Progress)
+			report.Skipped(file.FileURI, locale.LocaleID, fmt.Sprintf("progress %d%% below threshold", progressPercent))
 			continue
 		}
 
 		if params.Resume {
 			if _, err := os.Stat(path); err == nil {
 				fmt.Printf("skipped %s (already exists)\n", path)
+				report.Skipped(file.FileURI, locale.LocaleID, "already exists")
 				continue
 			}
 		}
@@ -272,8 +277,18 @@ func (s service) downloadFileTranslations(ctx context.Context, params PullParams
 			retrievalType,
 		)
 		if err != nil {
-			return err
+			rlog.Error(err)
+			report.Failed(file.FileURI, locale.LocaleID, err)
+			continue
 		}
+		report.OK(file.FileURI, locale.LocaleID)
+
+		rlog.WithFields(rlog.Fields{
+			"operation": "DownloadFile",
+			"file":      file.FileURI,
+			"locale":    locale.LocaleID,
+			"path":      path,
+		}).Debugf("downloaded file")
 
 		if params.Source {
 			fmt.Printf("downloaded %s\n", path)
@@ -282,7 +297,7 @@ func (s service) downloadFileTranslations(ctx context.Context, params PullParams
 		}
 	}
 
-	return err
+	return nil
 }
 
 func hasLocaleInList(locale string, locales []string) bool {
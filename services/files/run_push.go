@@ -15,6 +15,7 @@ import (
 
 	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	globfiles "github.com/Smartling/smartling-cli/services/helpers/glob_files"
+	"github.com/Smartling/smartling-cli/services/helpers/result"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	api "github.com/Smartling/api-sdk-go/api/batches"
@@ -33,7 +34,14 @@ type PushParams struct {
 	FileType    string
 	Directives  map[string]string
 	JobIDOrName string
+	JobTemplate string
 	NoJob       bool
+	ReportFile  string
+	// ChangedOnly uploads only files which are new to the job or whose
+	// content differs from the uploaded original.
+	ChangedOnly bool
+	// RemoveMissing removes job files which are not among the pushed files.
+	RemoveMissing bool
 }
 
 // Validate checks that the PushParams are valid
@@ -43,16 +51,31 @@ func (p PushParams) Validate() error {
 		if p.JobIDOrName != "" {
 			incompatibleWithParams = append(incompatibleWithParams, "job")
 		}
+		if p.JobTemplate != "" {
+			incompatibleWithParams = append(incompatibleWithParams, "job-template")
+		}
 		if p.Authorize {
 			incompatibleWithParams = append(incompatibleWithParams, "authorize")
 		}
 		if len(p.Locales) > 0 {
 			incompatibleWithParams = append(incompatibleWithParams, "locale")
 		}
+		if p.ChangedOnly {
+			incompatibleWithParams = append(incompatibleWithParams, "changed-only")
+		}
+		if p.RemoveMissing {
+			incompatibleWithParams = append(incompatibleWithParams, "remove-missing")
+		}
 		if len(incompatibleWithParams) > 0 {
 			return clierror.ErrIncompatibleParams("nojob", incompatibleWithParams)
 		}
 	}
+	if p.JobTemplate != "" && p.JobIDOrName != "" {
+		return clierror.ErrIncompatibleParams("job-template", []string{"job"})
+	}
+	if p.RemoveMissing && !p.ChangedOnly {
+		return errors.New("--remove-missing requires --changed-only")
+	}
 	return nil
 }
 
@@ -149,6 +172,12 @@ func (s service) runPushWithJob(ctx context.Context, params PushParams, files []
 	pattern := `^[a-z0-9]{12}$`
 	var jobUID string
 	var jobName string
+	if params.JobTemplate != "" {
+		jobUID, jobName, params.Locales, err = s.templateJob(ctx, projectID, params)
+		if err != nil {
+			return err
+		}
+	}
 	if re := regexp.MustCompile(pattern); params.JobIDOrName != "" && re.MatchString(params.JobIDOrName) {
 		jobUID = params.JobIDOrName
 		jobNameResponse, err := s.JobApi.GetJob(ctx, projectID, jobUID)
@@ -193,6 +222,57 @@ func (s service) runPushWithJob(ctx context.Context, params PushParams, files []
 	jobURL := getJobURL(projectID, jobUID)
 	fmt.Printf("Smartling Job URL: %s\n", jobURL)
 
+	report := result.NewReport("push")
+	// finish prints the summary and writes the report. A set err is returned
+	// along with the partial failure of the report, if any.
+	finish := func(err error) error {
+		finishErr := report.Finish(os.Stdout, params.ReportFile)
+		switch {
+		case err == nil:
+			return finishErr
+		case errors.As(finishErr, new(clierror.PartialFailureError)):
+			return errors.Join(finishErr, err)
+		case finishErr != nil:
+			rlog.Errorf("%s", finishErr)
+		}
+		return err
+	}
+	var delta jobDelta
+	if params.ChangedOnly {
+		delta, files, fileUris, err = s.diffJobFiles(ctx, projectID, jobUID, files, fileUris, params.RemoveMissing)
+		if err != nil {
+			return err
+		}
+		for _, uri := range delta.Unchanged {
+			report.Skipped(uri, "", "unchanged")
+		}
+		if len(files) == 0 {
+			fmt.Println("no new or changed files to upload")
+			return finish(s.finishDelta(ctx, projectID, jobUID, delta))
+		}
+	}
+	// stopUpload records the file as failed and the other files as not
+	// processed, as the batch is processed only once all of its files are
+	// uploaded, and finishes the report.
+	stopUpload := func(fileID int, err error) error {
+		for _, uri := range fileUris[:fileID] {
+			report.Skipped(uri, "", "batch not processed after a failed upload")
+		}
+		report.Failed(fileUris[fileID], "", err)
+		for _, uri := range fileUris[fileID+1:] {
+			report.Skipped(uri, "", "not uploaded after an earlier failure")
+		}
+		return finish(err)
+	}
+	// failBatch records every uploaded file as failed with err and finishes
+	// the report.
+	failBatch := func(err error) error {
+		for _, uri := range fileUris {
+			report.Failed(uri, "", err)
+		}
+		return finish(err)
+	}
+
 	createBatchResponse, err := s.BatchApi.Create(ctx, projectID, api.CreateBatchPayload{
 		Authorize:         params.Authorize,
 		TranslationJobUID: jobUID,
@@ -205,7 +285,7 @@ func (s service) runPushWithJob(ctx context.Context, params PushParams, files []
 	for fileID, file := range files {
 		content, err := os.ReadFile(file)
 		if err != nil {
-			return clierror.UIError{
+			return stopUpload(fileID, clierror.UIError{
 				Err:       err,
 				Operation: "ReadFile",
 				Description: `Unable to read file contents.
@@ -213,7 +293,7 @@ Check that file exists and readable by current user.`,
 				Fields: map[string]string{
 					"file": file,
 				},
-			}
+			})
 		}
 		var fileType api.Type
 		var found bool
@@ -234,7 +314,7 @@ Check that file exists and readable by current user.`,
 		if len(locales) == 0 {
 			locales, err = s.getLocales(ctx, projectID)
 			if err != nil {
-				return err
+				return stopUpload(fileID, err)
 			}
 		}
 		payload := api.UploadFilePayload{
@@ -250,7 +330,7 @@ Check that file exists and readable by current user.`,
 		}
 		uploadFileResponse, err := s.BatchApi.UploadFile(ctx, projectID, createBatchResponse.BatchUID, payload)
 		if err != nil {
-			return clierror.UIError{
+			return stopUpload(fileID, clierror.UIError{
 				Err:         err,
 				Operation:   "UploadFile",
 				Description: fmt.Sprintf(`unable to upload file "%s"`, file),
@@ -258,9 +338,13 @@ Check that file exists and readable by current user.`,
 					"Filename": fileUris[fileID],
 					"FileType": fileType.String(),
 				},
-			}
+			})
 		}
-		rlog.Debugf("uploaded file %v", uploadFileResponse)
+		rlog.WithFields(rlog.Fields{
+			"operation": "UploadFile",
+			"file":      fileUris[fileID],
+			"locales":   locales,
+		}).Debugf("uploaded file %v", uploadFileResponse)
 		fmt.Printf(
 			"%s (%s) %s [code: %d]\n",
 			fileUris[fileID],
@@ -274,26 +358,28 @@ Check that file exists and readable by current user.`,
 	var processed bool
 	for !processed {
 		if time.Since(started) > pollingDuration {
-			return errors.New("timeout exceeded for polling batch status: " + createBatchResponse.BatchUID)
+			return failBatch(errors.New("timeout exceeded for polling batch status: " + createBatchResponse.BatchUID))
 		}
 		time.Sleep(pollingInterval)
 		getStatusResponse, err := s.BatchApi.GetStatus(ctx, projectID, createBatchResponse.BatchUID)
 		if err != nil {
-			return clierror.UIError{
+			return failBatch(clierror.UIError{
 				Err:         err,
 				Operation:   "GetStatus",
 				Description: `unable to get status for batch`,
 				Fields: map[string]string{
 					"code": strconv.Itoa(getStatusResponse.Code),
 				},
-			}
+			})
 		}
 		if strings.ToLower(getStatusResponse.Status) == "completed" {
 			processed = true
 		}
 		errorsInFiles := make(map[string]string)
+		completed := make(map[string]bool)
 		for _, file := range getStatusResponse.Files {
 			if strings.ToLower(file.Status) == "completed" {
+				completed[file.FileUri] = true
 				continue
 			}
 			if file.Errors != "" && file.Errors != "{}" {
@@ -301,16 +387,32 @@ Check that file exists and readable by current user.`,
 			}
 		}
 		if (getStatusResponse.GeneralErrors != "" && getStatusResponse.GeneralErrors != "{}") || len(errorsInFiles) > 0 {
-			return clierror.UIError{
+			for _, uri := range fileUris {
+				switch {
+				case errorsInFiles[uri] != "":
+					report.Failed(uri, "", errors.New(errorsInFiles[uri]))
+				case completed[uri] || processed:
+					report.OK(uri, "")
+				default:
+					report.Skipped(uri, "", "batch processing stopped on errors")
+				}
+			}
+			return finish(clierror.UIError{
 				Err:         errors.New(getStatusResponse.GeneralErrors),
 				Operation:   "GetStatus",
 				Description: `errors occurred during batch processing`,
 				Fields:      errorsInFiles,
-			}
+			})
 		}
 	}
 	fmt.Println("batch is processed successfully")
-	return nil
+	for _, uri := range fileUris {
+		report.OK(uri, "")
+	}
+	if params.ChangedOnly {
+		return finish(s.finishDelta(ctx, projectID, jobUID, delta))
+	}
+	return finish(nil)
 }
 
 func (s service) runPushWithoutJob(ctx context.Context, params PushParams, files []string, projectID string) error {
@@ -319,7 +421,7 @@ func (s service) runPushWithoutJob(ctx context.Context, params PushParams, files
 		return err
 	}
 
-	var failedFiles []string
+	report := result.NewReport("push")
 	for fileID, file := range files {
 		fileConfig, err := s.Config.GetFileConfig(file)
 		if err != nil {
@@ -410,8 +512,9 @@ func (s service) runPushWithoutJob(ctx context.Context, params PushParams, files
 				)
 			}
 			_, _ = fmt.Fprintln(os.Stderr, "Unable to upload file "+file)
-			failedFiles = append(failedFiles, file)
+			report.Failed(fileUris[fileID], "", err)
 		} else {
+			report.OK(fileUris[fileID], "")
 			status := "new"
 			if response.Overwritten {
 				status = "overwritten"
@@ -428,11 +531,7 @@ func (s service) runPushWithoutJob(ctx context.Context, params PushParams, files
 		}
 	}
 
-	if len(failedFiles) != 0 {
-		return clierror.NewError(fmt.Errorf("failed to upload %d files", len(failedFiles)), "failed to upload files "+strings.Join(failedFiles, ", "))
-	}
-
-	return nil
+	return report.Finish(os.Stdout, params.ReportFile)
 }
 
 func (s service) getLocales(ctx context.Context, project string) ([]string, error) {
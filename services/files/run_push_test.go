@@ -0,0 +1,95 @@
+package files
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/config"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	batchapi "github.com/Smartling/api-sdk-go/api/batches"
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingBatch fails the upload of one file URI.
+type failingBatch struct {
+	batchapi.Batch
+	failURI string
+}
+
+func (b failingBatch) UploadFile(ctx context.Context, projectID, batchUID string, payload batchapi.UploadFilePayload) (batchapi.UploadFileResponse, error) {
+	if payload.FileUri == b.failURI {
+		return batchapi.UploadFileResponse{}, errors.New("upload rejected")
+	}
+	return b.Batch.UploadFile(ctx, projectID, batchUID, payload)
+}
+
+func TestRunPush_JobReport(t *testing.T) {
+	interval := pollingInterval
+	pollingInterval = time.Millisecond
+	t.Cleanup(func() { pollingInterval = interval })
+
+	server := httptest.NewServer(devserver.NewServer(devserver.Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		ProjectName:    "Project",
+		SourceLocaleID: "en-US",
+		TargetLocales:  []string{"fr-FR"},
+	}))
+	t.Cleanup(server.Close)
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret")
+	client.Client.BaseURL = server.URL
+
+	dir := t.TempDir()
+	for _, name := range []string{"a.json", "b.json", "c.json"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(`{"key": "value"}`), 0o600))
+	}
+	batchAPI := batchapi.NewBatch(client.Client)
+	// The job is created upfront, as creating it on push looks up the local
+	// time zone online.
+	job, err := batchAPI.CreateJob(context.Background(), "project", batchapi.CreateJobPayload{
+		NameTemplate:    "Release",
+		TargetLocaleIds: []string{"fr-FR"},
+		TimeZoneName:    "UTC",
+	})
+	require.NoError(t, err)
+	s := service{
+		APIClient: client,
+		BatchApi:  failingBatch{Batch: batchAPI, failURI: "b.json"},
+		JobApi:    jobapi.NewJob(client.Client),
+		Config:    config.Config{Path: filepath.Join(dir, "smartling.yml"), ProjectID: "project"},
+	}
+	params := PushParams{
+		File:        "*.json",
+		Directory:   dir,
+		JobIDOrName: job.TranslationJobUID,
+		Locales:     []string{"fr-FR"},
+		ReportFile:  filepath.Join(t.TempDir(), "report.json"),
+	}
+	err = s.RunPush(context.Background(), params)
+	var uiErr clierror.UIError
+	require.ErrorAs(t, err, &uiErr)
+	assert.Equal(t, "UploadFile", uiErr.Operation)
+	assert.Equal(t, clierror.ExitPartial, clierror.ExitCode(err))
+
+	report, err := os.ReadFile(params.ReportFile)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"operation": "push",
+		"summary": {"total": 3, "ok": 0, "skipped": 2, "failed": 1},
+		"items": [
+			{"file": "a.json", "status": "skipped", "reason": "batch not processed after a failed upload"},
+			{"file": "b.json", "status": "failed", "reason": "upload rejected"},
+			{"file": "c.json", "status": "skipped", "reason": "not uploaded after an earlier failure"}
+		]
+	}`, string(report))
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/Smartling/smartling-cli/services/helpers/config"
+	"github.com/Smartling/smartling-cli/services/jobs/lifecycle"
 
 	sdk "github.com/Smartling/api-sdk-go"
 	batchapi "github.com/Smartling/api-sdk-go/api/batches"
@@ -23,9 +24,13 @@ var (
 // pull service list job files without depending on the full JobFile interface.
 type ListJobFilesFn func(ctx context.Context, projectID, jobUID string, limit, offset uint32) (jobfile.ListResponse, error)
 
+// RemoveJobFileFn removes a source file from a translation job. It lets the
+// push service prune job files without depending on the full JobFile interface.
+type RemoveJobFileFn func(ctx context.Context, projectID, jobUID string, req jobfile.RemoveRequest) (jobfile.Result, error)
+
 // Service defines behaviors to interact with Smartling files.
 type Service interface {
-	RunDelete(ctx context.Context, uri string) error
+	RunDelete(ctx context.Context, params DeleteParams) error
 	RunImport(ctx context.Context, params ImportParams) error
 	RunList(ctx context.Context, formatType string, short bool, uri string) error
 	RunPull(ctx context.Context, params PullParams) error
@@ -36,12 +41,14 @@ type Service interface {
 
 // service provides methods to interact with Smartling files.
 type service struct {
-	APIClient    sdk.APIClient
-	BatchApi     batchapi.Batch
-	JobApi       jobapi.Job
-	ListJobFiles ListJobFilesFn
-	Config       config.Config
-	FileConfig   config.FileConfig
+	APIClient     sdk.APIClient
+	BatchApi      batchapi.Batch
+	JobApi        jobapi.Job
+	ListJobFiles  ListJobFilesFn
+	RemoveJobFile RemoveJobFileFn
+	JobLifecycle  lifecycle.API
+	Config        config.Config
+	FileConfig    config.FileConfig
 }
 
 // NewService creates a new instance of the Service with the provided client, and configurations.
@@ -49,15 +56,19 @@ func NewService(client sdk.APIClient,
 	batchApi batchapi.Batch,
 	jobApi jobapi.Job,
 	listJobFiles ListJobFilesFn,
+	removeJobFile RemoveJobFileFn,
+	jobLifecycle lifecycle.API,
 	config config.Config,
 	fileConfig config.FileConfig,
 ) Service {
 	return &service{
-		APIClient:    client,
-		BatchApi:     batchApi,
-		JobApi:       jobApi,
-		ListJobFiles: listJobFiles,
-		Config:       config,
-		FileConfig:   fileConfig,
+		APIClient:     client,
+		BatchApi:      batchApi,
+		JobApi:        jobApi,
+		ListJobFiles:  listJobFiles,
+		RemoveJobFile: removeJobFile,
+		JobLifecycle:  jobLifecycle,
+		Config:        config,
+		FileConfig:    fileConfig,
 	}
 }
@@ -0,0 +1,446 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/Smartling/smartling-cli/services/helpers/client"
+
+	api "github.com/Smartling/api-sdk-go/api/glossary"
+	smclient "github.com/Smartling/api-sdk-go/helpers/sm_client"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+const glossaryBasePath = "/glossary-api/v3/accounts/"
+
+var (
+	// ErrEntryNotFound is returned when a glossary entry does not exist.
+	ErrEntryNotFound = errors.New("glossary entry not found")
+	// ErrLabelNotFound is returned when a glossary label does not exist.
+	ErrLabelNotFound = errors.New("glossary label not found")
+)
+
+// API defines glossary calls which are missing from the SDK glossary API.
+type API interface {
+	GetGlossary(ctx context.Context, accountUID uid.AccountUID, glossaryUID string) (GlossaryDetails, error)
+	UpdateGlossary(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, req api.CreateGlossaryRequest) (GlossaryDetails, error)
+	ArchiveGlossary(ctx context.Context, accountUID uid.AccountUID, glossaryUID string) error
+	DeleteGlossary(ctx context.Context, accountUID uid.AccountUID, glossaryUID string) error
+	ImportCancel(ctx context.Context, accountUID uid.AccountUID, glossaryUID, importUID string) error
+	SearchEntries(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, filter EntryFilter) (EntryList, error)
+	GetEntry(ctx context.Context, accountUID uid.AccountUID, glossaryUID, entryUID string) (Entry, error)
+	CreateEntry(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, req EntryRequest) (Entry, error)
+	UpdateEntry(ctx context.Context, accountUID uid.AccountUID, glossaryUID, entryUID string, req EntryRequest) (Entry, error)
+	ArchiveEntries(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, entryUIDs []string) error
+	ExportEntries(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, req ExportRequest) (api.ExportGlossaryResponse, error)
+	ListLabels(ctx context.Context, accountUID uid.AccountUID) ([]Label, error)
+	CreateLabel(ctx context.Context, accountUID uid.AccountUID, name string) (Label, error)
+	DeleteLabel(ctx context.Context, accountUID uid.AccountUID, labelUID string) error
+}
+
+// GlossaryDetails is the full metadata of a glossary. The SDK glossary read
+// drops the fallback locales and the audit fields.
+type GlossaryDetails struct {
+	GlossaryUID       string               `json:"glossaryUid"`
+	AccountUID        string               `json:"accountUid"`
+	GlossaryName      string               `json:"glossaryName"`
+	Description       string               `json:"description"`
+	VerificationMode  bool                 `json:"verificationMode"`
+	Archived          bool                 `json:"archived"`
+	LocaleIDs         []string             `json:"localeIds"`
+	FallbackLocales   []api.FallbackLocale `json:"fallbackLocales"`
+	CreatedDate       string               `json:"createdDate,omitempty"`
+	CreatedByUserUID  string               `json:"createdByUserUid,omitempty"`
+	ModifiedDate      string               `json:"modifiedDate,omitempty"`
+	ModifiedByUserUID string               `json:"modifiedByUserUid,omitempty"`
+}
+
+// Entry is a glossary entry as returned by the Glossary Entries API.
+type Entry struct {
+	EntryUID          string             `json:"entryUid"`
+	Definition        string             `json:"definition,omitempty"`
+	PartOfSpeech      string             `json:"partOfSpeech,omitempty"`
+	LabelUIDs         []string           `json:"labelUids,omitempty"`
+	EntryState        string             `json:"entryState,omitempty"`
+	Translations      []EntryTranslation `json:"translations"`
+	CreatedDate       string             `json:"createdDate,omitempty"`
+	CreatedByUserUID  string             `json:"createdByUserUid,omitempty"`
+	ModifiedDate      string             `json:"modifiedDate,omitempty"`
+	ModifiedByUserUID string             `json:"modifiedByUserUid,omitempty"`
+}
+
+// EntryTranslation is the term of an entry in one locale.
+type EntryTranslation struct {
+	LocaleID       string `json:"localeId"`
+	Term           string `json:"term"`
+	Notes          string `json:"notes,omitempty"`
+	DoNotTranslate bool   `json:"doNotTranslate,omitempty"`
+}
+
+// EntryRequest is the body of the entry create and update calls.
+type EntryRequest struct {
+	Definition   string             `json:"definition,omitempty"`
+	PartOfSpeech string             `json:"partOfSpeech,omitempty"`
+	LabelUIDs    []string           `json:"labelUids"`
+	Translations []EntryTranslation `json:"translations"`
+}
+
+// EntryList is a page of entry search results.
+type EntryList struct {
+	TotalCount int     `json:"totalCount"`
+	Items      []Entry `json:"items"`
+}
+
+// EntryFilter is the `filter` object of the entry search and export calls.
+// Labels replaces the labels object of the SDK filter, which has no label
+// UIDs.
+type EntryFilter struct {
+	api.ExportGlossaryFilter
+	Labels *LabelsFilter `json:"labels,omitempty"`
+}
+
+// LabelsFilter matches entries by their labels.
+type LabelsFilter struct {
+	Type      string   `json:"type,omitempty"`
+	LabelUIDs []string `json:"labelUids,omitempty"`
+}
+
+// ExportRequest is the body of the export call with the full entry filter.
+type ExportRequest struct {
+	api.ExportGlossaryRequest
+	Filter EntryFilter `json:"filter"`
+}
+
+// Label is a glossary label. Labels belong to the account and can be put on
+// the entries of any of its glossaries.
+type Label struct {
+	LabelUID          string `json:"labelUid"`
+	LabelName         string `json:"labelName"`
+	CreatedDate       string `json:"createdDate,omitempty"`
+	CreatedByUserUID  string `json:"createdByUserUid,omitempty"`
+	ModifiedDate      string `json:"modifiedDate,omitempty"`
+	ModifiedByUserUID string `json:"modifiedByUserUid,omitempty"`
+}
+
+// NewAPI returns new API implementation
+func NewAPI(client *smclient.Client) API {
+	return httpAPI{client: client}
+}
+
+type httpAPI struct {
+	client *smclient.Client
+}
+
+// GetGlossary returns the full metadata of a glossary.
+// Endpoint: GET /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}.
+func (h httpAPI) GetGlossary(ctx context.Context, accountUID uid.AccountUID, glossaryUID string) (GlossaryDetails, error) {
+	if glossaryUID == "" {
+		return GlossaryDetails{}, smerror.ErrEmptyParam("glossaryUID")
+	}
+	var details GlossaryDetails
+	_, code, err := h.client.GetJSON(ctx, glossaryURL(accountUID, glossaryUID), nil, &details)
+	if err != nil && code == http.StatusNotFound {
+		return GlossaryDetails{}, api.ErrGlossaryNotFound
+	}
+	if err != nil {
+		return GlossaryDetails{}, fmt.Errorf("failed to get glossary: %w", err)
+	}
+	return details, nil
+}
+
+// UpdateGlossary replaces the metadata of a glossary; the body has the same
+// fields as the create call.
+// Endpoint: PUT /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}.
+func (h httpAPI) UpdateGlossary(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, req api.CreateGlossaryRequest) (GlossaryDetails, error) {
+	if glossaryUID == "" {
+		return GlossaryDetails{}, smerror.ErrEmptyParam("glossaryUID")
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return GlossaryDetails{}, fmt.Errorf("failed to marshal glossary: %w", err)
+	}
+	var details GlossaryDetails
+	code, err := client.PutJSON(ctx, h.client, glossaryURL(accountUID, glossaryUID), payload, &details)
+	if err != nil && code == http.StatusNotFound {
+		return GlossaryDetails{}, api.ErrGlossaryNotFound
+	}
+	if err != nil {
+		return GlossaryDetails{}, fmt.Errorf("failed to update glossary: %w", err)
+	}
+	return details, nil
+}
+
+// ArchiveGlossary archives a glossary; an archived glossary keeps its entries
+// but is no longer applied to translations.
+// Endpoint: POST /glossary-api/v3/accounts/{accountUid}/glossaries/archive.
+func (h httpAPI) ArchiveGlossary(ctx context.Context, accountUID uid.AccountUID, glossaryUID string) error {
+	if glossaryUID == "" {
+		return smerror.ErrEmptyParam("glossaryUID")
+	}
+	payload, err := json.Marshal(map[string][]string{"glossaryUids": {glossaryUID}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal glossary archive request: %w", err)
+	}
+	reqURL := path.Join(glossaryBasePath, url.PathEscape(string(accountUID)), "glossaries", "archive")
+	_, code, err := h.client.PostJSON(ctx, reqURL, payload, nil)
+	if err != nil && code == http.StatusNotFound {
+		return api.ErrGlossaryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to archive glossary: %w", err)
+	}
+	return nil
+}
+
+// DeleteGlossary deletes a glossary together with its entries.
+// Endpoint: DELETE /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}.
+func (h httpAPI) DeleteGlossary(ctx context.Context, accountUID uid.AccountUID, glossaryUID string) error {
+	if glossaryUID == "" {
+		return smerror.ErrEmptyParam("glossaryUID")
+	}
+	_, code, err := h.client.DeleteJSON(ctx, glossaryURL(accountUID, glossaryUID), nil)
+	if err != nil && code == http.StatusNotFound {
+		return api.ErrGlossaryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete glossary: %w", err)
+	}
+	return nil
+}
+
+// ImportCancel cancels a pending glossary import, leaving the glossary unchanged.
+// Endpoint: POST /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}/import/{importUid}/cancel.
+func (h httpAPI) ImportCancel(ctx context.Context, accountUID uid.AccountUID, glossaryUID, importUID string) error {
+	switch {
+	case glossaryUID == "":
+		return smerror.ErrEmptyParam("glossaryUID")
+	case importUID == "":
+		return smerror.ErrEmptyParam("importUID")
+	}
+	reqURL := path.Join(glossaryURL(accountUID, glossaryUID), "import", url.PathEscape(importUID), "cancel")
+	_, code, err := h.client.PostJSON(ctx, reqURL, nil, nil)
+	if err != nil && code == http.StatusNotFound {
+		return api.ErrImportNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to cancel glossary import: %w", err)
+	}
+	return nil
+}
+
+// SearchEntries returns a page of the glossary entries matching the filter.
+// Endpoint: POST /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}/entries/search.
+func (h httpAPI) SearchEntries(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, filter EntryFilter) (EntryList, error) {
+	if glossaryUID == "" {
+		return EntryList{}, smerror.ErrEmptyParam("glossaryUID")
+	}
+	payload, err := json.Marshal(filter)
+	if err != nil {
+		return EntryList{}, fmt.Errorf("failed to marshal entry search request: %w", err)
+	}
+	var list EntryList
+	_, code, err := h.client.PostJSON(ctx, path.Join(glossaryURL(accountUID, glossaryUID), "entries", "search"), payload, &list)
+	if err != nil && code == http.StatusNotFound {
+		return EntryList{}, api.ErrGlossaryNotFound
+	}
+	if err != nil {
+		return EntryList{}, fmt.Errorf("failed to search glossary entries: %w", err)
+	}
+	return list, nil
+}
+
+// GetEntry returns a glossary entry.
+// Endpoint: GET /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}/entries/{entryUid}.
+func (h httpAPI) GetEntry(ctx context.Context, accountUID uid.AccountUID, glossaryUID, entryUID string) (Entry, error) {
+	if err := requireEntryParams(glossaryUID, entryUID); err != nil {
+		return Entry{}, err
+	}
+	var entry Entry
+	_, code, err := h.client.GetJSON(ctx, entryURL(accountUID, glossaryUID, entryUID), nil, &entry)
+	if err != nil && code == http.StatusNotFound {
+		return Entry{}, ErrEntryNotFound
+	}
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to get glossary entry: %w", err)
+	}
+	return entry, nil
+}
+
+// CreateEntry adds an entry to the glossary.
+// Endpoint: POST /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}/entries.
+func (h httpAPI) CreateEntry(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, req EntryRequest) (Entry, error) {
+	if glossaryUID == "" {
+		return Entry{}, smerror.ErrEmptyParam("glossaryUID")
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to marshal entry: %w", err)
+	}
+	var entry Entry
+	_, code, err := h.client.PostJSON(ctx, path.Join(glossaryURL(accountUID, glossaryUID), "entries"), payload, &entry)
+	if err != nil && code == http.StatusNotFound {
+		return Entry{}, api.ErrGlossaryNotFound
+	}
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to create glossary entry: %w", err)
+	}
+	return entry, nil
+}
+
+// UpdateEntry replaces the content of a glossary entry.
+// Endpoint: PUT /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}/entries/{entryUid}.
+func (h httpAPI) UpdateEntry(ctx context.Context, accountUID uid.AccountUID, glossaryUID, entryUID string, req EntryRequest) (Entry, error) {
+	if err := requireEntryParams(glossaryUID, entryUID); err != nil {
+		return Entry{}, err
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to marshal entry: %w", err)
+	}
+	var entry Entry
+	code, err := client.PutJSON(ctx, h.client, entryURL(accountUID, glossaryUID, entryUID), payload, &entry)
+	if err != nil && code == http.StatusNotFound {
+		return Entry{}, ErrEntryNotFound
+	}
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to update glossary entry: %w", err)
+	}
+	return entry, nil
+}
+
+// ArchiveEntries archives glossary entries; archived entries stay in the
+// glossary but are no longer used.
+// Endpoint: POST /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}/entries/archive.
+func (h httpAPI) ArchiveEntries(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, entryUIDs []string) error {
+	if glossaryUID == "" {
+		return smerror.ErrEmptyParam("glossaryUID")
+	}
+	payload, err := json.Marshal(map[string]any{
+		"filter": api.ExportGlossaryFilter{EntryUids: entryUIDs, Paging: api.ExportGlossaryPaging{Limit: len(entryUIDs)}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal entry archive request: %w", err)
+	}
+	_, code, err := h.client.PostJSON(ctx, path.Join(glossaryURL(accountUID, glossaryUID), "entries", "archive"), payload, nil)
+	if err != nil && code == http.StatusNotFound {
+		return api.ErrGlossaryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to archive glossary entries: %w", err)
+	}
+	return nil
+}
+
+// ExportEntries downloads the glossary like the SDK export does, with the
+// full entry filter. On success the response Data is the open HTTP response
+// body - the caller MUST Close it.
+// Endpoint: POST /glossary-api/v3/accounts/{accountUid}/glossaries/{glossaryUid}/entries/download.
+func (h httpAPI) ExportEntries(ctx context.Context, accountUID uid.AccountUID, glossaryUID string, req ExportRequest) (api.ExportGlossaryResponse, error) {
+	if glossaryUID == "" {
+		return api.ExportGlossaryResponse{}, smerror.ErrEmptyParam("glossaryUID")
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return api.ExportGlossaryResponse{}, fmt.Errorf("failed to marshal export request: %w", err)
+	}
+	reply, err := h.client.Post(ctx, path.Join(glossaryURL(accountUID, glossaryUID), "entries", "download"), payload)
+	if err != nil {
+		return api.ExportGlossaryResponse{}, fmt.Errorf("failed to export glossary: %w", err)
+	}
+	contentType := reply.Header.Get("Content-Type")
+	if reply.StatusCode != http.StatusOK || strings.HasPrefix(contentType, "application/json") {
+		body, _ := io.ReadAll(reply.Body)
+		_ = reply.Body.Close()
+		if reply.StatusCode == http.StatusNotFound {
+			return api.ExportGlossaryResponse{}, api.ErrGlossaryNotFound
+		}
+		return api.ExportGlossaryResponse{}, fmt.Errorf("failed to export glossary: unexpected response code %d: %s", reply.StatusCode, body)
+	}
+	var filename string
+	if _, params, err := mime.ParseMediaType(reply.Header.Get("Content-Disposition")); err == nil {
+		filename = params["filename"]
+	}
+	return api.ExportGlossaryResponse{
+		Code:          reply.StatusCode,
+		Filename:      filename,
+		ContentType:   contentType,
+		ContentLength: reply.ContentLength,
+		Data:          reply.Body,
+	}, nil
+}
+
+// ListLabels returns the glossary labels of the account.
+// Endpoint: GET /glossary-api/v3/accounts/{accountUid}/labels.
+func (h httpAPI) ListLabels(ctx context.Context, accountUID uid.AccountUID) ([]Label, error) {
+	var list struct {
+		Items []Label `json:"items"`
+	}
+	if _, _, err := h.client.GetJSON(ctx, labelsURL(accountUID), nil, &list); err != nil {
+		return nil, fmt.Errorf("failed to list glossary labels: %w", err)
+	}
+	return list.Items, nil
+}
+
+// CreateLabel creates a glossary label in the account.
+// Endpoint: POST /glossary-api/v3/accounts/{accountUid}/labels.
+func (h httpAPI) CreateLabel(ctx context.Context, accountUID uid.AccountUID, name string) (Label, error) {
+	if name == "" {
+		return Label{}, smerror.ErrEmptyParam("name")
+	}
+	payload, err := json.Marshal(map[string]string{"labelName": name})
+	if err != nil {
+		return Label{}, fmt.Errorf("failed to marshal label: %w", err)
+	}
+	var label Label
+	if _, _, err := h.client.PostJSON(ctx, labelsURL(accountUID), payload, &label); err != nil {
+		return Label{}, fmt.Errorf("failed to create glossary label: %w", err)
+	}
+	return label, nil
+}
+
+// DeleteLabel deletes a glossary label; entries which had it lose it.
+// Endpoint: DELETE /glossary-api/v3/accounts/{accountUid}/labels/{labelUid}.
+func (h httpAPI) DeleteLabel(ctx context.Context, accountUID uid.AccountUID, labelUID string) error {
+	if labelUID == "" {
+		return smerror.ErrEmptyParam("labelUID")
+	}
+	_, code, err := h.client.DeleteJSON(ctx, path.Join(labelsURL(accountUID), url.PathEscape(labelUID)), nil)
+	if err != nil && code == http.StatusNotFound {
+		return ErrLabelNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete glossary label: %w", err)
+	}
+	return nil
+}
+
+func entryURL(accountUID uid.AccountUID, glossaryUID, entryUID string) string {
+	return path.Join(glossaryURL(accountUID, glossaryUID), "entries", url.PathEscape(entryUID))
+}
+
+func requireEntryParams(glossaryUID, entryUID string) error {
+	switch {
+	case glossaryUID == "":
+		return smerror.ErrEmptyParam("glossaryUID")
+	case entryUID == "":
+		return smerror.ErrEmptyParam("entryUID")
+	}
+	return nil
+}
+
+func labelsURL(accountUID uid.AccountUID) string {
+	return path.Join(glossaryBasePath, url.PathEscape(string(accountUID)), "labels")
+}
+
+func glossaryURL(accountUID uid.AccountUID, glossaryUID string) string {
+	return path.Join(glossaryBasePath, url.PathEscape(string(accountUID)), "glossaries", url.PathEscape(glossaryUID))
+}
@@ -0,0 +1,118 @@
+package glossaryfile
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Violation kinds reported by CheckConsistency.
+const (
+	// ViolationTranslation means the source term is used, but the translation
+	// does not use the approved translation of it.
+	ViolationTranslation = "unapproved-translation"
+	// ViolationDNT means a do-not-translate term was translated.
+	ViolationDNT = "dnt-translated"
+)
+
+// CheckTerm is a source term with the text a translation must use for it.
+type CheckTerm struct {
+	Source   string `json:"source"`
+	Expected string `json:"expected"`
+	DNT      bool   `json:"dnt,omitempty"`
+}
+
+// CheckTerms lists the terms to check translations into targetLocaleID
+// against. A term is DNT when its source or its target term is marked so,
+// and must then stay as it is; other terms must use their approved target
+// term. Entries without a target term, and without DNT, are skipped.
+func CheckTerms(f File, sourceLocaleID, targetLocaleID string) []CheckTerm {
+	var terms []CheckTerm
+	for _, e := range f.Entries {
+		source, ok := e.Term(sourceLocaleID)
+		if !ok || strings.TrimSpace(source.Text) == "" {
+			continue
+		}
+		target, hasTarget := e.Term(targetLocaleID)
+		switch {
+		case source.DNT || target.DNT:
+			terms = append(terms, CheckTerm{Source: source.Text, Expected: source.Text, DNT: true})
+		case hasTarget && strings.TrimSpace(target.Text) != "":
+			terms = append(terms, CheckTerm{Source: source.Text, Expected: target.Text})
+		}
+	}
+	return terms
+}
+
+// Violation is a use of a glossary term which the translation does not
+// follow.
+type Violation struct {
+	// Line is the line of the source file using the term.
+	Line int `json:"line"`
+	// TranslationLine is the matching line of the translation, or 0 when the
+	// files have a different number of lines and are compared as a whole.
+	TranslationLine int    `json:"translationLine,omitempty"`
+	Kind            string `json:"kind"`
+	SourceTerm      string `json:"sourceTerm"`
+	Expected        string `json:"expected"`
+}
+
+// CheckConsistency finds where the source uses a term which the translation
+// does not translate as approved. Terms match case-insensitively as whole
+// words. When both texts have the same number of lines, as pulled
+// translations of line-oriented formats do, they are compared line by line;
+// otherwise a term is reported once, at its first use, when the translation
+// does not contain the expected text anywhere.
+func CheckConsistency(terms []CheckTerm, source, translation string) []Violation {
+	sourceLines := strings.Split(source, "\n")
+	translationLines := strings.Split(translation, "\n")
+	aligned := len(sourceLines) == len(translationLines)
+
+	var violations []Violation
+	for _, term := range terms {
+		sourcePattern := termPattern(term.Source)
+		expectedPattern := termPattern(term.Expected)
+		kind := ViolationTranslation
+		if term.DNT {
+			kind = ViolationDNT
+		}
+		for i, line := range sourceLines {
+			if !sourcePattern.MatchString(line) {
+				continue
+			}
+			v := Violation{Line: i + 1, Kind: kind, SourceTerm: term.Source, Expected: term.Expected}
+			if aligned {
+				if !expectedPattern.MatchString(translationLines[i]) {
+					v.TranslationLine = i + 1
+					violations = append(violations, v)
+				}
+				continue
+			}
+			if !expectedPattern.MatchString(translation) {
+				violations = append(violations, v)
+			}
+			break
+		}
+	}
+	sortViolations(violations)
+	return violations
+}
+
+// termPattern matches the term case-insensitively between word boundaries;
+// whitespace inside the term matches any run of whitespace.
+func termPattern(term string) *regexp.Regexp {
+	words := strings.Fields(term)
+	for i, word := range words {
+		words[i] = regexp.QuoteMeta(word)
+	}
+	return regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}_])` + strings.Join(words, `\s+`) + `(?:$|[^\p{L}\p{N}_])`)
+}
+
+func sortViolations(violations []Violation) {
+	slices.SortStableFunc(violations, func(a, b Violation) int {
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return strings.Compare(a.SourceTerm, b.SourceTerm)
+	})
+}
@@ -0,0 +1,51 @@
+package glossaryfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTerms(t *testing.T) {
+	f := File{Entries: []Entry{
+		{Terms: []Term{{LocaleID: "en-US", Text: "checkout"}, {LocaleID: "de-DE", Text: "Kasse"}}},
+		{Terms: []Term{{LocaleID: "en-US", Text: "Smartling", DNT: true}}},
+		{Terms: []Term{{LocaleID: "en-US", Text: "cart"}, {LocaleID: "de-DE", Text: "cart", DNT: true}}},
+		{Terms: []Term{{LocaleID: "en-US", Text: "wishlist"}, {LocaleID: "fr-FR", Text: "liste"}}},
+	}}
+	assert.Equal(t, []CheckTerm{
+		{Source: "checkout", Expected: "Kasse"},
+		{Source: "Smartling", Expected: "Smartling", DNT: true},
+		{Source: "cart", Expected: "cart", DNT: true},
+	}, CheckTerms(f, "en-US", "de-DE"))
+}
+
+func TestCheckConsistency(t *testing.T) {
+	terms := []CheckTerm{
+		{Source: "checkout", Expected: "Kasse"},
+		{Source: "Smartling", Expected: "Smartling", DNT: true},
+		{Source: "shopping  cart", Expected: "Warenkorb"},
+	}
+
+	source := "title = Checkout\n" +
+		"brand = Powered by Smartling\n" +
+		"hint = Go to checkout now\n" +
+		"items = Your shopping\tcart\n" +
+		"other = checkouts are not terms\n"
+	translation := "title = Zur Kasse\n" +
+		"brand = Unterstützt von Smartlings\n" +
+		"hint = Jetzt bezahlen\n" +
+		"items = Ihr warenkorb\n" +
+		"other = egal\n"
+	assert.Equal(t, []Violation{
+		{Line: 2, TranslationLine: 2, Kind: ViolationDNT, SourceTerm: "Smartling", Expected: "Smartling"},
+		{Line: 3, TranslationLine: 3, Kind: ViolationTranslation, SourceTerm: "checkout", Expected: "Kasse"},
+	}, CheckConsistency(terms, source, translation))
+
+	// Files with a different number of lines are compared as a whole.
+	assert.Equal(t, []Violation{
+		{Line: 2, Kind: ViolationDNT, SourceTerm: "Smartling", Expected: "Smartling"},
+	}, CheckConsistency(terms, source, "Zur Kasse, Ihr Warenkorb, von Smartlings"))
+
+	assert.Empty(t, CheckConsistency(terms, "nothing to see", "nichts"))
+}
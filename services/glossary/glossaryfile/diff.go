@@ -0,0 +1,197 @@
+package glossaryfile
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Kinds of Change.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// Fields a Change applies to. FieldTerm is the source term, so a term change
+// is a whole entry added or removed; FieldTranslation is a term in any other
+// locale.
+const (
+	FieldTerm        = "term"
+	FieldTranslation = "translation"
+	FieldDefinition  = "definition"
+	FieldDNT         = "dnt"
+	FieldLabels      = "labels"
+)
+
+// Change is a difference between two glossaries for one aligned entry.
+type Change struct {
+	SourceTerm string `json:"sourceTerm"`
+	LocaleID   string `json:"localeId,omitempty"`
+	Field      string `json:"field"`
+	Change     string `json:"change"`
+	Old        string `json:"old,omitempty"`
+	New        string `json:"new,omitempty"`
+}
+
+// DiffResult is the outcome of Diff.
+type DiffResult struct {
+	SourceLocaleID string
+	Changes        []Change
+	// Updates holds the entries of the to file which were added or changed,
+	// with the entry UIDs of the from file, so importing them into the
+	// glossary of the from file applies every change but removals.
+	Updates File
+	// Unaligned counts entries of either side without a source term.
+	Unaligned int
+}
+
+// Diff aligns the entries of two glossary files by their term in the source
+// locale and reports what changed between from and to. Entries sharing a
+// source term are aligned in file order. Definitions, labels and DNT flags
+// are compared only when both files carry them. An empty sourceLocaleID
+// selects the first locale of from which to has too.
+func Diff(from, to File, sourceLocaleID string) (DiffResult, error) {
+	if sourceLocaleID == "" {
+		for _, localeID := range from.Locales {
+			if slices.Contains(to.Locales, localeID) {
+				sourceLocaleID = localeID
+				break
+			}
+		}
+		if sourceLocaleID == "" {
+			return DiffResult{}, fmt.Errorf("glossaries have no locale in common to align entries by")
+		}
+	}
+	locales := slices.Clone(from.Locales)
+	for _, localeID := range to.Locales {
+		if !slices.Contains(locales, localeID) {
+			locales = append(locales, localeID)
+		}
+	}
+
+	res := DiffResult{
+		SourceLocaleID: sourceLocaleID,
+		Updates: File{
+			Format:         FormatCSV,
+			Locales:        to.Locales,
+			HasDefinitions: to.HasDefinitions,
+			HasLabels:      to.HasLabels,
+			HasNotes:       to.HasNotes,
+			HasDNT:         to.HasDNT,
+		},
+	}
+	oldKeys, unaligned := alignKeys(from, sourceLocaleID)
+	res.Unaligned += unaligned
+	newKeys, unaligned := alignKeys(to, sourceLocaleID)
+	res.Unaligned += unaligned
+	newIndex := make(map[string]int, len(newKeys))
+	for i, key := range newKeys {
+		if key != "" {
+			newIndex[key] = i
+		}
+	}
+	matched := make([]bool, len(to.Entries))
+
+	for i, oldEntry := range from.Entries {
+		key := oldKeys[i]
+		if key == "" {
+			continue
+		}
+		source, _ := oldEntry.Term(sourceLocaleID)
+		j, ok := newIndex[key]
+		if !ok {
+			res.Changes = append(res.Changes, Change{SourceTerm: source.Text, LocaleID: sourceLocaleID, Field: FieldTerm, Change: ChangeRemoved, Old: source.Text})
+			continue
+		}
+		matched[j] = true
+		changes := diffEntry(source.Text, oldEntry, to.Entries[j], locales, sourceLocaleID, compared{
+			definitions: from.HasDefinitions && to.HasDefinitions,
+			labels:      from.HasLabels && to.HasLabels,
+			dnt:         from.HasDNT && to.HasDNT,
+		})
+		if len(changes) > 0 {
+			res.Changes = append(res.Changes, changes...)
+			update := to.Entries[j]
+			update.EntryUID = oldEntry.EntryUID
+			res.Updates.Entries = append(res.Updates.Entries, update)
+		}
+	}
+	for j, newEntry := range to.Entries {
+		if matched[j] || newKeys[j] == "" {
+			continue
+		}
+		source, _ := newEntry.Term(sourceLocaleID)
+		res.Changes = append(res.Changes, Change{SourceTerm: source.Text, LocaleID: sourceLocaleID, Field: FieldTerm, Change: ChangeAdded, New: source.Text})
+		update := newEntry
+		update.EntryUID = ""
+		res.Updates.Entries = append(res.Updates.Entries, update)
+	}
+	return res, nil
+}
+
+// alignKeys returns the alignment key of every entry: the lowercased source
+// term followed by its occurrence number. Entries without a source term get
+// an empty key.
+func alignKeys(f File, sourceLocaleID string) ([]string, int) {
+	keys := make([]string, len(f.Entries))
+	seen := map[string]int{}
+	unaligned := 0
+	for i, e := range f.Entries {
+		source, ok := e.Term(sourceLocaleID)
+		if !ok {
+			unaligned++
+			continue
+		}
+		text := strings.ToLower(strings.TrimSpace(source.Text))
+		keys[i] = fmt.Sprintf("%s\x00%d", text, seen[text])
+		seen[text]++
+	}
+	return keys, unaligned
+}
+
+// compared lists the optional fields both sides of a diff carry.
+type compared struct {
+	definitions, labels, dnt bool
+}
+
+func diffEntry(sourceTerm string, from, to Entry, locales []string, sourceLocaleID string, fields compared) []Change {
+	var changes []Change
+	add := func(localeID, field, oldValue, newValue string) {
+		change := ChangeChanged
+		switch {
+		case oldValue == newValue:
+			return
+		case oldValue == "":
+			change = ChangeAdded
+		case newValue == "":
+			change = ChangeRemoved
+		}
+		changes = append(changes, Change{SourceTerm: sourceTerm, LocaleID: localeID, Field: field, Change: change, Old: oldValue, New: newValue})
+	}
+
+	if fields.definitions {
+		add("", FieldDefinition, from.Definition, to.Definition)
+	}
+	if fields.labels {
+		add("", FieldLabels, joinLabels(from.Labels), joinLabels(to.Labels))
+	}
+	for _, localeID := range locales {
+		oldTerm, _ := from.Term(localeID)
+		newTerm, _ := to.Term(localeID)
+		if localeID != sourceLocaleID {
+			add(localeID, FieldTranslation, oldTerm.Text, newTerm.Text)
+		}
+		if fields.dnt && oldTerm.DNT != newTerm.DNT && oldTerm.Text != "" && newTerm.Text != "" {
+			add(localeID, FieldDNT, fmt.Sprint(oldTerm.DNT), fmt.Sprint(newTerm.DNT))
+		}
+	}
+	return changes
+}
+
+// joinLabels renders labels independently of their order.
+func joinLabels(labels []string) string {
+	sorted := slices.Clone(labels)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ", ")
+}
@@ -0,0 +1,53 @@
+package glossaryfile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	from := parse(t, "Entry UID,Definition,Labels,Term (en-US),Term (de-DE),DNT (de-DE)\n"+
+		"u1,a greeting,\"ui, web\",hello,hallo,\n"+
+		"u2,a farewell,,bye,tschüss,\n"+
+		"u3,brand,,Smartling,Smartling,true\n"+
+		"u4,,,,nur deutsch,\n", FormatCSV)
+	to := parse(t, "Definition,Labels,Term (en-US),Term (de-DE),DNT (de-DE),Term (fr-FR)\n"+
+		"a greeting,\"web, ui\",Hello,servus,,bonjour\n"+
+		"brand name,,smartling,Smartling,,\n"+
+		"thanks,,thank you,danke,,merci\n", FormatCSV)
+
+	res, err := Diff(from, to, "")
+	require.NoError(t, err)
+	assert.Equal(t, "en-US", res.SourceLocaleID)
+	assert.Equal(t, 1, res.Unaligned)
+	assert.Equal(t, []Change{
+		{SourceTerm: "hello", LocaleID: "de-DE", Field: FieldTranslation, Change: ChangeChanged, Old: "hallo", New: "servus"},
+		{SourceTerm: "hello", LocaleID: "fr-FR", Field: FieldTranslation, Change: ChangeAdded, New: "bonjour"},
+		{SourceTerm: "bye", LocaleID: "en-US", Field: FieldTerm, Change: ChangeRemoved, Old: "bye"},
+		{SourceTerm: "Smartling", Field: FieldDefinition, Change: ChangeChanged, Old: "brand", New: "brand name"},
+		{SourceTerm: "Smartling", LocaleID: "de-DE", Field: FieldDNT, Change: ChangeChanged, Old: "true", New: "false"},
+		{SourceTerm: "thank you", LocaleID: "en-US", Field: FieldTerm, Change: ChangeAdded, New: "thank you"},
+	}, res.Changes)
+
+	require.Len(t, res.Updates.Entries, 3)
+	assert.Equal(t, []string{"u1", "u3", ""}, []string{
+		res.Updates.Entries[0].EntryUID, res.Updates.Entries[1].EntryUID, res.Updates.Entries[2].EntryUID,
+	})
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, res.Updates))
+	assert.Equal(t, "Entry UID,Definition,Labels,Term (en-US),DNT (en-US),Term (de-DE),DNT (de-DE),Term (fr-FR),DNT (fr-FR)\n"+
+		"u1,a greeting,\"web, ui\",Hello,,servus,,bonjour,\n"+
+		"u3,brand name,,smartling,,Smartling,,,\n"+
+		",thanks,,thank you,,danke,,merci,\n", buf.String())
+
+	reparsed := parse(t, buf.String(), FormatCSV)
+	res, err = Diff(to, reparsed, "en-US")
+	require.NoError(t, err)
+	assert.Empty(t, res.Changes)
+
+	_, err = Diff(from, parse(t, "Term (ja-JP)\nこんにちは\n", FormatCSV), "")
+	assert.Error(t, err)
+}
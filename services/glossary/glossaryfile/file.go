@@ -0,0 +1,164 @@
+// Package glossaryfile reads Smartling glossary files (CSV, XLSX and TBX)
+// into a common model and checks them before they are imported.
+package glossaryfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Format is the file format of a glossary file.
+type Format string
+
+// Supported glossary file formats.
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+	FormatTBX  Format = "tbx"
+)
+
+// Media types of the supported formats, as accepted by the Glossary Import API.
+const (
+	MediaTypeCSV  = "text/csv"
+	MediaTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	MediaTypeTBX  = "text/xml"
+)
+
+// TBX structure versions.
+const (
+	TBXVersion2 = "v2"
+	TBXVersion3 = "v3"
+)
+
+// FormatFromPath maps a file extension to a Format; "" means unknown.
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV
+	case ".xlsx":
+		return FormatXLSX
+	case ".tbx", ".xml":
+		return FormatTBX
+	default:
+		return ""
+	}
+}
+
+// FormatFromMediaType maps an import media type to a Format; "" means unknown.
+func FormatFromMediaType(mediaType string) Format {
+	switch mediaType {
+	case MediaTypeCSV:
+		return FormatCSV
+	case MediaTypeXLSX:
+		return FormatXLSX
+	case MediaTypeTBX:
+		return FormatTBX
+	default:
+		return ""
+	}
+}
+
+// Term is the term of an entry in one locale.
+type Term struct {
+	LocaleID string `json:"localeId"`
+	Text     string `json:"text"`
+	Notes    string `json:"notes,omitempty"`
+	DNT      bool   `json:"dnt,omitempty"`
+}
+
+// Entry is a glossary entry: one concept with its terms in every locale.
+type Entry struct {
+	// Line is the line (CSV, TBX) or row (XLSX) the entry starts on.
+	Line         int      `json:"line"`
+	EntryUID     string   `json:"entryUid,omitempty"`
+	Definition   string   `json:"definition,omitempty"`
+	PartOfSpeech string   `json:"partOfSpeech,omitempty"`
+	Labels       []string `json:"labels,omitempty"`
+	Terms        []Term   `json:"terms"`
+}
+
+// Term returns the entry's term in the locale.
+func (e Entry) Term(localeID string) (Term, bool) {
+	for _, t := range e.Terms {
+		if t.LocaleID == localeID {
+			return t, true
+		}
+	}
+	return Term{}, false
+}
+
+// File is a parsed glossary file.
+type File struct {
+	Format Format
+	// TBXVersion is the structure declared by the root element of a TBX file.
+	TBXVersion string
+	// Locales lists the locales of the file in column or document order.
+	Locales []string
+	// HasDefinitions is false for tables without a Definition column.
+	HasDefinitions bool
+	// HasLabels is true for tables with a Labels column.
+	HasLabels bool
+	// HasNotes and HasDNT are false for tables without Notes or DNT columns.
+	HasNotes bool
+	HasDNT   bool
+	Entries  []Entry
+	// Issues are the problems found while parsing.
+	Issues []Issue
+
+	// localeLines is the line each locale is first declared on.
+	localeLines map[string]int
+}
+
+// Location describes a line of the file in the terms of its format.
+func (f File) Location(line int) string {
+	if f.Format == FormatXLSX {
+		return fmt.Sprintf("row %d", line)
+	}
+	return fmt.Sprintf("line %d", line)
+}
+
+func (f *File) addLocale(localeID string, line int) {
+	if f.localeLines == nil {
+		f.localeLines = map[string]int{}
+	}
+	if _, ok := f.localeLines[localeID]; ok {
+		return
+	}
+	f.localeLines[localeID] = line
+	f.Locales = append(f.Locales, localeID)
+}
+
+func (f *File) errorf(line int, format string, args ...any) {
+	f.Issues = append(f.Issues, Issue{Line: line, Severity: SeverityError, Message: fmt.Sprintf(format, args...)})
+}
+
+func (f *File) warnf(line int, format string, args ...any) {
+	f.Issues = append(f.Issues, Issue{Line: line, Severity: SeverityWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+// Read reads and parses the glossary file at path. Problems with the content
+// are reported as File.Issues; the error is reserved for unreadable files and
+// unknown formats.
+func Read(path string, format Format) (File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return File{}, err
+	}
+	return Parse(data, format)
+}
+
+// Parse parses glossary file content in the given format.
+func Parse(data []byte, format Format) (File, error) {
+	switch format {
+	case FormatCSV:
+		return parseCSV(data), nil
+	case FormatXLSX:
+		return parseXLSX(data), nil
+	case FormatTBX:
+		return parseTBX(data), nil
+	default:
+		return File{}, fmt.Errorf("unsupported glossary file format %q", format)
+	}
+}
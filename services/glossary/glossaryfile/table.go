@@ -0,0 +1,240 @@
+package glossaryfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+
+	// localeColumnPattern matches locale-specific headers such as "Term (de-DE)".
+	localeColumnPattern = regexp.MustCompile(`^(.+?)\s*\(\s*([^()\s]+)\s*\)$`)
+)
+
+// Column kinds of a glossary table.
+const (
+	columnIgnored = iota
+	columnEntryUID
+	columnDefinition
+	columnPartOfSpeech
+	columnLabels
+	columnTerm
+	columnNotes
+	columnDNT
+)
+
+type column struct {
+	kind     int
+	localeID string
+}
+
+// Table headers, normalized by normalizeHeader, mapped to column kinds.
+var (
+	entryColumns = map[string]int{
+		"entryuid":     columnEntryUID,
+		"uid":          columnEntryUID,
+		"definition":   columnDefinition,
+		"partofspeech": columnPartOfSpeech,
+		"labels":       columnLabels,
+	}
+	localeColumns = map[string]int{
+		"term":           columnTerm,
+		"notes":          columnNotes,
+		"note":           columnNotes,
+		"dnt":            columnDNT,
+		"donottranslate": columnDNT,
+		"variations":     columnIgnored,
+		"variants":       columnIgnored,
+	}
+)
+
+// tableRow is a row of a CSV or XLSX table with its line or row number.
+type tableRow struct {
+	line  int
+	cells []string
+}
+
+func parseCSV(data []byte) File {
+	f := File{Format: FormatCSV}
+	if bytes.HasPrefix(data, utf16LEBOM) || bytes.HasPrefix(data, utf16BEBOM) {
+		f.errorf(1, "file is UTF-16 encoded; save it as UTF-8")
+		return f
+	}
+	data = bytes.TrimPrefix(data, utf8BOM)
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		if !utf8.Valid(line) {
+			f.errorf(i+1, "line is not valid UTF-8")
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	var rows []tableRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				f.errorf(parseErr.Line, "malformed CSV: %s", parseErr.Err)
+			} else {
+				f.errorf(0, "malformed CSV: %s", err)
+			}
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, tableRow{line: line, cells: record})
+	}
+	fromTable(&f, rows)
+	return f
+}
+
+// fromTable fills the file from table rows, the first of which is the header.
+func fromTable(f *File, rows []tableRow) {
+	if len(rows) == 0 {
+		f.errorf(1, "file has no header row")
+		return
+	}
+	header := rows[0]
+	columns := make([]column, len(header.cells))
+	seen := map[string]bool{}
+	hasTerms := false
+	for i, cell := range header.cells {
+		name := strings.TrimSpace(cell)
+		if name == "" {
+			continue
+		}
+		key := strings.ToLower(name)
+		if seen[key] {
+			f.errorf(header.line, "duplicate column %q", name)
+			continue
+		}
+		seen[key] = true
+
+		if m := localeColumnPattern.FindStringSubmatch(name); m != nil {
+			kind, ok := localeColumns[normalizeHeader(m[1])]
+			if !ok {
+				f.warnf(header.line, "unknown column %q is ignored", name)
+				continue
+			}
+			columns[i] = column{kind: kind, localeID: m[2]}
+			f.addLocale(m[2], header.line)
+			hasTerms = hasTerms || kind == columnTerm
+			f.HasNotes = f.HasNotes || kind == columnNotes
+			f.HasDNT = f.HasDNT || kind == columnDNT
+			continue
+		}
+		kind, ok := entryColumns[normalizeHeader(name)]
+		if !ok {
+			f.warnf(header.line, "unknown column %q is ignored", name)
+			continue
+		}
+		columns[i] = column{kind: kind}
+		f.HasDefinitions = f.HasDefinitions || kind == columnDefinition
+		f.HasLabels = f.HasLabels || kind == columnLabels
+	}
+	if !hasTerms {
+		f.errorf(header.line, `header has no "Term (<localeId>)" columns`)
+		return
+	}
+
+	for _, row := range rows[1:] {
+		if isBlank(row.cells) {
+			continue
+		}
+		if len(row.cells) != len(header.cells) {
+			f.errorf(row.line, "row has %d fields, header has %d", len(row.cells), len(header.cells))
+			continue
+		}
+		f.Entries = append(f.Entries, entryFromRow(row, columns))
+	}
+}
+
+func entryFromRow(row tableRow, columns []column) Entry {
+	entry := Entry{Line: row.line}
+	terms := map[string]*Term{}
+	term := func(localeID string) *Term {
+		if t, ok := terms[localeID]; ok {
+			return t
+		}
+		terms[localeID] = &Term{LocaleID: localeID}
+		return terms[localeID]
+	}
+	var order []string
+	for i, cell := range row.cells {
+		value := strings.TrimSpace(cell)
+		c := columns[i]
+		switch c.kind {
+		case columnEntryUID:
+			entry.EntryUID = value
+		case columnDefinition:
+			entry.Definition = value
+		case columnPartOfSpeech:
+			entry.PartOfSpeech = value
+		case columnLabels:
+			entry.Labels = parseLabels(value)
+		case columnTerm:
+			term(c.localeID).Text = value
+			order = append(order, c.localeID)
+		case columnNotes:
+			term(c.localeID).Notes = value
+		case columnDNT:
+			term(c.localeID).DNT = parseFlag(value)
+		}
+	}
+	for _, localeID := range order {
+		if t := terms[localeID]; t.Text != "" {
+			entry.Terms = append(entry.Terms, *t)
+		}
+	}
+	return entry
+}
+
+// normalizeHeader lowercases a header and drops spaces, dashes and underscores.
+func normalizeHeader(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+// parseLabels splits a comma-separated Labels cell.
+func parseLabels(value string) []string {
+	var labels []string
+	for label := range strings.SplitSeq(value, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+func parseFlag(value string) bool {
+	switch strings.ToLower(value) {
+	case "true", "yes", "y", "x", "1":
+		return true
+	}
+	return false
+}
+
+func isBlank(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
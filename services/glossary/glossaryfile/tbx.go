@@ -0,0 +1,235 @@
+package glossaryfile
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// tbxNamespace is the namespace of TBX v3 (ISO 30042:2019) documents.
+const tbxNamespace = "urn:iso:std:iso:30042:ed-2"
+
+// tbxElements names the entry, language and term containers of a TBX structure.
+type tbxElements struct {
+	root, entry, lang, term string
+}
+
+var (
+	tbxV2 = tbxElements{root: "martif", entry: "termEntry", lang: "langSet", term: "tig"}
+	tbxV3 = tbxElements{root: "tbx", entry: "conceptEntry", lang: "langSec", term: "termSec"}
+)
+
+// tbxParser walks TBX tokens and collects entries; v2 and v3 differ only in
+// element names, so both are read by one parser which flags mixed structures.
+type tbxParser struct {
+	f        *File
+	dec      *xml.Decoder
+	declared tbxElements
+	other    tbxElements
+	flagged  map[string]bool
+
+	entry    *Entry
+	term     *Term
+	localeID string
+
+	depth        int
+	captureDepth int
+	text         *strings.Builder
+	assign       func(string)
+}
+
+func parseTBX(data []byte) File {
+	f := File{Format: FormatTBX, HasDefinitions: true, HasNotes: true, HasDNT: true}
+	if bytes.HasPrefix(data, utf16LEBOM) || bytes.HasPrefix(data, utf16BEBOM) {
+		f.errorf(1, "file is UTF-16 encoded; save it as UTF-8")
+		return f
+	}
+	p := tbxParser{f: &f, dec: xml.NewDecoder(bytes.NewReader(data)), flagged: map[string]bool{}}
+	if err := p.parse(); err != nil {
+		var syntaxErr *xml.SyntaxError
+		line, _ := p.dec.InputPos()
+		if errors.As(err, &syntaxErr) {
+			line = syntaxErr.Line
+		}
+		f.errorf(line, "malformed TBX: %s", err)
+	}
+	return f
+}
+
+func (p *tbxParser) parse() error {
+	rootSeen := false
+	for {
+		token, err := p.dec.Token()
+		if errors.Is(err, io.EOF) {
+			if !rootSeen {
+				p.f.errorf(1, "file has no root element")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := p.dec.InputPos()
+		switch t := token.(type) {
+		case xml.StartElement:
+			p.depth++
+			if !rootSeen {
+				rootSeen = true
+				if !p.root(t, line) {
+					return nil
+				}
+				continue
+			}
+			p.start(t, line)
+		case xml.EndElement:
+			p.end(t)
+			p.depth--
+		case xml.CharData:
+			if p.text != nil {
+				p.text.Write(t)
+			}
+		}
+	}
+}
+
+func (p *tbxParser) root(t xml.StartElement, line int) bool {
+	switch t.Name.Local {
+	case tbxV2.root:
+		p.f.TBXVersion, p.declared, p.other = TBXVersion2, tbxV2, tbxV3
+	case tbxV3.root:
+		p.f.TBXVersion, p.declared, p.other = TBXVersion3, tbxV3, tbxV2
+		if t.Name.Space != tbxNamespace {
+			p.f.warnf(line, "TBX v3 root <tbx> should be in namespace %q", tbxNamespace)
+		}
+	default:
+		p.f.errorf(line, "root element <%s> is neither TBX v2 <martif> nor TBX v3 <tbx>", t.Name.Local)
+		return false
+	}
+	if localeID := langAttr(t); localeID != "" {
+		p.f.addLocale(localeID, line)
+	}
+	return true
+}
+
+// structure maps an element name to the declared structure's name, flagging
+// elements of the other TBX version.
+func (p *tbxParser) structure(name string, line int) string {
+	for _, pair := range [][2]string{
+		{p.other.entry, p.declared.entry},
+		{p.other.lang, p.declared.lang},
+		{p.other.term, p.declared.term},
+	} {
+		if name != pair[0] {
+			continue
+		}
+		if !p.flagged[name] {
+			p.flagged[name] = true
+			p.f.errorf(line, "<%s> belongs to TBX %s, but the root <%s> declares TBX %s",
+				name, otherVersion(p.f.TBXVersion), p.declared.root, p.f.TBXVersion)
+		}
+		return pair[1]
+	}
+	if name == "ntig" {
+		return p.declared.term
+	}
+	return name
+}
+
+func (p *tbxParser) start(t xml.StartElement, line int) {
+	switch name := p.structure(t.Name.Local, line); {
+	case name == p.declared.entry:
+		p.entry = &Entry{Line: line, EntryUID: attr(t, "id")}
+	case p.entry == nil:
+	case name == p.declared.lang:
+		p.localeID = langAttr(t)
+		if p.localeID == "" {
+			p.f.errorf(line, "<%s> has no xml:lang attribute", t.Name.Local)
+			return
+		}
+		p.f.addLocale(p.localeID, line)
+	case name == p.declared.term:
+		if p.localeID != "" {
+			p.term = &Term{LocaleID: p.localeID}
+		}
+	case name == "descrip" && attr(t, "type") == "definition":
+		p.capture(func(s string) {
+			if p.entry.Definition == "" {
+				p.entry.Definition = s
+			}
+		})
+	case name == "term" && p.term != nil:
+		p.capture(func(s string) { p.term.Text = s })
+	case name == "note" && p.term != nil:
+		p.capture(func(s string) { p.term.Notes = s })
+	case name == "termNote":
+		switch attr(t, "type") {
+		case "partOfSpeech":
+			p.capture(func(s string) {
+				if p.entry.PartOfSpeech == "" {
+					p.entry.PartOfSpeech = s
+				}
+			})
+		case "x-doNotTranslate", "doNotTranslate":
+			if p.term != nil {
+				p.capture(func(s string) { p.term.DNT = parseFlag(s) })
+			}
+		}
+	}
+}
+
+func (p *tbxParser) end(t xml.EndElement) {
+	if p.assign != nil && p.depth == p.captureDepth {
+		p.assign(strings.TrimSpace(p.text.String()))
+		p.text, p.assign = nil, nil
+	}
+	line, _ := p.dec.InputPos()
+	switch p.structure(t.Name.Local, line) {
+	case p.declared.entry:
+		if p.entry != nil {
+			p.f.Entries = append(p.f.Entries, *p.entry)
+		}
+		p.entry = nil
+	case p.declared.lang:
+		p.localeID = ""
+	case p.declared.term:
+		if p.term != nil && p.entry != nil && p.term.Text != "" {
+			p.entry.Terms = append(p.entry.Terms, *p.term)
+		}
+		p.term = nil
+	}
+}
+
+// capture collects the text of the current element and hands it to assign
+// when the element ends.
+func (p *tbxParser) capture(assign func(string)) {
+	p.text = &strings.Builder{}
+	p.assign = assign
+	p.captureDepth = p.depth
+}
+
+func otherVersion(version string) string {
+	if version == TBXVersion2 {
+		return TBXVersion3
+	}
+	return TBXVersion2
+}
+
+func attr(t xml.StartElement, local string) string {
+	for _, a := range t.Attr {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func langAttr(t xml.StartElement) string {
+	for _, a := range t.Attr {
+		if a.Name.Local == "lang" && (a.Name.Space == "xml" || a.Name.Space == "http://www.w3.org/XML/1998/namespace") {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,89 @@
+package glossaryfile
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Issue severities.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Issue is a problem found in a glossary file. Errors make the Glossary
+// Import API reject the file or import it wrongly; warnings do not.
+type Issue struct {
+	Line     int    `json:"line"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// Validate returns the parse issues of the file together with the problems of
+// its content: locales which are not glossary locales, duplicate terms and
+// entry UIDs, entries without terms, and entries without a definition.
+// Locales are not checked when glossaryLocales is nil. Issues are ordered by
+// line.
+func Validate(f File, glossaryLocales []string) []Issue {
+	v := File{Format: f.Format, Issues: slices.Clone(f.Issues)}
+
+	if glossaryLocales != nil {
+		for _, localeID := range f.Locales {
+			if slices.Contains(glossaryLocales, localeID) {
+				continue
+			}
+			hint := ""
+			for _, known := range glossaryLocales {
+				if strings.EqualFold(known, localeID) {
+					hint = fmt.Sprintf(" (did you mean %s?)", known)
+				}
+			}
+			v.errorf(f.localeLines[localeID], "locale %s is not a locale of the glossary%s", localeID, hint)
+		}
+	}
+
+	if !f.HasDefinitions && len(f.Entries) > 0 {
+		v.warnf(1, "header has no Definition column; entries are imported without definitions")
+	}
+	entryUIDs := map[string]int{}
+	terms := map[string]int{}
+	for _, e := range f.Entries {
+		if e.EntryUID != "" {
+			if first, ok := entryUIDs[e.EntryUID]; ok {
+				v.errorf(e.Line, "duplicate entry UID %s (first at %s)", e.EntryUID, f.Location(first))
+			} else {
+				entryUIDs[e.EntryUID] = e.Line
+			}
+		}
+		if len(e.Terms) == 0 {
+			v.errorf(e.Line, "entry has no terms")
+			continue
+		}
+		if f.HasDefinitions && e.Definition == "" {
+			v.warnf(e.Line, "entry has an empty definition")
+		}
+		for _, t := range e.Terms {
+			key := t.LocaleID + "\x00" + strings.ToLower(strings.TrimSpace(t.Text))
+			if first, ok := terms[key]; ok {
+				v.errorf(e.Line, "duplicate %s term %q (first at %s)", t.LocaleID, t.Text, f.Location(first))
+				continue
+			}
+			terms[key] = e.Line
+		}
+	}
+
+	slices.SortStableFunc(v.Issues, func(a, b Issue) int { return a.Line - b.Line })
+	return v.Issues
+}
+
+// Errors counts the issues of error severity.
+func Errors(issues []Issue) int {
+	n := 0
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,166 @@
+package glossaryfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parse(t *testing.T, content string, format Format) File {
+	t.Helper()
+	f, err := Parse([]byte(content), format)
+	require.NoError(t, err)
+	return f
+}
+
+func TestValidate_CSV(t *testing.T) {
+	f := parse(t, "Entry UID,Definition,Term (en-US),Notes (en-US),Term (de-de),DNT (de-de),Color\n"+
+		"e1,a greeting,hello,informal,hallo,no,red\n"+
+		"e2,,Hello,,servus,yes,\n"+
+		"\n"+
+		"e1,farewell,bye,,\"tschüss\nbis bald\",,\n"+
+		",,,,,,x\n"+
+		"e4,short\n", FormatCSV)
+
+	assert.Equal(t, []string{"en-US", "de-de"}, f.Locales)
+	require.Len(t, f.Entries, 4)
+	assert.Equal(t, Entry{
+		Line: 2, EntryUID: "e1", Definition: "a greeting",
+		Terms: []Term{{LocaleID: "en-US", Text: "hello", Notes: "informal"}, {LocaleID: "de-de", Text: "hallo"}},
+	}, f.Entries[0])
+	assert.True(t, f.Entries[1].Terms[1].DNT)
+	assert.Equal(t, 5, f.Entries[2].Line)
+
+	assert.Equal(t, []Issue{
+		{Line: 1, Severity: SeverityWarning, Message: `unknown column "Color" is ignored`},
+		{Line: 1, Severity: SeverityError, Message: "locale de-de is not a locale of the glossary (did you mean de-DE?)"},
+		{Line: 3, Severity: SeverityWarning, Message: "entry has an empty definition"},
+		{Line: 3, Severity: SeverityError, Message: `duplicate en-US term "Hello" (first at line 2)`},
+		{Line: 5, Severity: SeverityError, Message: "duplicate entry UID e1 (first at line 2)"},
+		{Line: 7, Severity: SeverityError, Message: "entry has no terms"},
+		{Line: 8, Severity: SeverityError, Message: "row has 2 fields, header has 7"},
+	}, Validate(f, []string{"en-US", "de-DE"}))
+}
+
+func TestValidate_CSVEncoding(t *testing.T) {
+	f := parse(t, "\xEF\xBB\xBFTerm (en-US)\nhello\nbad \xff byte\n", FormatCSV)
+	assert.Equal(t, []string{"en-US"}, f.Locales)
+	assert.Equal(t, []Issue{
+		{Line: 1, Severity: SeverityWarning, Message: "header has no Definition column; entries are imported without definitions"},
+		{Line: 3, Severity: SeverityError, Message: "line is not valid UTF-8"},
+	}, Validate(f, nil))
+
+	f = parse(t, "\xFF\xFET\x00e\x00", FormatCSV)
+	assert.Equal(t, []Issue{{Line: 1, Severity: SeverityError, Message: "file is UTF-16 encoded; save it as UTF-8"}}, Validate(f, nil))
+
+	f = parse(t, "Definition\nterm\n", FormatCSV)
+	assert.Equal(t, []Issue{{Line: 1, Severity: SeverityError, Message: `header has no "Term (<localeId>)" columns`}}, Validate(f, nil))
+}
+
+func TestValidate_XLSX(t *testing.T) {
+	f := parse(t, string(testXLSX(t)), FormatXLSX)
+	assert.Equal(t, []string{"en-US", "fr-FR"}, f.Locales)
+	require.Len(t, f.Entries, 2)
+	assert.Equal(t, Entry{
+		Line: 2, Definition: "a greeting",
+		Terms: []Term{{LocaleID: "en-US", Text: "hello"}, {LocaleID: "fr-FR", Text: "bonjour"}},
+	}, f.Entries[0])
+	assert.Equal(t, []Issue{
+		{Line: 4, Severity: SeverityError, Message: `duplicate en-US term "hello" (first at row 2)`},
+	}, Validate(f, []string{"en-US", "fr-FR"}))
+	assert.Equal(t, "row 4", f.Location(4))
+
+	f = parse(t, "not a zip", FormatXLSX)
+	require.Len(t, f.Issues, 1)
+	assert.Contains(t, f.Issues[0].Message, "not a valid XLSX file")
+}
+
+func TestValidate_TBX(t *testing.T) {
+	v2 := `<?xml version="1.0" encoding="UTF-8"?>
+<martif type="TBX-Basic" xml:lang="en-US">
+  <text><body>
+    <termEntry id="e1">
+      <descrip type="definition">a greeting</descrip>
+      <langSet xml:lang="en-US"><tig><term>hello</term><termNote type="partOfSpeech">noun</termNote></tig></langSet>
+      <langSet xml:lang="de-DE"><tig><term>hallo</term><note>informal</note></tig></langSet>
+    </termEntry>
+    <termEntry id="e2">
+      <langSet xml:lang="en-US"><tig><term>hello</term></tig></langSet>
+    </termEntry>
+  </body></text>
+</martif>`
+	f := parse(t, v2, FormatTBX)
+	assert.Equal(t, TBXVersion2, f.TBXVersion)
+	assert.Equal(t, []string{"en-US", "de-DE"}, f.Locales)
+	require.Len(t, f.Entries, 2)
+	assert.Equal(t, Entry{
+		Line: 4, EntryUID: "e1", Definition: "a greeting", PartOfSpeech: "noun",
+		Terms: []Term{{LocaleID: "en-US", Text: "hello"}, {LocaleID: "de-DE", Text: "hallo", Notes: "informal"}},
+	}, f.Entries[0])
+	assert.Equal(t, []Issue{
+		{Line: 9, Severity: SeverityWarning, Message: "entry has an empty definition"},
+		{Line: 9, Severity: SeverityError, Message: `duplicate en-US term "hello" (first at line 4)`},
+	}, Validate(f, []string{"en-US", "de-DE"}))
+
+	mixed := `<tbx xmlns="urn:iso:std:iso:30042:ed-2" xml:lang="en-US">
+<text><body>
+<conceptEntry id="c1"><descrip type="definition">d</descrip>
+  <langSec xml:lang="en-US"><termSec><term>one</term></termSec></langSec>
+</conceptEntry>
+<termEntry id="c2"><descrip type="definition">d</descrip>
+  <langSet><tig><term>two</term></tig></langSet>
+</termEntry>
+</body></text>
+</tbx>`
+	f = parse(t, mixed, FormatTBX)
+	assert.Equal(t, TBXVersion3, f.TBXVersion)
+	assert.Len(t, f.Entries, 2)
+	assert.Equal(t, []Issue{
+		{Line: 6, Severity: SeverityError, Message: "<termEntry> belongs to TBX v2, but the root <tbx> declares TBX v3"},
+		{Line: 6, Severity: SeverityError, Message: "entry has no terms"},
+		{Line: 7, Severity: SeverityError, Message: "<langSet> belongs to TBX v2, but the root <tbx> declares TBX v3"},
+		{Line: 7, Severity: SeverityError, Message: "<langSet> has no xml:lang attribute"},
+		{Line: 7, Severity: SeverityError, Message: "<tig> belongs to TBX v2, but the root <tbx> declares TBX v3"},
+	}, Validate(f, nil))
+
+	f = parse(t, `<glossary><entry/></glossary>`, FormatTBX)
+	assert.Equal(t, []Issue{
+		{Line: 1, Severity: SeverityError, Message: "root element <glossary> is neither TBX v2 <martif> nor TBX v3 <tbx>"},
+	}, Validate(f, nil))
+
+	f = parse(t, "<martif>\n<text>\n</martif>", FormatTBX)
+	require.Len(t, f.Issues, 1)
+	assert.Equal(t, 3, f.Issues[0].Line)
+}
+
+// testXLSX builds a minimal workbook with shared and inline strings, an
+// omitted trailing cell and a skipped row number.
+func testXLSX(t *testing.T) []byte {
+	t.Helper()
+	parts := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Glossary" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>Definition</t></si><si><t>Term (en-US)</t></si><si><r><t>Term </t></r><r><t>(fr-FR)</t></r></si><si><t>hello</t></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>2</v></c></row>
+<row r="2"><c r="A2" t="inlineStr"><is><t>a greeting</t></is></c><c r="B2" t="s"><v>3</v></c><c r="C2" t="inlineStr"><is><t>bonjour</t></is></c></row>
+<row r="4"><c r="A4" t="inlineStr"><is><t>again</t></is></c><c r="B4" t="s"><v>3</v></c></row>
+</sheetData></worksheet>`,
+	}
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range parts {
+		part, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
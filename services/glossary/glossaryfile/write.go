@@ -0,0 +1,225 @@
+package glossaryfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Records lays the file out as a glossary table in the column order the
+// Glossary Import API accepts: entry columns first, then Term, Notes and DNT
+// for every locale. Columns the file does not carry are left out, so that an
+// import does not clear them. The first record is the header.
+func Records(f File) [][]string {
+	hasUIDs, hasPartOfSpeech := false, false
+	for _, e := range f.Entries {
+		hasUIDs = hasUIDs || e.EntryUID != ""
+		hasPartOfSpeech = hasPartOfSpeech || e.PartOfSpeech != ""
+	}
+
+	var header []string
+	if hasUIDs {
+		header = append(header, "Entry UID")
+	}
+	if f.HasDefinitions {
+		header = append(header, "Definition")
+	}
+	if hasPartOfSpeech {
+		header = append(header, "Part of Speech")
+	}
+	if f.HasLabels {
+		header = append(header, "Labels")
+	}
+	for _, localeID := range f.Locales {
+		header = append(header, "Term ("+localeID+")")
+		if f.HasNotes {
+			header = append(header, "Notes ("+localeID+")")
+		}
+		if f.HasDNT {
+			header = append(header, "DNT ("+localeID+")")
+		}
+	}
+
+	records := [][]string{header}
+	for _, e := range f.Entries {
+		record := make([]string, 0, len(header))
+		if hasUIDs {
+			record = append(record, e.EntryUID)
+		}
+		if f.HasDefinitions {
+			record = append(record, e.Definition)
+		}
+		if hasPartOfSpeech {
+			record = append(record, e.PartOfSpeech)
+		}
+		if f.HasLabels {
+			record = append(record, strings.Join(e.Labels, ", "))
+		}
+		for _, localeID := range f.Locales {
+			t, _ := e.Term(localeID)
+			record = append(record, t.Text)
+			if f.HasNotes {
+				record = append(record, t.Notes)
+			}
+			if f.HasDNT {
+				record = append(record, formatFlag(t.DNT))
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// WriteCSV writes the file as a CSV glossary table.
+func WriteCSV(w io.Writer, f File) error {
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(Records(f)); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// AddLabels adds labels to the Labels cell of every entry row of a CSV or
+// XLSX glossary table, appending a Labels column when the table has none.
+// Unlike WriteCSV and WriteXLSX it keeps every other column as it is,
+// including the ones the import ignores. Of an XLSX workbook only the cells
+// of the first sheet are kept.
+func AddLabels(data []byte, format Format, labels []string) ([]byte, error) {
+	var records [][]string
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+		reader.FieldsPerRecord = -1
+		var err error
+		if records, err = reader.ReadAll(); err != nil {
+			return nil, fmt.Errorf("malformed CSV: %w", err)
+		}
+	case FormatXLSX:
+		rows, err := readXLSXRows(data)
+		if err != nil {
+			return nil, fmt.Errorf("not a valid XLSX file: %w", err)
+		}
+		for _, row := range rows {
+			records = append(records, row.cells)
+		}
+	default:
+		return nil, fmt.Errorf("labels can only be added to CSV and XLSX files, not %s", format)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("file has no header row")
+	}
+
+	col := slices.IndexFunc(records[0], func(name string) bool {
+		return entryColumns[normalizeHeader(strings.TrimSpace(name))] == columnLabels
+	})
+	if col < 0 {
+		col = len(records[0])
+		records[0] = append(records[0], "Labels")
+	}
+	for i, record := range records[1:] {
+		if isBlank(record) {
+			continue
+		}
+		for len(record) <= col {
+			record = append(record, "")
+		}
+		entryLabels := parseLabels(record[col])
+		for _, label := range labels {
+			if !slices.Contains(entryLabels, label) {
+				entryLabels = append(entryLabels, label)
+			}
+		}
+		record[col] = strings.Join(entryLabels, ", ")
+		records[i+1] = record
+	}
+
+	var buf bytes.Buffer
+	if format == FormatXLSX {
+		if err := writeXLSXRecords(&buf, records); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	if bytes.HasPrefix(data, utf8BOM) {
+		buf.Write(utf8BOM)
+	}
+	cw := csv.NewWriter(&buf)
+	if err := cw.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func formatFlag(value bool) string {
+	if value {
+		return "true"
+	}
+	return ""
+}
+
+// WriteTBX writes the file as a TBX-Basic document of the given version,
+// TBXVersion2 or TBXVersion3. The first locale of the file is declared as
+// the document language.
+func WriteTBX(w io.Writer, f File, version string) error {
+	elements := tbxV3
+	if version == TBXVersion2 {
+		elements = tbxV2
+	}
+	var sourceLocale string
+	if len(f.Locales) > 0 {
+		sourceLocale = f.Locales[0]
+	}
+
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	if version == TBXVersion2 {
+		b.WriteString(`<martif type="TBX-Basic" xml:lang="` + xmlEscape(sourceLocale) + "\">\n")
+		b.WriteString("  <martifHeader><fileDesc><sourceDesc><p>smartling-cli</p></sourceDesc></fileDesc></martifHeader>\n")
+	} else {
+		b.WriteString(`<tbx xmlns="` + tbxNamespace + `" type="TBX-Basic" style="dca" xml:lang="` + xmlEscape(sourceLocale) + "\">\n")
+		b.WriteString("  <tbxHeader><fileDesc><sourceDesc><p>smartling-cli</p></sourceDesc></fileDesc></tbxHeader>\n")
+	}
+	b.WriteString("  <text>\n    <body>\n")
+	for _, e := range f.Entries {
+		b.WriteString("      <" + elements.entry)
+		if e.EntryUID != "" {
+			b.WriteString(` id="` + xmlEscape(e.EntryUID) + `"`)
+		}
+		b.WriteString(">\n")
+		if e.Definition != "" {
+			b.WriteString("        <descrip type=\"definition\">" + xmlEscape(e.Definition) + "</descrip>\n")
+		}
+		for _, t := range e.Terms {
+			b.WriteString("        <" + elements.lang + ` xml:lang="` + xmlEscape(t.LocaleID) + "\">\n")
+			b.WriteString("          <" + elements.term + ">\n")
+			b.WriteString("            <term>" + xmlEscape(t.Text) + "</term>\n")
+			if e.PartOfSpeech != "" {
+				b.WriteString("            <termNote type=\"partOfSpeech\">" + xmlEscape(e.PartOfSpeech) + "</termNote>\n")
+			}
+			if t.DNT {
+				b.WriteString("            <termNote type=\"x-doNotTranslate\">true</termNote>\n")
+			}
+			if t.Notes != "" {
+				b.WriteString("            <note>" + xmlEscape(t.Notes) + "</note>\n")
+			}
+			b.WriteString("          </" + elements.term + ">\n")
+			b.WriteString("        </" + elements.lang + ">\n")
+		}
+		b.WriteString("      </" + elements.entry + ">\n")
+	}
+	b.WriteString("    </body>\n  </text>\n</" + elements.root + ">\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package glossaryfile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteTBX_RoundTrip(t *testing.T) {
+	f := File{
+		Locales:        []string{"en-US", "de-DE"},
+		HasDefinitions: true,
+		Entries: []Entry{
+			{
+				EntryUID: "e1", Definition: `a "greeting" & more`, PartOfSpeech: "noun",
+				Terms: []Term{{LocaleID: "en-US", Text: "hello"}, {LocaleID: "de-DE", Text: "hallo <informal>", Notes: "casual"}},
+			},
+			{
+				Terms: []Term{{LocaleID: "en-US", Text: "Smartling", DNT: true}},
+			},
+		},
+	}
+	for _, version := range []string{TBXVersion2, TBXVersion3} {
+		t.Run(version, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, WriteTBX(&buf, f, version))
+
+			got := parse(t, buf.String(), FormatTBX)
+			assert.Empty(t, got.Issues)
+			assert.Equal(t, version, got.TBXVersion)
+			assert.Equal(t, f.Locales, got.Locales)
+			require.Len(t, got.Entries, 2)
+			for i, e := range got.Entries {
+				e.Line = 0
+				assert.Equal(t, f.Entries[i], e)
+			}
+		})
+	}
+}
+
+func TestWriteXLSX_RoundTrip(t *testing.T) {
+	f := File{
+		Locales:        []string{"en-US", "de-DE"},
+		HasDefinitions: true,
+		HasLabels:      true,
+		HasNotes:       true,
+		HasDNT:         true,
+		Entries: []Entry{
+			{
+				EntryUID: "e1", Definition: "a greeting & more", Labels: []string{"ui", "web"},
+				Terms: []Term{{LocaleID: "en-US", Text: "hello"}, {LocaleID: "de-DE", Text: "hallo", Notes: "casual"}},
+			},
+			{
+				Terms: []Term{{LocaleID: "en-US", Text: "Smartling", DNT: true}},
+			},
+		},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, WriteXLSX(&buf, f))
+
+	got := parse(t, buf.String(), FormatXLSX)
+	assert.Empty(t, got.Issues)
+	assert.Equal(t, f.Locales, got.Locales)
+	assert.Equal(t, Records(f), Records(got))
+	require.Len(t, got.Entries, 2)
+	assert.Equal(t, 3, got.Entries[1].Line)
+}
+
+func TestAddLabels(t *testing.T) {
+	csvData := "Term (en-US),Variations (en-US),Comment,Labels\nhello,\"hi, hey\",keep,web\n,,,\ncart,,,\n"
+	labeled, err := AddLabels([]byte(csvData), FormatCSV, []string{"web", "mobile"})
+	require.NoError(t, err)
+	assert.Equal(t, "Term (en-US),Variations (en-US),Comment,Labels\n"+
+		"hello,\"hi, hey\",keep,\"web, mobile\"\n,,,\ncart,,,\"web, mobile\"\n", string(labeled))
+
+	var buf bytes.Buffer
+	require.NoError(t, writeXLSXRecords(&buf, [][]string{
+		{"Term (en-US)", "Variants (en-US)"},
+		{"hello", "hi"},
+		{"bye"},
+	}))
+	labeled, err = AddLabels(buf.Bytes(), FormatXLSX, []string{"web"})
+	require.NoError(t, err)
+	rows, err := readXLSXRows(labeled)
+	require.NoError(t, err)
+	var records [][]string
+	for _, row := range rows {
+		records = append(records, row.cells)
+	}
+	assert.Equal(t, [][]string{
+		{"Term (en-US)", "Variants (en-US)", "Labels"},
+		{"hello", "hi", "web"},
+		{"bye", "", "web"},
+	}, records)
+
+	_, err = AddLabels(nil, FormatTBX, []string{"web"})
+	assert.Error(t, err)
+}
+
+func TestColumnName(t *testing.T) {
+	for col, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, want, columnName(col))
+		index, err := columnIndex(want + "1")
+		require.NoError(t, err)
+		assert.Equal(t, col, index)
+	}
+}
@@ -0,0 +1,282 @@
+package glossaryfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// XLSX files are zip archives of SpreadsheetML parts. Only the first
+// worksheet is read, which is where the Glossary Export API puts the entries.
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.Text)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func parseXLSX(data []byte) File {
+	f := File{Format: FormatXLSX}
+	rows, err := readXLSXRows(data)
+	if err != nil {
+		f.errorf(1, "not a valid XLSX file: %s", err)
+		return f
+	}
+	fromTable(&f, rows)
+	return f
+}
+
+func readXLSXRows(data []byte) ([]tableRow, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	parts := map[string]*zip.File{}
+	for _, file := range archive.File {
+		parts[file.Name] = file
+	}
+	decode := func(name string, v any) error {
+		part, ok := parts[name]
+		if !ok {
+			return fmt.Errorf("missing part %s", name)
+		}
+		r, err := part.Open()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = r.Close() }()
+		if err := xml.NewDecoder(r).Decode(v); err != nil && err != io.EOF {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		return nil
+	}
+
+	sheetPath, err := firstSheetPath(decode)
+	if err != nil {
+		return nil, err
+	}
+	var shared xlsxSharedStrings
+	if _, ok := parts["xl/sharedStrings.xml"]; ok {
+		if err := decode("xl/sharedStrings.xml", &shared); err != nil {
+			return nil, err
+		}
+	}
+	var sheet xlsxWorksheet
+	if err := decode(sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([]tableRow, 0, len(sheet.Rows))
+	for i, row := range sheet.Rows {
+		number := row.Number
+		if number == 0 {
+			number = i + 1
+		}
+		var cells []string
+		for j, cell := range row.Cells {
+			col := j
+			if cell.Ref != "" {
+				if col, err = columnIndex(cell.Ref); err != nil {
+					return nil, fmt.Errorf("row %d: %w", number, err)
+				}
+			}
+			for len(cells) <= col {
+				cells = append(cells, "")
+			}
+			switch cell.Type {
+			case "s":
+				idx, err := strconv.Atoi(cell.Value)
+				if err != nil || idx < 0 || idx >= len(shared.Items) {
+					return nil, fmt.Errorf("row %d: bad shared string index %q", number, cell.Value)
+				}
+				cells[col] = shared.Items[idx].String()
+			case "inlineStr":
+				cells[col] = cell.Inline.String()
+			default:
+				cells[col] = cell.Value
+			}
+		}
+		rows = append(rows, tableRow{line: number, cells: cells})
+	}
+	return padRows(rows), nil
+}
+
+// firstSheetPath resolves the archive path of the first worksheet.
+func firstSheetPath(decode func(string, any) error) (string, error) {
+	var workbook xlsxWorkbook
+	if err := decode("xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("workbook has no sheets")
+	}
+	var rels xlsxRelationships
+	if err := decode("xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", fmt.Errorf("first sheet %s has no relationship", workbook.Sheets[0].RelID)
+}
+
+// columnIndex returns the zero-based column of a cell reference such as "AB12".
+func columnIndex(ref string) (int, error) {
+	col := 0
+	for i, r := range ref {
+		if r >= '0' && r <= '9' {
+			if i == 0 {
+				break
+			}
+			return col - 1, nil
+		}
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+	}
+	return 0, fmt.Errorf("bad cell reference %q", ref)
+}
+
+// padRows fits rows to the header width, as spreadsheets omit trailing empty
+// cells and may keep formatted but empty ones past the last column.
+func padRows(rows []tableRow) []tableRow {
+	if len(rows) == 0 {
+		return rows
+	}
+	width := len(rows[0].cells)
+	for i := range rows {
+		cells := rows[i].cells
+		for len(cells) > width && strings.TrimSpace(cells[len(cells)-1]) == "" {
+			cells = cells[:len(cells)-1]
+		}
+		for len(cells) < width {
+			cells = append(cells, "")
+		}
+		rows[i].cells = cells
+	}
+	return rows
+}
+
+// xlsxParts are the fixed parts of a single-sheet workbook written by
+// WriteXLSX; the worksheet itself is generated.
+var xlsxParts = []struct{ name, content string }{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Glossary" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// WriteXLSX writes the file as a workbook with the glossary table on its
+// only sheet. Cells are inline strings, so no shared string table is needed.
+func WriteXLSX(w io.Writer, f File) error {
+	return writeXLSXRecords(w, Records(f))
+}
+
+func writeXLSXRecords(w io.Writer, records [][]string) error {
+	var sheet strings.Builder
+	sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, record := range records {
+		row := strconv.Itoa(i + 1)
+		sheet.WriteString(`<row r="` + row + `">`)
+		for col, value := range record {
+			if value == "" {
+				continue
+			}
+			sheet.WriteString(`<c r="` + columnName(col) + row + `" t="inlineStr"><is><t xml:space="preserve">` + xmlEscape(value) + `</t></is></c>`)
+		}
+		sheet.WriteString(`</row>`)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	archive := zip.NewWriter(w)
+	writePart := func(name, content string) error {
+		pw, err := archive.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(pw, content)
+		return err
+	}
+	for _, part := range xlsxParts {
+		if err := writePart(part.name, part.content); err != nil {
+			return err
+		}
+	}
+	if err := writePart("xl/worksheets/sheet1.xml", sheet.String()); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// columnName returns the letters of a zero-based column, the inverse of
+// columnIndex.
+func columnName(col int) string {
+	var name []byte
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = append([]byte{byte('A' + (col-1)%26)}, name...)
+	}
+	return string(name)
+}
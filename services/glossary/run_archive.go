@@ -0,0 +1,77 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryresolver"
+)
+
+// Glossary actions reported by ArchiveOutput.
+const (
+	actionArchived = "archived"
+	actionDeleted  = "deleted"
+)
+
+// ArchiveOutput is the result of archiving or deleting a glossary.
+type ArchiveOutput struct {
+	Action       string `json:"action"`
+	GlossaryUID  string `json:"glossaryUid"`
+	GlossaryName string `json:"glossaryName"`
+	JSON         []byte `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the result.
+func (o ArchiveOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns a human-readable summary of the result.
+func (o ArchiveOutput) SimpleLines() []string {
+	return []string{fmt.Sprintf("Glossary %q (%s) %s", o.GlossaryName, o.GlossaryUID, o.Action)}
+}
+
+// TableData returns the result as a single-row table.
+func (o ArchiveOutput) TableData() ([]string, [][]string) {
+	return []string{"ACTION", "GLOSSARY UID", "GLOSSARY NAME"},
+		[][]string{{o.Action, o.GlossaryUID, o.GlossaryName}}
+}
+
+// RunArchive archives the glossary. Its entries are kept, and the glossary
+// can still be viewed and exported.
+func (s service) RunArchive(ctx context.Context, params GlossaryParams) (ArchiveOutput, error) {
+	return s.remove(ctx, params, actionArchived)
+}
+
+// RunDelete deletes the glossary together with its entries.
+func (s service) RunDelete(ctx context.Context, params GlossaryParams) (ArchiveOutput, error) {
+	return s.remove(ctx, params, actionDeleted)
+}
+
+func (s service) remove(ctx context.Context, params GlossaryParams, action string) (ArchiveOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ArchiveOutput{}, fmt.Errorf("invalid %s params: %w", action, err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return ArchiveOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	details, err := s.glossaryExtApi.GetGlossary(ctx, params.AccountUID, glossaryUID)
+	if err != nil {
+		return ArchiveOutput{}, fmt.Errorf("get glossary %q: %w", glossaryUID, err)
+	}
+	switch action {
+	case actionArchived:
+		err = s.glossaryExtApi.ArchiveGlossary(ctx, params.AccountUID, glossaryUID)
+	default:
+		err = s.glossaryExtApi.DeleteGlossary(ctx, params.AccountUID, glossaryUID)
+	}
+	if err != nil {
+		return ArchiveOutput{}, fmt.Errorf("glossary %s API call failed: %w", action, err)
+	}
+
+	out := ArchiveOutput{Action: action, GlossaryUID: glossaryUID, GlossaryName: details.GlossaryName}
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return ArchiveOutput{}, fmt.Errorf("marshal %s result to JSON: %w", action, err)
+	}
+	return out, nil
+}
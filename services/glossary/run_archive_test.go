@@ -0,0 +1,36 @@
+package glossary
+
+import (
+	"testing"
+
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunArchive(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Web":    "Term (en-US)\nhello\n",
+		"Mobile": "Term (en-US)\nhello\n",
+	})
+	ctx := t.Context()
+
+	archived, err := s.RunArchive(ctx, GlossaryParams{AccountUID: "account", GlossaryUIDOrName: "Web"})
+	require.NoError(t, err)
+	assert.Equal(t, "archived", archived.Action)
+	assert.Equal(t, []string{`Glossary "Web" (` + archived.GlossaryUID + `) archived`}, archived.SimpleLines())
+	view, err := s.RunView(ctx, GlossaryParams{AccountUID: "account", GlossaryUIDOrName: archived.GlossaryUID})
+	require.NoError(t, err)
+	assert.True(t, view.Archived)
+	assert.Equal(t, 1, view.ActiveEntries)
+
+	deleted, err := s.RunDelete(ctx, GlossaryParams{AccountUID: "account", GlossaryUIDOrName: "Mobile"})
+	require.NoError(t, err)
+	assert.Equal(t, "deleted", deleted.Action)
+	assert.JSONEq(t, `{"action":"deleted","glossaryUid":"`+deleted.GlossaryUID+`","glossaryName":"Mobile"}`, string(deleted.JSON))
+	_, err = s.RunView(ctx, GlossaryParams{AccountUID: "account", GlossaryUIDOrName: "Mobile"})
+	assert.ErrorIs(t, err, glossaryapi.ErrGlossaryNotFound)
+
+	_, err = s.RunDelete(ctx, GlossaryParams{AccountUID: "account"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,333 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/config"
+	"github.com/Smartling/smartling-cli/services/helpers/format"
+	globfiles "github.com/Smartling/smartling-cli/services/helpers/glob_files"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	sdkfile "github.com/Smartling/api-sdk-go/helpers/sm_file"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// CheckParams defines term-consistency check params. The glossary is either
+// exported from Smartling or read from a local file, which keeps the check
+// offline.
+type CheckParams struct {
+	AccountUID        uid.AccountUID
+	GlossaryUIDOrName string
+	GlossaryFile      string
+	GlossaryFormat    glossaryfile.Format
+	// SourceLocaleID is the locale of the source files; empty selects the
+	// first locale of the glossary.
+	SourceLocaleID string
+	// LocaleIDs limits the translations checked; empty checks every other
+	// locale of the glossary.
+	LocaleIDs []string
+	// Patterns select the source files; empty uses the `files:` patterns of
+	// Config which have a push type, as `files push` does.
+	Patterns []string
+	// Config supplies the `files:` patterns and the pull format which names
+	// the translated files.
+	Config    config.Config
+	Directory string
+}
+
+// Validate enforces the fields required to check term consistency.
+func (p CheckParams) Validate() error {
+	switch {
+	case p.GlossaryUIDOrName != "" && p.GlossaryFile != "":
+		return clierror.ErrIncompatibleParams("glossary file", []string{"glossary"})
+	case p.GlossaryUIDOrName == "" && p.GlossaryFile == "":
+		return smerror.ErrEmptyParam("GlossaryUIDOrName or GlossaryFile")
+	case p.GlossaryFile != "" && p.GlossaryFormat == "":
+		return fmt.Errorf("unknown glossary file format of %q: use a .csv, .xlsx or .tbx file", p.GlossaryFile)
+	case p.GlossaryUIDOrName != "":
+		return p.AccountUID.Validate()
+	}
+	return nil
+}
+
+// CheckedTranslation is a translated file checked against the glossary.
+type CheckedTranslation struct {
+	Source      string                   `json:"source"`
+	Translation string                   `json:"translation"`
+	LocaleID    string                   `json:"localeId"`
+	Violations  []glossaryfile.Violation `json:"violations"`
+}
+
+// CheckOutput represents the result of a term-consistency check.
+type CheckOutput struct {
+	Glossary            string               `json:"glossary"`
+	SourceLocaleID      string               `json:"sourceLocaleId"`
+	LocaleIDs           []string             `json:"localeIds"`
+	SourceFiles         int                  `json:"sourceFiles"`
+	Violations          int                  `json:"violations"`
+	Translations        []CheckedTranslation `json:"translations"`
+	MissingTranslations []string             `json:"missingTranslations"`
+	JSON                []byte               `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the check result.
+func (o CheckOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns one line per violation followed by a summary.
+func (o CheckOutput) SimpleLines() []string {
+	lines := make([]string, 0, o.Violations+1)
+	for _, t := range o.Translations {
+		for _, v := range t.Violations {
+			location := t.Translation
+			if v.TranslationLine > 0 {
+				location = fmt.Sprintf("%s:%d", t.Translation, v.TranslationLine)
+			}
+			lines = append(lines, fmt.Sprintf("%s: %s: %s (source %s:%d)", location, t.LocaleID, violationMessage(v), t.Source, v.Line))
+		}
+	}
+	summary := fmt.Sprintf("Checked %d translation(s) of %d source file(s): %d violation(s)", len(o.Translations), o.SourceFiles, o.Violations)
+	if len(o.MissingTranslations) > 0 {
+		summary += fmt.Sprintf(", %d translation(s) not found locally", len(o.MissingTranslations))
+	}
+	return append(lines, summary+".")
+}
+
+// TableData returns one row per violation.
+func (o CheckOutput) TableData() ([]string, [][]string) {
+	headers := []string{"TRANSLATION", "LINE", "LOCALE", "KIND", "TERM", "EXPECTED", "SOURCE"}
+	rows := make([][]string, 0, o.Violations)
+	for _, t := range o.Translations {
+		for _, v := range t.Violations {
+			line := ""
+			if v.TranslationLine > 0 {
+				line = fmt.Sprint(v.TranslationLine)
+			}
+			rows = append(rows, []string{t.Translation, line, t.LocaleID, v.Kind, v.SourceTerm, v.Expected, fmt.Sprintf("%s:%d", t.Source, v.Line)})
+		}
+	}
+	return headers, rows
+}
+
+func violationMessage(v glossaryfile.Violation) string {
+	if v.Kind == glossaryfile.ViolationDNT {
+		return fmt.Sprintf("do-not-translate term %q was translated", v.SourceTerm)
+	}
+	return fmt.Sprintf("%q is not translated as %q", v.SourceTerm, v.Expected)
+}
+
+// RunCheck scans local source files and their pulled translations for uses
+// of glossary terms which the translation does not follow. When violations
+// are found the output is returned together with a
+// clierror.PartialFailureError counting the translations with violations.
+func (s service) RunCheck(ctx context.Context, params CheckParams) (CheckOutput, error) {
+	if err := params.Validate(); err != nil {
+		return CheckOutput{}, fmt.Errorf("invalid check params: %w", err)
+	}
+	f, glossary, err := s.checkGlossary(ctx, params)
+	if err != nil {
+		return CheckOutput{}, err
+	}
+
+	out := CheckOutput{
+		Glossary:            glossary,
+		SourceLocaleID:      params.SourceLocaleID,
+		LocaleIDs:           params.LocaleIDs,
+		Translations:        []CheckedTranslation{},
+		MissingTranslations: []string{},
+	}
+	if out.SourceLocaleID == "" && len(f.Locales) > 0 {
+		out.SourceLocaleID = f.Locales[0]
+	}
+	if !slices.Contains(f.Locales, out.SourceLocaleID) {
+		return CheckOutput{}, fmt.Errorf("source locale %q is not a locale of glossary %s", out.SourceLocaleID, glossary)
+	}
+	if len(out.LocaleIDs) == 0 {
+		out.LocaleIDs = slices.DeleteFunc(slices.Clone(f.Locales), func(localeID string) bool { return localeID == out.SourceLocaleID })
+	}
+
+	sources, err := checkSources(params, out.LocaleIDs)
+	if err != nil {
+		return CheckOutput{}, err
+	}
+	out.SourceFiles = len(sources)
+
+	for _, source := range sources {
+		content, err := os.ReadFile(source.path)
+		if err != nil {
+			return CheckOutput{}, fmt.Errorf("read source file: %w", err)
+		}
+		for i, localeID := range out.LocaleIDs {
+			path := source.translations[i]
+			if path == source.path {
+				continue
+			}
+			translation, err := os.ReadFile(path)
+			if os.IsNotExist(err) {
+				rlog.Debugf("translation %s of %s not found", path, source.path)
+				out.MissingTranslations = append(out.MissingTranslations, path)
+				continue
+			}
+			if err != nil {
+				return CheckOutput{}, fmt.Errorf("read translation: %w", err)
+			}
+			terms := glossaryfile.CheckTerms(f, out.SourceLocaleID, localeID)
+			violations := glossaryfile.CheckConsistency(terms, string(content), string(translation))
+			if violations == nil {
+				violations = []glossaryfile.Violation{}
+			}
+			out.Violations += len(violations)
+			out.Translations = append(out.Translations, CheckedTranslation{
+				Source:      source.path,
+				Translation: path,
+				LocaleID:    localeID,
+				Violations:  violations,
+			})
+		}
+	}
+
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return CheckOutput{}, fmt.Errorf("marshal check result to JSON: %w", err)
+	}
+	if out.Violations > 0 {
+		failed := 0
+		for _, t := range out.Translations {
+			if len(t.Violations) > 0 {
+				failed++
+			}
+		}
+		return out, clierror.PartialFailureError{Failed: failed, Total: len(out.Translations)}
+	}
+	return out, nil
+}
+
+// checkGlossary reads the glossary file, or exports the glossary as TBX, and
+// returns it with the name it is reported under.
+func (s service) checkGlossary(ctx context.Context, params CheckParams) (glossaryfile.File, string, error) {
+	if params.GlossaryFile != "" {
+		f, err := glossaryfile.Read(params.GlossaryFile, params.GlossaryFormat)
+		if err != nil {
+			return glossaryfile.File{}, "", fmt.Errorf("read glossary file %q: %w", params.GlossaryFile, err)
+		}
+		return f, params.GlossaryFile, parseErrors(params.GlossaryFile, f)
+	}
+
+	dir, err := os.MkdirTemp("", "smartling-glossary-check-")
+	if err != nil {
+		return glossaryfile.File{}, "", fmt.Errorf("create export directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			rlog.Errorf("failed to remove export directory %q: %v", dir, err)
+		}
+	}()
+	path := filepath.Join(dir, "glossary.tbx")
+	f, err := s.exportAndRead(ctx, params.AccountUID, params.GlossaryUIDOrName, path)
+	if err != nil {
+		return glossaryfile.File{}, "", err
+	}
+	return f, params.GlossaryUIDOrName, parseErrors(params.GlossaryUIDOrName, f)
+}
+
+type checkSource struct {
+	path    string
+	fileURI string
+	// translations are the local paths of the translations, one per locale.
+	translations []string
+}
+
+// checkSources finds the local source files, the file URIs they are pushed
+// under (their path relative to the config file directory) and the paths
+// their translations are pulled to. Files which are themselves the
+// translation of a matched file are left out, since patterns such as
+// "**/*.json" match pulled translations too.
+func checkSources(params CheckParams, localeIDs []string) ([]checkSource, error) {
+	patterns := params.Patterns
+	if len(patterns) == 0 {
+		for pattern, section := range params.Config.Files {
+			if section.Push.Type != "" {
+				patterns = append(patterns, pattern)
+			}
+		}
+		slices.Sort(patterns)
+	}
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("no source files to check: pass file patterns or configure `files:` patterns with a push type")
+	}
+
+	base := params.Directory
+	if params.Config.Path != "" {
+		base = filepath.Dir(params.Config.Path)
+	}
+	base, err := filepath.Abs(base)
+	if err != nil {
+		return nil, fmt.Errorf("resolve project directory: %w", err)
+	}
+
+	var sources []checkSource
+	seen := map[string]bool{}
+	translations := map[string]bool{}
+	for _, pattern := range patterns {
+		dir, mask := globfiles.GetDirectoryFromPattern(pattern)
+		paths, err := globfiles.LocallyFunc(params.Directory, dir, mask)
+		if err != nil {
+			return nil, fmt.Errorf("find files matching %q: %w", pattern, err)
+		}
+		for _, path := range paths {
+			if seen[path] {
+				continue
+			}
+			seen[path] = true
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				return nil, fmt.Errorf("resolve %q: %w", path, err)
+			}
+			fileURI, err := filepath.Rel(base, abs)
+			if err != nil || strings.HasPrefix(fileURI, "..") {
+				fileURI = path
+			}
+			source := checkSource{path: path, fileURI: filepath.ToSlash(fileURI)}
+			for _, localeID := range localeIDs {
+				translation, err := translationPath(params, source.fileURI, localeID)
+				if err != nil {
+					return nil, err
+				}
+				source.translations = append(source.translations, translation)
+				if translation != path {
+					translations[translation] = true
+				}
+			}
+			sources = append(sources, source)
+		}
+	}
+	sources = slices.DeleteFunc(sources, func(source checkSource) bool { return translations[source.path] })
+	slices.SortFunc(sources, func(a, b checkSource) int { return strings.Compare(a.path, b.path) })
+	return sources, nil
+}
+
+// translationPath names the pulled translation of a file the way `files
+// pull` does.
+func translationPath(params CheckParams, fileURI, localeID string) (string, error) {
+	path, err := format.ExecuteFileFormat(
+		params.Config,
+		sdkfile.File{FileURI: fileURI},
+		format.DefaultFilePullFormat,
+		format.UsePullFormat,
+		map[string]any{
+			"FileURI": fileURI,
+			"Locale":  localeID,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(params.Directory, path), nil
+}
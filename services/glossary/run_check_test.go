@@ -0,0 +1,107 @@
+package glossary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkProject writes files into a temporary project directory with a
+// `files:` pattern for properties files.
+func checkProject(t *testing.T, files map[string]string) (string, config.Config) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	var properties config.FileConfig
+	properties.Push.Type = "javaProperties"
+	return dir, config.Config{
+		Path:  filepath.Join(dir, "smartling.yml"),
+		Files: map[string]config.FileConfig{"*.properties": properties},
+	}
+}
+
+func TestRunCheck(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Web": "Definition,Term (en-US),Term (de-DE),DNT (de-DE)\npage,checkout,Kasse,\nbrand,Smartling,Smartling,true\n",
+	})
+	dir, cfg := checkProject(t, map[string]string{
+		"app.properties":       "title=Checkout\nbrand=Powered by Smartling\nhint=Go to checkout\n",
+		"app_de-DE.properties": "title=Kasse\nbrand=Unterstützt von Smartlings\nhint=Zur Bezahlung\n",
+		"other.properties":     "empty=\n",
+	})
+
+	out, err := s.RunCheck(t.Context(), CheckParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		Config:            cfg,
+		Directory:         dir,
+	})
+	var partial clierror.PartialFailureError
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, clierror.PartialFailureError{Failed: 1, Total: 1}, partial)
+
+	assert.Equal(t, "en-US", out.SourceLocaleID)
+	assert.Equal(t, []string{"de-DE"}, out.LocaleIDs)
+	assert.Equal(t, 2, out.SourceFiles, "the translation is not a source file")
+	assert.Equal(t, []string{filepath.Join(dir, "other_de-DE.properties")}, out.MissingTranslations)
+	require.Len(t, out.Translations, 1)
+	assert.Equal(t, []glossaryfile.Violation{
+		{Line: 2, TranslationLine: 2, Kind: glossaryfile.ViolationDNT, SourceTerm: "Smartling", Expected: "Smartling"},
+		{Line: 3, TranslationLine: 3, Kind: glossaryfile.ViolationTranslation, SourceTerm: "checkout", Expected: "Kasse"},
+	}, out.Translations[0].Violations)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "app_de-DE.properties") + `:2: de-DE: do-not-translate term "Smartling" was translated (source ` + filepath.Join(dir, "app.properties") + ":2)",
+		filepath.Join(dir, "app_de-DE.properties") + `:3: de-DE: "checkout" is not translated as "Kasse" (source ` + filepath.Join(dir, "app.properties") + ":3)",
+		"Checked 1 translation(s) of 2 source file(s): 2 violation(s), 1 translation(s) not found locally.",
+	}, out.SimpleLines())
+}
+
+func TestRunCheck_Offline(t *testing.T) {
+	dir, cfg := checkProject(t, map[string]string{
+		"terms.csv":            "Term (en-US),Term (de-DE),Term (fr-FR)\ncheckout,Kasse,paiement\n",
+		"app.properties":       "title=Checkout\n",
+		"app_de-DE.properties": "title=Zur Kasse\n",
+	})
+
+	s := NewService(nil, nil)
+	out, err := s.RunCheck(t.Context(), CheckParams{
+		GlossaryFile:   filepath.Join(dir, "terms.csv"),
+		GlossaryFormat: glossaryfile.FormatCSV,
+		LocaleIDs:      []string{"de-DE"},
+		Patterns:       []string{"app*.properties"},
+		Config:         cfg,
+		Directory:      dir,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, out.Violations)
+	assert.Len(t, out.Translations, 1)
+	assert.Empty(t, out.MissingTranslations)
+
+	_, err = s.RunCheck(t.Context(), CheckParams{
+		GlossaryFile:   filepath.Join(dir, "terms.csv"),
+		GlossaryFormat: glossaryfile.FormatCSV,
+		SourceLocaleID: "es-ES",
+		Config:         cfg,
+		Directory:      dir,
+	})
+	assert.EqualError(t, err, `source locale "es-ES" is not a locale of glossary `+filepath.Join(dir, "terms.csv"))
+}
+
+func TestCheckParams_Validate(t *testing.T) {
+	assert.Error(t, CheckParams{}.Validate())
+	assert.Error(t, CheckParams{GlossaryUIDOrName: "Web", GlossaryFile: "terms.tbx", GlossaryFormat: glossaryfile.FormatTBX}.Validate())
+	assert.Error(t, CheckParams{GlossaryFile: "terms.txt"}.Validate())
+	assert.Error(t, CheckParams{GlossaryUIDOrName: "Web"}.Validate())
+	assert.NoError(t, CheckParams{AccountUID: "account", GlossaryUIDOrName: "Web"}.Validate())
+	assert.NoError(t, CheckParams{GlossaryFile: "terms.tbx", GlossaryFormat: glossaryfile.FormatTBX}.Validate())
+}
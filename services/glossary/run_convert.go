@@ -0,0 +1,186 @@
+package glossary
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+)
+
+// ConvertParams defines a local conversion of a glossary file to another
+// format.
+type ConvertParams struct {
+	InputPath    string
+	InputFormat  glossaryfile.Format
+	OutputPath   string
+	OutputFormat glossaryfile.Format
+	// TBXVersion is the structure of TBX output: glossaryfile.TBXVersion2 or
+	// glossaryfile.TBXVersion3.
+	TBXVersion string
+}
+
+// Validate enforces the fields required to convert a glossary file.
+func (p ConvertParams) Validate() error {
+	switch {
+	case p.InputPath == "":
+		return smerror.ErrEmptyParam("InputPath")
+	case p.OutputPath == "":
+		return smerror.ErrEmptyParam("OutputPath")
+	case p.InputFormat == "":
+		return fmt.Errorf("unknown glossary file format of %q: use a .csv, .xlsx or .tbx file", p.InputPath)
+	case p.OutputFormat == "":
+		return fmt.Errorf("unknown glossary file format of %q: use a .csv, .xlsx or .tbx file", p.OutputPath)
+	}
+	if filepath.Clean(p.InputPath) == filepath.Clean(p.OutputPath) {
+		return fmt.Errorf("output file %q must differ from the input file", p.OutputPath)
+	}
+	if p.OutputFormat != glossaryfile.FormatTBX {
+		if p.TBXVersion != "" {
+			return clierror.ErrIncompatibleParams("tbx version", []string{string(p.OutputFormat) + " output"})
+		}
+		return nil
+	}
+	if !slices.Contains(AllowedExportTbxVersions, p.TBXVersion) {
+		return fmt.Errorf("unsupported tbx version %q: allowed values are %v", p.TBXVersion, AllowedExportTbxVersions)
+	}
+	return nil
+}
+
+// ConvertOutput represents the result of a glossary file conversion.
+type ConvertOutput struct {
+	Input        string               `json:"input"`
+	InputFormat  string               `json:"inputFormat"`
+	Output       string               `json:"output"`
+	OutputFormat string               `json:"outputFormat"`
+	TBXVersion   string               `json:"tbxVersion,omitempty"`
+	Locales      []string             `json:"locales"`
+	Entries      int                  `json:"entries"`
+	Issues       []glossaryfile.Issue `json:"issues"`
+	// Dropped lists the data the output format cannot carry.
+	Dropped []string `json:"dropped"`
+	JSON    []byte   `json:"-"`
+
+	// locations renders issue lines as "line N" or "row N".
+	locations []string
+}
+
+// JSONBytes returns the JSON representation of the conversion result.
+func (o ConvertOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns the input issues and dropped data followed by a summary.
+func (o ConvertOutput) SimpleLines() []string {
+	lines := make([]string, 0, len(o.Issues)+len(o.Dropped)+1)
+	for i, issue := range o.Issues {
+		lines = append(lines, fmt.Sprintf("%s:%s: %s: %s", o.Input, o.locations[i], issue.Severity, issue.Message))
+	}
+	for _, dropped := range o.Dropped {
+		lines = append(lines, fmt.Sprintf("%s: warning: %s", o.Output, dropped))
+	}
+	if glossaryfile.Errors(o.Issues) > 0 {
+		return append(lines, fmt.Sprintf("%s: not converted, fix the errors first", o.Input))
+	}
+	format := strings.ToUpper(o.OutputFormat)
+	if o.TBXVersion != "" {
+		format += " " + o.TBXVersion
+	}
+	return append(lines, fmt.Sprintf("Converted %d entries in %d locales from %s to %s (%s).",
+		o.Entries, len(o.Locales), o.Input, o.Output, format))
+}
+
+// TableData returns the conversion summary as a single-row table.
+func (o ConvertOutput) TableData() ([]string, [][]string) {
+	headers := []string{"INPUT", "OUTPUT", "FORMAT", "ENTRIES", "LOCALES", "ISSUES", "DROPPED"}
+	format := o.OutputFormat
+	if o.TBXVersion != "" {
+		format += " " + o.TBXVersion
+	}
+	rows := [][]string{{
+		o.Input, o.Output, format, fmt.Sprintf("%d", o.Entries), strings.Join(o.Locales, ", "),
+		fmt.Sprintf("%d", len(o.Issues)), strings.Join(o.Dropped, "; "),
+	}}
+	return headers, rows
+}
+
+// RunConvert reads a glossary file and writes it in another format, locally.
+// The input is validated the way the import would validate it; when it has
+// errors nothing is written and the output is returned together with a
+// clierror.InvalidFileError.
+func (s service) RunConvert(_ context.Context, params ConvertParams) (ConvertOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ConvertOutput{}, fmt.Errorf("invalid convert params: %w", err)
+	}
+	f, err := glossaryfile.Read(params.InputPath, params.InputFormat)
+	if err != nil {
+		return ConvertOutput{}, fmt.Errorf("read glossary file %q: %w", params.InputPath, err)
+	}
+
+	issues := glossaryfile.Validate(f, nil)
+	out := ConvertOutput{
+		Input:        params.InputPath,
+		InputFormat:  string(params.InputFormat),
+		Output:       params.OutputPath,
+		OutputFormat: string(params.OutputFormat),
+		TBXVersion:   params.TBXVersion,
+		Locales:      f.Locales,
+		Entries:      len(f.Entries),
+		Issues:       issues,
+		Dropped:      droppedData(f, params.OutputFormat),
+		locations:    make([]string, len(issues)),
+	}
+	for i, issue := range issues {
+		out.locations[i] = f.Location(issue.Line)
+	}
+	if out.Locales == nil {
+		out.Locales = []string{}
+	}
+	if out.Issues == nil {
+		out.Issues = []glossaryfile.Issue{}
+	}
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return ConvertOutput{}, fmt.Errorf("marshal conversion result to JSON: %w", err)
+	}
+	if errs := glossaryfile.Errors(issues); errs > 0 {
+		return out, clierror.InvalidFileError{Path: params.InputPath, Errors: errs}
+	}
+
+	var buf bytes.Buffer
+	switch params.OutputFormat {
+	case glossaryfile.FormatCSV:
+		err = glossaryfile.WriteCSV(&buf, f)
+	case glossaryfile.FormatXLSX:
+		err = glossaryfile.WriteXLSX(&buf, f)
+	case glossaryfile.FormatTBX:
+		err = glossaryfile.WriteTBX(&buf, f, params.TBXVersion)
+	}
+	if err != nil {
+		return ConvertOutput{}, fmt.Errorf("convert to %s: %w", params.OutputFormat, err)
+	}
+	if err := os.WriteFile(params.OutputPath, buf.Bytes(), 0o644); err != nil {
+		return ConvertOutput{}, fmt.Errorf("write glossary file %q: %w", params.OutputPath, err)
+	}
+	return out, nil
+}
+
+// droppedData lists what the output format cannot carry: TBX-Basic has no
+// entry labels.
+func droppedData(f glossaryfile.File, format glossaryfile.Format) []string {
+	dropped := []string{}
+	if format != glossaryfile.FormatTBX || !f.HasLabels {
+		return dropped
+	}
+	for _, e := range f.Entries {
+		if len(e.Labels) > 0 {
+			return append(dropped, "TBX has no entry labels; the Labels column is left out")
+		}
+	}
+	return dropped
+}
@@ -0,0 +1,110 @@
+package glossary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunConvert(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "vendor.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("Definition,Labels,Term (en-US),Term (de-DE),DNT (de-DE)\n"+
+		"a greeting,ui,hello,hallo,\n"+
+		"brand,,Smartling,Smartling,true\n"), 0o600))
+	s := NewService(nil, nil)
+	ctx := t.Context()
+
+	tbxPath := filepath.Join(dir, "terms.tbx")
+	out, err := s.RunConvert(ctx, ConvertParams{
+		InputPath: csvPath, InputFormat: glossaryfile.FormatCSV,
+		OutputPath: tbxPath, OutputFormat: glossaryfile.FormatTBX, TBXVersion: glossaryfile.TBXVersion3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, out.Entries)
+	assert.Equal(t, []string{"TBX has no entry labels; the Labels column is left out"}, out.Dropped)
+	assert.Equal(t, "Converted 2 entries in 2 locales from "+csvPath+" to "+tbxPath+" (TBX v3).", out.SimpleLines()[1])
+
+	tbx, err := glossaryfile.Read(tbxPath, glossaryfile.FormatTBX)
+	require.NoError(t, err)
+	assert.Equal(t, glossaryfile.TBXVersion3, tbx.TBXVersion)
+	assert.Empty(t, glossaryfile.Validate(tbx, []string{"en-US", "de-DE"}))
+
+	xlsxPath := filepath.Join(dir, "terms.xlsx")
+	out, err = s.RunConvert(ctx, ConvertParams{
+		InputPath: tbxPath, InputFormat: glossaryfile.FormatTBX,
+		OutputPath: xlsxPath, OutputFormat: glossaryfile.FormatXLSX,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, out.Dropped)
+	xlsx, err := glossaryfile.Read(xlsxPath, glossaryfile.FormatXLSX)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Definition", "Term (en-US)", "Notes (en-US)", "DNT (en-US)", "Term (de-DE)", "Notes (de-DE)", "DNT (de-DE)"},
+		{"a greeting", "hello", "", "", "hallo", "", ""},
+		{"brand", "Smartling", "", "", "Smartling", "", "true"},
+	}, glossaryfile.Records(xlsx))
+
+	backPath := filepath.Join(dir, "back.csv")
+	_, err = s.RunConvert(ctx, ConvertParams{
+		InputPath: xlsxPath, InputFormat: glossaryfile.FormatXLSX,
+		OutputPath: backPath, OutputFormat: glossaryfile.FormatCSV,
+	})
+	require.NoError(t, err)
+	back, err := os.ReadFile(backPath)
+	require.NoError(t, err)
+	assert.Equal(t, "Definition,Term (en-US),Notes (en-US),DNT (en-US),Term (de-DE),Notes (de-DE),DNT (de-DE)\n"+
+		"a greeting,hello,,,hallo,,\n"+
+		"brand,Smartling,,,Smartling,,true\n", string(back))
+}
+
+func TestRunConvert_InvalidInput(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "vendor.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("Definition,Term (en-US)\ngreeting,hello\nagain,hello\n"), 0o600))
+	outPath := filepath.Join(dir, "terms.tbx")
+
+	out, err := NewService(nil, nil).RunConvert(t.Context(), ConvertParams{
+		InputPath: csvPath, InputFormat: glossaryfile.FormatCSV,
+		OutputPath: outPath, OutputFormat: glossaryfile.FormatTBX, TBXVersion: glossaryfile.TBXVersion2,
+	})
+	var invalid clierror.InvalidFileError
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, 1, invalid.Errors)
+	assert.Equal(t, []string{
+		csvPath + `:line 3: error: duplicate en-US term "hello" (first at line 2)`,
+		csvPath + ": not converted, fix the errors first",
+	}, out.SimpleLines())
+	assert.NoFileExists(t, outPath)
+}
+
+func TestConvertParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  ConvertParams
+		wantErr bool
+	}{
+		{name: "csv to tbx", params: ConvertParams{InputPath: "a.csv", InputFormat: "csv", OutputPath: "a.tbx", OutputFormat: "tbx", TBXVersion: "v2"}},
+		{name: "tbx to xlsx", params: ConvertParams{InputPath: "a.tbx", InputFormat: "tbx", OutputPath: "a.xlsx", OutputFormat: "xlsx"}},
+		{name: "tbx without version", params: ConvertParams{InputPath: "a.csv", InputFormat: "csv", OutputPath: "a.tbx", OutputFormat: "tbx"}, wantErr: true},
+		{name: "version for csv output", params: ConvertParams{InputPath: "a.tbx", InputFormat: "tbx", OutputPath: "a.csv", OutputFormat: "csv", TBXVersion: "v3"}, wantErr: true},
+		{name: "unknown output format", params: ConvertParams{InputPath: "a.csv", InputFormat: "csv", OutputPath: "a.txt"}, wantErr: true},
+		{name: "same file", params: ConvertParams{InputPath: "a.csv", InputFormat: "csv", OutputPath: "./a.csv", OutputFormat: "csv"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// DiffParams defines diff params. The glossary is compared either with
+// another glossary or with a local file.
+type DiffParams struct {
+	AccountUID             uid.AccountUID
+	GlossaryUIDOrName      string
+	OtherGlossaryUIDOrName string
+	File                   string
+	FileFormat             glossaryfile.Format
+	// SourceLocaleID is the locale entries are aligned by; empty selects the
+	// first locale of the glossary which the other side has too.
+	SourceLocaleID string
+}
+
+// Validate enforces the fields required to diff a glossary.
+func (p DiffParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.GlossaryUIDOrName == "" {
+		return smerror.ErrEmptyParam("GlossaryUIDOrName")
+	}
+	switch {
+	case p.OtherGlossaryUIDOrName != "" && p.File != "":
+		return clierror.ErrIncompatibleParams("file", []string{"other glossary"})
+	case p.OtherGlossaryUIDOrName == "" && p.File == "":
+		return smerror.ErrEmptyParam("OtherGlossaryUIDOrName or File")
+	case p.File != "" && p.FileFormat == "":
+		return fmt.Errorf("unknown glossary file format of %q: use a .csv, .xlsx or .tbx file", p.File)
+	}
+	return nil
+}
+
+// DiffOutput represents the differences from a glossary to the other side.
+type DiffOutput struct {
+	Old            string                `json:"old"`
+	New            string                `json:"new"`
+	SourceLocaleID string                `json:"sourceLocaleId"`
+	Added          int                   `json:"added"`
+	Removed        int                   `json:"removed"`
+	Changed        int                   `json:"changed"`
+	Unaligned      int                   `json:"unaligned"`
+	Changes        []glossaryfile.Change `json:"changes"`
+	JSON           []byte                `json:"-"`
+
+	updates glossaryfile.File
+}
+
+// JSONBytes returns the JSON representation of the diff.
+func (p DiffOutput) JSONBytes() []byte { return p.JSON }
+
+// SimpleLines returns one line per change followed by a summary.
+func (p DiffOutput) SimpleLines() []string {
+	lines := make([]string, 0, len(p.Changes)+2)
+	for _, c := range p.Changes {
+		subject := c.SourceTerm
+		if c.LocaleID != "" {
+			subject += " [" + c.LocaleID + "]"
+		}
+		var value string
+		switch c.Change {
+		case glossaryfile.ChangeAdded:
+			value = fmt.Sprintf("+ %s %s %q", subject, c.Field, c.New)
+		case glossaryfile.ChangeRemoved:
+			value = fmt.Sprintf("- %s %s %q", subject, c.Field, c.Old)
+		default:
+			value = fmt.Sprintf("~ %s %s %q -> %q", subject, c.Field, c.Old, c.New)
+		}
+		lines = append(lines, value)
+	}
+	lines = append(lines, fmt.Sprintf("%s -> %s: %d added, %d removed, %d changed (entries aligned by %s terms)",
+		p.Old, p.New, p.Added, p.Removed, p.Changed, p.SourceLocaleID))
+	if p.Unaligned > 0 {
+		lines = append(lines, fmt.Sprintf("%d entries without a %s term were not compared", p.Unaligned, p.SourceLocaleID))
+	}
+	return lines
+}
+
+// TableData returns one row per change.
+func (p DiffOutput) TableData() ([]string, [][]string) {
+	headers := []string{"SOURCE TERM", "LOCALE", "FIELD", "CHANGE", "OLD", "NEW"}
+	rows := make([][]string, 0, len(p.Changes))
+	for _, c := range p.Changes {
+		rows = append(rows, []string{c.SourceTerm, c.LocaleID, c.Field, c.Change, c.Old, c.New})
+	}
+	return headers, rows
+}
+
+// CSVData returns the added and changed entries as a glossary table which
+// can be imported into the old glossary.
+func (p DiffOutput) CSVData() ([]string, [][]string) {
+	records := glossaryfile.Records(p.updates)
+	return records[0], records[1:]
+}
+
+// RunDiff exports the glossary and the other glossary, or parses the local
+// file, aligns their entries by source term and reports the differences.
+func (s service) RunDiff(ctx context.Context, params DiffParams) (DiffOutput, error) {
+	if err := params.Validate(); err != nil {
+		return DiffOutput{}, fmt.Errorf("invalid diff params: %w", err)
+	}
+	dir, err := os.MkdirTemp("", "smartling-glossary-diff-")
+	if err != nil {
+		return DiffOutput{}, fmt.Errorf("create export directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			rlog.Errorf("failed to remove export directory %q: %v", dir, err)
+		}
+	}()
+
+	oldFile, err := s.exportAndRead(ctx, params.AccountUID, params.GlossaryUIDOrName, filepath.Join(dir, "old.csv"))
+	if err != nil {
+		return DiffOutput{}, err
+	}
+	var newFile glossaryfile.File
+	newName := params.OtherGlossaryUIDOrName
+	if params.File != "" {
+		newName = params.File
+		newFile, err = glossaryfile.Read(params.File, params.FileFormat)
+		if err != nil {
+			return DiffOutput{}, fmt.Errorf("read glossary file %q: %w", params.File, err)
+		}
+		if err := parseErrors(params.File, newFile); err != nil {
+			return DiffOutput{}, err
+		}
+	} else {
+		newFile, err = s.exportAndRead(ctx, params.AccountUID, params.OtherGlossaryUIDOrName, filepath.Join(dir, "new.csv"))
+		if err != nil {
+			return DiffOutput{}, err
+		}
+	}
+
+	res, err := glossaryfile.Diff(oldFile, newFile, params.SourceLocaleID)
+	if err != nil {
+		return DiffOutput{}, err
+	}
+	return toDiffOutput(params.GlossaryUIDOrName, newName, res)
+}
+
+// exportAndRead exports the glossary to path, in the format its extension
+// names (TBX as v3), and reads the export back.
+func (s service) exportAndRead(ctx context.Context, accountUID uid.AccountUID, glossaryUIDOrName, path string) (glossaryfile.File, error) {
+	format := glossaryfile.FormatFromPath(path)
+	params := ExportParams{
+		AccountUID:        accountUID,
+		GlossaryUIDOrName: glossaryUIDOrName,
+		OutFile:           path,
+		FileType:          string(format),
+	}
+	if format == glossaryfile.FormatTBX {
+		params.TbxVersion = glossaryfile.TBXVersion3
+	}
+	if _, err := s.RunExport(ctx, params); err != nil {
+		return glossaryfile.File{}, fmt.Errorf("export glossary %q: %w", glossaryUIDOrName, err)
+	}
+	f, err := glossaryfile.Read(path, format)
+	if err != nil {
+		return glossaryfile.File{}, fmt.Errorf("read export of glossary %q: %w", glossaryUIDOrName, err)
+	}
+	return f, nil
+}
+
+// parseErrors returns an InvalidFileError when the file could not be parsed
+// cleanly, since a diff of a half-read file would be misleading.
+func parseErrors(path string, f glossaryfile.File) error {
+	var details []string
+	for _, issue := range f.Issues {
+		if issue.Severity == glossaryfile.SeverityError {
+			details = append(details, f.Location(issue.Line)+": "+issue.Message)
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return clierror.InvalidFileError{Path: path, Errors: len(details), Details: details}
+}
+
+func toDiffOutput(oldName, newName string, res glossaryfile.DiffResult) (DiffOutput, error) {
+	out := DiffOutput{
+		Old:            oldName,
+		New:            newName,
+		SourceLocaleID: res.SourceLocaleID,
+		Unaligned:      res.Unaligned,
+		Changes:        res.Changes,
+		updates:        res.Updates,
+	}
+	for _, c := range res.Changes {
+		switch c.Change {
+		case glossaryfile.ChangeAdded:
+			out.Added++
+		case glossaryfile.ChangeRemoved:
+			out.Removed++
+		default:
+			out.Changed++
+		}
+	}
+	if out.Changes == nil {
+		out.Changes = []glossaryfile.Change{}
+	}
+	var err error
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return DiffOutput{}, fmt.Errorf("marshal diff to JSON: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,134 @@
+package glossary
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDevserverService returns a service backed by an in-process dev server
+// with one glossary per name, each holding the given CSV content.
+func newDevserverService(t *testing.T, glossaries map[string]string) Service {
+	t.Helper()
+	ctx := t.Context()
+	t.Cleanup(func() { pollingInterval = time.Second })
+	pollingInterval = 0
+
+	server := httptest.NewServer(devserver.NewServer(devserver.Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		ProjectName:    "Project",
+		SourceLocaleID: "en-US",
+	}))
+	t.Cleanup(server.Close)
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret")
+	client.Client.BaseURL = server.URL
+	glossaryApi := glossaryapi.NewGlossary(client.Client)
+	s := NewService(glossaryApi, NewAPI(client.Client))
+
+	for name, content := range glossaries {
+		_, err := glossaryApi.Create(ctx, "account", glossaryapi.CreateGlossaryRequest{
+			GlossaryName: name,
+			LocaleIDs:    []string{"en-US", "de-DE"},
+		})
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), "terms.csv")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		_, err = s.RunImport(ctx, ImportParams{
+			AccountUID:        "account",
+			GlossaryUIDOrName: name,
+			ImportFile:        ImportFile{Path: path, Name: "terms.csv", MediaType: "text/csv"},
+		})
+		require.NoError(t, err)
+	}
+	return s
+}
+
+func TestRunDiff(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Web":    "Definition,Term (en-US),Term (de-DE),DNT (de-DE)\ngreeting,hello,hallo,\nbrand,Smartling,Smartling,true\nfarewell,bye,tschüss,\n",
+		"Mobile": "Definition,Term (en-US),Term (de-DE),DNT (de-DE)\ngreeting,Hello,servus,\nbrand,Smartling,Smartling,true\nthanks,thank you,danke,\n",
+	})
+
+	out, err := s.RunDiff(t.Context(), DiffParams{
+		AccountUID:             "account",
+		GlossaryUIDOrName:      "Web",
+		OtherGlossaryUIDOrName: "Mobile",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "en-US", out.SourceLocaleID)
+	assert.Equal(t, []glossaryfile.Change{
+		{SourceTerm: "hello", LocaleID: "de-DE", Field: glossaryfile.FieldTranslation, Change: glossaryfile.ChangeChanged, Old: "hallo", New: "servus"},
+		{SourceTerm: "bye", LocaleID: "en-US", Field: glossaryfile.FieldTerm, Change: glossaryfile.ChangeRemoved, Old: "bye"},
+		{SourceTerm: "thank you", LocaleID: "en-US", Field: glossaryfile.FieldTerm, Change: glossaryfile.ChangeAdded, New: "thank you"},
+	}, out.Changes)
+	assert.Equal(t, []string{
+		`~ hello [de-DE] translation "hallo" -> "servus"`,
+		`- bye [en-US] term "bye"`,
+		`+ thank you [en-US] term "thank you"`,
+		"Web -> Mobile: 1 added, 1 removed, 1 changed (entries aligned by en-US terms)",
+	}, out.SimpleLines())
+	headers, rows := out.CSVData()
+	assert.Equal(t, []string{"Definition", "Term (en-US)", "DNT (en-US)", "Term (de-DE)", "DNT (de-DE)"}, headers)
+	assert.Equal(t, [][]string{
+		{"greeting", "Hello", "", "servus", ""},
+		{"thanks", "thank you", "", "danke", ""},
+	}, rows)
+
+	path := filepath.Join(t.TempDir(), "terms.csv")
+	require.NoError(t, os.WriteFile(path, []byte("Term (de-DE),Term (en-US)\nhallo,hello\nSmartling,Smartling\ntschüss,bye\n"), 0o600))
+	out, err = s.RunDiff(t.Context(), DiffParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		File:              path,
+		FileFormat:        glossaryfile.FormatCSV,
+		SourceLocaleID:    "de-DE",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "de-DE", out.SourceLocaleID)
+	assert.Empty(t, out.Changes)
+
+	require.NoError(t, os.WriteFile(path, []byte("Term (en-US)\n\"broken\n"), 0o600))
+	_, err = s.RunDiff(t.Context(), DiffParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		File:              path,
+		FileFormat:        glossaryfile.FormatCSV,
+	})
+	var invalid clierror.InvalidFileError
+	assert.ErrorAs(t, err, &invalid)
+}
+
+func TestDiffParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  DiffParams
+		wantErr bool
+	}{
+		{name: "two glossaries", params: DiffParams{AccountUID: "account", GlossaryUIDOrName: "a", OtherGlossaryUIDOrName: "b"}},
+		{name: "glossary and file", params: DiffParams{AccountUID: "account", GlossaryUIDOrName: "a", File: "t.tbx", FileFormat: glossaryfile.FormatTBX}},
+		{name: "missing account", params: DiffParams{GlossaryUIDOrName: "a", OtherGlossaryUIDOrName: "b"}, wantErr: true},
+		{name: "missing other side", params: DiffParams{AccountUID: "account", GlossaryUIDOrName: "a"}, wantErr: true},
+		{name: "both other sides", params: DiffParams{AccountUID: "account", GlossaryUIDOrName: "a", OtherGlossaryUIDOrName: "b", File: "t.csv", FileFormat: glossaryfile.FormatCSV}, wantErr: true},
+		{name: "unknown file format", params: DiffParams{AccountUID: "account", GlossaryUIDOrName: "a", File: "t.dat"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,450 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryresolver"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// entriesPageLimit is the page size used to walk entry search results.
+const entriesPageLimit = 500
+
+// ListEntriesParams carries an entry list or search request from CLI to
+// service. Filter uses the same vocabulary as the export filter.
+type ListEntriesParams struct {
+	AccountUID        uid.AccountUID
+	GlossaryUIDOrName string
+	Filter            ExportFilter
+	// Limit caps the number of entries returned; 0 returns every match.
+	Limit int
+}
+
+// Validate checks that ListEntriesParams carry the required fields.
+func (p ListEntriesParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.GlossaryUIDOrName == "" {
+		return smerror.ErrEmptyParam("GlossaryUIDOrName")
+	}
+	if p.Limit < 0 {
+		return fmt.Errorf("limit must not be negative, got %d", p.Limit)
+	}
+	return nil
+}
+
+// EntriesOutput represents a list of glossary entries.
+type EntriesOutput struct {
+	GlossaryUID string  `json:"glossaryUid"`
+	TotalCount  int     `json:"totalCount"`
+	Entries     []Entry `json:"entries"`
+	JSON        []byte  `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the entries.
+func (o EntriesOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns one line per entry.
+func (o EntriesOutput) SimpleLines() []string {
+	if len(o.Entries) == 0 {
+		return []string{"No entries found."}
+	}
+	lines := make([]string, 0, len(o.Entries)+1)
+	for _, e := range o.Entries {
+		lines = append(lines, fmt.Sprintf("%s  %s", e.EntryUID, termsSummary(e)))
+	}
+	if o.TotalCount > len(o.Entries) {
+		lines = append(lines, fmt.Sprintf("Showing %d of %d entries.", len(o.Entries), o.TotalCount))
+	}
+	return lines
+}
+
+// TableData returns one row per entry.
+func (o EntriesOutput) TableData() ([]string, [][]string) {
+	headers := []string{"ENTRY UID", "STATE", "DEFINITION", "TERMS"}
+	rows := make([][]string, 0, len(o.Entries))
+	for _, e := range o.Entries {
+		rows = append(rows, []string{e.EntryUID, e.EntryState, e.Definition, termsSummary(e)})
+	}
+	return headers, rows
+}
+
+// RunListEntries lists the glossary entries matching the filter, walking
+// every page of results up to the limit.
+func (s service) RunListEntries(ctx context.Context, params ListEntriesParams) (EntriesOutput, error) {
+	if err := params.Validate(); err != nil {
+		return EntriesOutput{}, fmt.Errorf("invalid list entries params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return EntriesOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+
+	out := EntriesOutput{GlossaryUID: glossaryUID, Entries: []Entry{}}
+	filter, err := s.entryFilter(ctx, params.AccountUID, params.Filter)
+	if err != nil {
+		return EntriesOutput{}, err
+	}
+	for {
+		filter.Paging.Offset = len(out.Entries)
+		filter.Paging.Limit = entriesPageLimit
+		if params.Limit > 0 {
+			filter.Paging.Limit = min(entriesPageLimit, params.Limit-len(out.Entries))
+		}
+		page, err := s.glossaryExtApi.SearchEntries(ctx, params.AccountUID, glossaryUID, filter)
+		if err != nil {
+			return EntriesOutput{}, err
+		}
+		out.TotalCount = page.TotalCount
+		out.Entries = append(out.Entries, page.Items...)
+		if len(page.Items) == 0 || len(out.Entries) >= page.TotalCount || len(out.Entries) == params.Limit {
+			break
+		}
+	}
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return EntriesOutput{}, fmt.Errorf("marshal entries to JSON: %w", err)
+	}
+	return out, nil
+}
+
+// EntryParams addresses a single glossary entry.
+type EntryParams struct {
+	AccountUID        uid.AccountUID
+	GlossaryUIDOrName string
+	EntryUID          string
+}
+
+// Validate checks that EntryParams carry the required fields.
+func (p EntryParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.GlossaryUIDOrName == "" {
+		return smerror.ErrEmptyParam("GlossaryUIDOrName")
+	}
+	if p.EntryUID == "" {
+		return smerror.ErrEmptyParam("EntryUID")
+	}
+	return nil
+}
+
+// EntryOutput represents a single glossary entry.
+type EntryOutput struct {
+	GlossaryUID string `json:"glossaryUid"`
+	Entry       Entry  `json:"entry"`
+	JSON        []byte `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the entry.
+func (o EntryOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns the fields of the entry, one per line.
+func (o EntryOutput) SimpleLines() []string {
+	e := o.Entry
+	lines := []string{
+		fmt.Sprintf("Entry UID:      %s", e.EntryUID),
+		fmt.Sprintf("State:          %s", e.EntryState),
+		fmt.Sprintf("Definition:     %s", e.Definition),
+		fmt.Sprintf("Part of speech: %s", e.PartOfSpeech),
+		fmt.Sprintf("Labels:         %s", strings.Join(e.LabelUIDs, ", ")),
+	}
+	for _, t := range e.Translations {
+		line := fmt.Sprintf("  %s: %s", t.LocaleID, t.Term)
+		if t.DoNotTranslate {
+			line += " (DNT)"
+		}
+		if t.Notes != "" {
+			line += " — " + t.Notes
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// TableData returns one row per translation of the entry.
+func (o EntryOutput) TableData() ([]string, [][]string) {
+	headers := []string{"ENTRY UID", "LOCALE", "TERM", "DNT", "NOTES"}
+	rows := make([][]string, 0, len(o.Entry.Translations))
+	for _, t := range o.Entry.Translations {
+		rows = append(rows, []string{o.Entry.EntryUID, t.LocaleID, t.Term, fmt.Sprint(t.DoNotTranslate), t.Notes})
+	}
+	return headers, rows
+}
+
+// RunGetEntry returns a glossary entry.
+func (s service) RunGetEntry(ctx context.Context, params EntryParams) (EntryOutput, error) {
+	if err := params.Validate(); err != nil {
+		return EntryOutput{}, fmt.Errorf("invalid entry params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return EntryOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	entry, err := s.glossaryExtApi.GetEntry(ctx, params.AccountUID, glossaryUID, params.EntryUID)
+	if err != nil {
+		return EntryOutput{}, err
+	}
+	return toEntryOutput(glossaryUID, entry)
+}
+
+// EntryChanges lists the fields to set on an entry. Nil fields, and locales
+// missing from the maps, are left as they are; an empty term removes the
+// translation of its locale.
+type EntryChanges struct {
+	Definition   *string
+	PartOfSpeech *string
+	LabelUIDs    []string
+	Terms        map[string]string
+	Notes        map[string]string
+	DNT          map[string]bool
+}
+
+// empty reports whether the changes would leave an entry unchanged.
+func (c EntryChanges) empty() bool {
+	return c.Definition == nil && c.PartOfSpeech == nil && c.LabelUIDs == nil &&
+		len(c.Terms) == 0 && len(c.Notes) == 0 && len(c.DNT) == 0
+}
+
+// apply returns the request which stores the entry with the changes.
+func (c EntryChanges) apply(entry Entry) (EntryRequest, error) {
+	req := EntryRequest{
+		Definition:   entry.Definition,
+		PartOfSpeech: entry.PartOfSpeech,
+		LabelUIDs:    entry.LabelUIDs,
+	}
+	if c.Definition != nil {
+		req.Definition = *c.Definition
+	}
+	if c.PartOfSpeech != nil {
+		req.PartOfSpeech = *c.PartOfSpeech
+	}
+	if c.LabelUIDs != nil {
+		req.LabelUIDs = c.LabelUIDs
+	}
+	if req.LabelUIDs == nil {
+		req.LabelUIDs = []string{}
+	}
+
+	translations := slices.Clone(entry.Translations)
+	find := func(localeID string) int {
+		return slices.IndexFunc(translations, func(t EntryTranslation) bool { return t.LocaleID == localeID })
+	}
+	for _, localeID := range slices.Sorted(maps.Keys(c.Terms)) {
+		i := find(localeID)
+		switch {
+		case c.Terms[localeID] == "" && i >= 0:
+			translations = slices.Delete(translations, i, i+1)
+		case c.Terms[localeID] == "":
+		case i >= 0:
+			translations[i].Term = c.Terms[localeID]
+		default:
+			translations = append(translations, EntryTranslation{LocaleID: localeID, Term: c.Terms[localeID]})
+		}
+	}
+	for _, localeID := range slices.Sorted(maps.Keys(c.Notes)) {
+		i := find(localeID)
+		if i < 0 {
+			return EntryRequest{}, fmt.Errorf("notes for %s: the entry has no %s term", localeID, localeID)
+		}
+		translations[i].Notes = c.Notes[localeID]
+	}
+	for _, localeID := range slices.Sorted(maps.Keys(c.DNT)) {
+		i := find(localeID)
+		if i < 0 {
+			return EntryRequest{}, fmt.Errorf("DNT for %s: the entry has no %s term", localeID, localeID)
+		}
+		translations[i].DoNotTranslate = c.DNT[localeID]
+	}
+	if len(translations) == 0 {
+		return EntryRequest{}, fmt.Errorf("an entry needs at least one term")
+	}
+	req.Translations = translations
+	return req, nil
+}
+
+// AddEntryParams carries an entry create request.
+type AddEntryParams struct {
+	AccountUID        uid.AccountUID
+	GlossaryUIDOrName string
+	Changes           EntryChanges
+}
+
+// Validate checks that AddEntryParams carry the required fields.
+func (p AddEntryParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.GlossaryUIDOrName == "" {
+		return smerror.ErrEmptyParam("GlossaryUIDOrName")
+	}
+	if len(p.Changes.Terms) == 0 {
+		return smerror.ErrEmptyParam("Terms")
+	}
+	return nil
+}
+
+// RunAddEntry adds an entry to the glossary.
+func (s service) RunAddEntry(ctx context.Context, params AddEntryParams) (EntryOutput, error) {
+	if err := params.Validate(); err != nil {
+		return EntryOutput{}, fmt.Errorf("invalid add entry params: %w", err)
+	}
+	req, err := params.Changes.apply(Entry{})
+	if err != nil {
+		return EntryOutput{}, fmt.Errorf("invalid add entry params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return EntryOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	entry, err := s.glossaryExtApi.CreateEntry(ctx, params.AccountUID, glossaryUID, req)
+	if err != nil {
+		return EntryOutput{}, err
+	}
+	return toEntryOutput(glossaryUID, entry)
+}
+
+// UpdateEntryParams carries an entry update request.
+type UpdateEntryParams struct {
+	EntryParams
+	Changes EntryChanges
+}
+
+// Validate checks that UpdateEntryParams carry the required fields.
+func (p UpdateEntryParams) Validate() error {
+	if err := p.EntryParams.Validate(); err != nil {
+		return err
+	}
+	if p.Changes.empty() {
+		return fmt.Errorf("nothing to update: set a definition, part of speech, labels, terms, notes or DNT flags")
+	}
+	return nil
+}
+
+// RunUpdateEntry reads the entry, applies the changes and stores it.
+func (s service) RunUpdateEntry(ctx context.Context, params UpdateEntryParams) (EntryOutput, error) {
+	if err := params.Validate(); err != nil {
+		return EntryOutput{}, fmt.Errorf("invalid update entry params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return EntryOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	entry, err := s.glossaryExtApi.GetEntry(ctx, params.AccountUID, glossaryUID, params.EntryUID)
+	if err != nil {
+		return EntryOutput{}, err
+	}
+	req, err := params.Changes.apply(entry)
+	if err != nil {
+		return EntryOutput{}, fmt.Errorf("invalid update entry params: %w", err)
+	}
+	entry, err = s.glossaryExtApi.UpdateEntry(ctx, params.AccountUID, glossaryUID, params.EntryUID, req)
+	if err != nil {
+		return EntryOutput{}, err
+	}
+	return toEntryOutput(glossaryUID, entry)
+}
+
+// ArchiveEntriesParams carries an entry archive request.
+type ArchiveEntriesParams struct {
+	AccountUID        uid.AccountUID
+	GlossaryUIDOrName string
+	EntryUIDs         []string
+}
+
+// Validate checks that ArchiveEntriesParams carry the required fields.
+func (p ArchiveEntriesParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.GlossaryUIDOrName == "" {
+		return smerror.ErrEmptyParam("GlossaryUIDOrName")
+	}
+	if len(p.EntryUIDs) == 0 {
+		return smerror.ErrEmptyParam("EntryUIDs")
+	}
+	return nil
+}
+
+// ArchiveEntriesOutput represents the result of an entry archive.
+type ArchiveEntriesOutput struct {
+	GlossaryUID string   `json:"glossaryUid"`
+	EntryUIDs   []string `json:"archivedEntryUids"`
+	JSON        []byte   `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the archive result.
+func (o ArchiveEntriesOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns one line per archived entry.
+func (o ArchiveEntriesOutput) SimpleLines() []string {
+	lines := make([]string, 0, len(o.EntryUIDs))
+	for _, entryUID := range o.EntryUIDs {
+		lines = append(lines, "Archived entry "+entryUID)
+	}
+	return lines
+}
+
+// TableData returns one row per archived entry.
+func (o ArchiveEntriesOutput) TableData() ([]string, [][]string) {
+	headers := []string{"GLOSSARY UID", "ENTRY UID"}
+	rows := make([][]string, 0, len(o.EntryUIDs))
+	for _, entryUID := range o.EntryUIDs {
+		rows = append(rows, []string{o.GlossaryUID, entryUID})
+	}
+	return headers, rows
+}
+
+// RunArchiveEntries archives glossary entries. Every entry is read first so
+// that an unknown entry UID fails the call before anything is archived.
+func (s service) RunArchiveEntries(ctx context.Context, params ArchiveEntriesParams) (ArchiveEntriesOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ArchiveEntriesOutput{}, fmt.Errorf("invalid archive entries params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return ArchiveEntriesOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	for _, entryUID := range params.EntryUIDs {
+		if _, err := s.glossaryExtApi.GetEntry(ctx, params.AccountUID, glossaryUID, entryUID); err != nil {
+			return ArchiveEntriesOutput{}, fmt.Errorf("entry %s: %w", entryUID, err)
+		}
+	}
+	if err := s.glossaryExtApi.ArchiveEntries(ctx, params.AccountUID, glossaryUID, params.EntryUIDs); err != nil {
+		return ArchiveEntriesOutput{}, err
+	}
+	out := ArchiveEntriesOutput{GlossaryUID: glossaryUID, EntryUIDs: params.EntryUIDs}
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return ArchiveEntriesOutput{}, fmt.Errorf("marshal archive result to JSON: %w", err)
+	}
+	return out, nil
+}
+
+// termsSummary renders the terms of an entry on one line.
+func termsSummary(e Entry) string {
+	terms := make([]string, 0, len(e.Translations))
+	for _, t := range e.Translations {
+		term := t.LocaleID + ": " + t.Term
+		if t.DoNotTranslate {
+			term += " (DNT)"
+		}
+		terms = append(terms, term)
+	}
+	return strings.Join(terms, " | ")
+}
+
+func toEntryOutput(glossaryUID string, entry Entry) (EntryOutput, error) {
+	out := EntryOutput{GlossaryUID: glossaryUID, Entry: entry}
+	var err error
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return EntryOutput{}, fmt.Errorf("marshal entry to JSON: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,140 @@
+package glossary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunEntries(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Web": "Definition,Term (en-US),Term (de-DE)\ngreeting,hello,hallo\nfarewell,bye,\nthanks,thank you,danke\n",
+	})
+	ctx := t.Context()
+
+	list, err := s.RunListEntries(ctx, ListEntriesParams{AccountUID: "account", GlossaryUIDOrName: "Web"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, list.TotalCount)
+	require.Len(t, list.Entries, 3)
+	assert.Contains(t, string(list.JSON), `"totalCount":3`)
+
+	list, err = s.RunListEntries(ctx, ListEntriesParams{AccountUID: "account", GlossaryUIDOrName: "Web", Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, list.Entries, 2)
+	assert.Equal(t, "Showing 2 of 3 entries.", list.SimpleLines()[2])
+
+	search, err := s.RunListEntries(ctx, ListEntriesParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		Filter:            ExportFilter{Query: "HAL"},
+	})
+	require.NoError(t, err)
+	require.Len(t, search.Entries, 1)
+	hello := search.Entries[0]
+	assert.Equal(t, "greeting", hello.Definition)
+
+	missing, err := s.RunListEntries(ctx, ListEntriesParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		Filter:            ExportFilter{MissingTranslationLocaleID: "de-DE"},
+	})
+	require.NoError(t, err)
+	require.Len(t, missing.Entries, 1)
+	assert.Equal(t, "en-US: bye", termsSummary(missing.Entries[0]))
+
+	definition := "a salutation"
+	updated, err := s.RunUpdateEntry(ctx, UpdateEntryParams{
+		EntryParams: EntryParams{AccountUID: "account", GlossaryUIDOrName: "Web", EntryUID: hello.EntryUID},
+		Changes: EntryChanges{
+			Definition: &definition,
+			Terms:      map[string]string{"de-DE": "servus"},
+			DNT:        map[string]bool{"de-DE": true},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "a salutation", updated.Entry.Definition)
+	assert.Equal(t, "en-US: hello | de-DE: servus (DNT)", termsSummary(updated.Entry))
+
+	got, err := s.RunGetEntry(ctx, EntryParams{AccountUID: "account", GlossaryUIDOrName: "Web", EntryUID: hello.EntryUID})
+	require.NoError(t, err)
+	assert.Equal(t, updated.Entry.Translations, got.Entry.Translations)
+
+	added, err := s.RunAddEntry(ctx, AddEntryParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		Changes:           EntryChanges{Terms: map[string]string{"en-US": "checkout", "de-DE": "Kasse"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "de-DE: Kasse | en-US: checkout", termsSummary(added.Entry), "new terms are added in locale order")
+	assert.NotEmpty(t, added.Entry.EntryUID)
+
+	_, err = s.RunArchiveEntries(ctx, ArchiveEntriesParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		EntryUIDs:         []string{hello.EntryUID, "unknown"},
+	})
+	assert.ErrorIs(t, err, ErrEntryNotFound)
+
+	archived, err := s.RunArchiveEntries(ctx, ArchiveEntriesParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		EntryUIDs:         []string{hello.EntryUID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Archived entry " + hello.EntryUID}, archived.SimpleLines())
+
+	list, err = s.RunListEntries(ctx, ListEntriesParams{AccountUID: "account", GlossaryUIDOrName: "Web"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, list.TotalCount)
+	list, err = s.RunListEntries(ctx, ListEntriesParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		Filter:            ExportFilter{EntryState: "ARCHIVED"},
+	})
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 1)
+	assert.Equal(t, hello.EntryUID, list.Entries[0].EntryUID)
+
+	_, err = s.RunGetEntry(ctx, EntryParams{AccountUID: "account", GlossaryUIDOrName: "Web", EntryUID: "unknown"})
+	assert.ErrorIs(t, err, ErrEntryNotFound)
+}
+
+func TestEntryChanges_apply(t *testing.T) {
+	entry := Entry{
+		Definition: "greeting",
+		LabelUIDs:  []string{"l1"},
+		Translations: []EntryTranslation{
+			{LocaleID: "en-US", Term: "hello"},
+			{LocaleID: "de-DE", Term: "hallo", Notes: "informal"},
+		},
+	}
+
+	pos := "NOUN"
+	req, err := EntryChanges{
+		PartOfSpeech: &pos,
+		Terms:        map[string]string{"de-DE": "", "fr-FR": "bonjour"},
+		Notes:        map[string]string{"fr-FR": "formal"},
+	}.apply(entry)
+	require.NoError(t, err)
+	assert.Equal(t, EntryRequest{
+		Definition:   "greeting",
+		PartOfSpeech: "NOUN",
+		LabelUIDs:    []string{"l1"},
+		Translations: []EntryTranslation{
+			{LocaleID: "en-US", Term: "hello"},
+			{LocaleID: "fr-FR", Term: "bonjour", Notes: "formal"},
+		},
+	}, req)
+	assert.Len(t, entry.Translations, 2, "apply must not modify the entry")
+
+	req, err = EntryChanges{LabelUIDs: []string{}}.apply(entry)
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, req.LabelUIDs)
+
+	_, err = EntryChanges{DNT: map[string]bool{"es-ES": true}}.apply(entry)
+	assert.EqualError(t, err, "DNT for es-ES: the entry has no es-ES term")
+
+	_, err = EntryChanges{Terms: map[string]string{"en-US": "", "de-DE": ""}}.apply(entry)
+	assert.EqualError(t, err, "an entry needs at least one term")
+}
@@ -55,7 +55,20 @@ func (s service) RunExport(ctx context.Context, params ExportParams) (ExportOutp
 		params.LocaleIDs = gl.LocaleIDs
 	}
 
-	resp, err := s.glossaryApi.Export(ctx, params.AccountUID, glossaryUID, toApiExportGlossaryRequest(params))
+	// The SDK export filter has no label UIDs, so label filters go through
+	// the extension API.
+	req := toApiExportGlossaryRequest(params)
+	var resp api.ExportGlossaryResponse
+	if len(params.Filter.Labels) > 0 {
+		filter, err := s.entryFilter(ctx, params.AccountUID, params.Filter)
+		if err != nil {
+			return ExportOutput{}, err
+		}
+		filter.Paging = req.Filter.Paging
+		resp, err = s.glossaryExtApi.ExportEntries(ctx, params.AccountUID, glossaryUID, ExportRequest{ExportGlossaryRequest: req, Filter: filter})
+	} else {
+		resp, err = s.glossaryApi.Export(ctx, params.AccountUID, glossaryUID, req)
+	}
 	if err != nil {
 		return ExportOutput{}, fmt.Errorf("failed to get api export glossary: %w", err)
 	}
@@ -131,6 +144,10 @@ type ExportFilter struct {
 	LastModified               LastModified
 	CreatedBy                  CreatedBy
 	Created                    Created
+
+	// Labels are the names or UIDs of the labels to match; the API takes
+	// label UIDs, so names are looked up first.
+	Labels []string
 }
 
 // Created filters entries by creation.
@@ -239,52 +256,77 @@ func toApiExportGlossaryRequest(params ExportParams) api.ExportGlossaryRequest {
 		FocusLocaleId: params.FocusLocaleID,
 		LocaleIds:     params.LocaleIDs,
 		SkipEntries:   params.SkipEntries,
+		Filter:        toApiExportFilter(params.Filter),
 	}
+	// limit=0 means "return 0 entries" on Smartling's pagination
+	req.Filter.Paging.Limit = defaultExportPageLimit
 
-	f := params.Filter
-	req.Filter.Query = f.Query
-	req.Filter.LocaleIds = f.LocaleID
-	req.Filter.EntryUids = f.EntryUIDs
-	req.Filter.EntryState = f.EntryState
-	req.Filter.MissingTranslationLocaleId = f.MissingTranslationLocaleID
-	req.Filter.PresentTranslationLocaleId = f.PresentTranslationLocaleID
-	req.Filter.DntLocaleId = f.DntLocaleID
-	req.Filter.ReturnFallbackTranslations = f.ReturnFallbackTranslations
-	req.Filter.DntTermSet = f.DntTermSet
+	return req
+}
+
+// toApiExportFilter maps the filter to the `filter` object shared by the
+// export and entry search endpoints.
+func toApiExportFilter(f ExportFilter) api.ExportGlossaryFilter {
+	filter := api.ExportGlossaryFilter{
+		Query:                      f.Query,
+		LocaleIds:                  f.LocaleID,
+		EntryUids:                  f.EntryUIDs,
+		EntryState:                 f.EntryState,
+		MissingTranslationLocaleId: f.MissingTranslationLocaleID,
+		PresentTranslationLocaleId: f.PresentTranslationLocaleID,
+		DntLocaleId:                f.DntLocaleID,
+		ReturnFallbackTranslations: f.ReturnFallbackTranslations,
+		DntTermSet:                 f.DntTermSet,
+	}
 
 	if f.LabelsType != "" {
-		req.Filter.Labels = &api.ExportGlossaryLabelsFilter{Type: f.LabelsType}
+		filter.Labels = &api.ExportGlossaryLabelsFilter{Type: f.LabelsType}
 	}
 	if f.Created.Level != "" || f.Created.Type != "" {
-		req.Filter.Created = &api.ExportGlossaryDateFilter{
+		filter.Created = &api.ExportGlossaryDateFilter{
 			Level: f.Created.Level,
 			Type:  f.Created.Type,
 			Date:  f.Created.Date,
 		}
 	}
 	if f.LastModified.Level != "" || f.LastModified.Type != "" {
-		req.Filter.LastModified = &api.ExportGlossaryDateFilter{
+		filter.LastModified = &api.ExportGlossaryDateFilter{
 			Level: f.LastModified.Level,
 			Type:  f.LastModified.Type,
 			Date:  f.LastModified.Date,
 		}
 	}
 	if f.CreatedBy.Level != "" || len(f.CreatedBy.UserIDs) > 0 {
-		req.Filter.CreatedBy = &api.ExportGlossaryUserFilter{
+		filter.CreatedBy = &api.ExportGlossaryUserFilter{
 			Level:   f.CreatedBy.Level,
 			UserIds: f.CreatedBy.UserIDs,
 		}
 	}
 	if f.LastModifiedBy.Level != "" || len(f.LastModifiedBy.UserIDs) > 0 {
-		req.Filter.LastModifiedBy = &api.ExportGlossaryUserFilter{
+		filter.LastModifiedBy = &api.ExportGlossaryUserFilter{
 			Level:   f.LastModifiedBy.Level,
 			UserIds: f.LastModifiedBy.UserIDs,
 		}
 	}
-	// limit=0 means "return 0 entries" on Smartling's pagination
-	req.Filter.Paging.Limit = defaultExportPageLimit
+	return filter
+}
 
-	return req
+// entryFilter maps the filter to the `filter` object of the extension API,
+// resolving label names to label UIDs.
+func (s service) entryFilter(ctx context.Context, accountUID uid.AccountUID, f ExportFilter) (EntryFilter, error) {
+	filter := EntryFilter{ExportGlossaryFilter: toApiExportFilter(f)}
+	filter.ExportGlossaryFilter.Labels = nil
+	if f.LabelsType == "" && len(f.Labels) == 0 {
+		return filter, nil
+	}
+	filter.Labels = &LabelsFilter{Type: f.LabelsType}
+	if len(f.Labels) > 0 {
+		var err error
+		if filter.Labels.LabelUIDs, err = s.labelUIDs(ctx, accountUID, f.Labels); err != nil {
+			return EntryFilter{}, err
+		}
+	}
+	return filter, nil
 }
 
 func toExportOutput(glossaryUID, outFile, fileType string, resp api.ExportGlossaryResponse, bytesWritten uint64) ExportOutput {
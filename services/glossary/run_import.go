@@ -8,7 +8,9 @@ import (
 	"os"
 	"time"
 
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
 	"github.com/Smartling/smartling-cli/services/glossary/glossaryresolver"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	api "github.com/Smartling/api-sdk-go/api/glossary"
@@ -28,6 +30,14 @@ type ImportParams struct {
 	AccountUID        uid.AccountUID
 	ArchiveMode       bool
 	ImportFile        ImportFile
+	// Preview uploads the file but leaves the import pending, so its changes
+	// can be reviewed before RunImportConfirm or RunImportCancel.
+	Preview bool
+	// SkipValidation uploads the file without checking it locally first.
+	SkipValidation bool
+	// Labels are the names or UIDs of labels added to every entry of the
+	// file. TBX files have no labels and cannot be labeled.
+	Labels []string
 }
 
 // Validate enforces the fields required by the Smartling Glossary Import API.
@@ -47,6 +57,15 @@ func (p ImportParams) Validate() error {
 	if p.ImportFile.MediaType == "" {
 		return smerror.ErrEmptyParam("ImportFile.MediaType")
 	}
+	if len(p.Labels) > 0 {
+		switch glossaryfile.FormatFromMediaType(p.ImportFile.MediaType) {
+		case glossaryfile.FormatCSV, glossaryfile.FormatXLSX:
+		case glossaryfile.FormatTBX:
+			return clierror.ErrIncompatibleParams("labels", []string{"TBX import file"})
+		default:
+			return fmt.Errorf("labels need a CSV or XLSX import file, not %q", p.ImportFile.MediaType)
+		}
+	}
 	return nil
 }
 
@@ -60,13 +79,15 @@ type ImportFile struct {
 
 // ImportOutput represents the result of a glossary import.
 type ImportOutput struct {
-	GlossaryUID  string
-	ImportUID    string
-	ImportStatus string
-	SourceFile   string
-	EntryChanges api.ImportEntryChanges
-	Warnings     []api.ImportWarning
-	JSON         []byte
+	GlossaryUID        string
+	ImportUID          string
+	ImportStatus       string
+	SourceFile         string
+	Preview            bool
+	EntryChanges       api.ImportEntryChanges
+	TranslationChanges []api.ImportTranslationChanges
+	Warnings           []api.ImportWarning
+	JSON               []byte
 }
 
 // JSONBytes returns the raw JSON payload of the import response.
@@ -84,9 +105,20 @@ func (p ImportOutput) SimpleLines() []string {
 		fmt.Sprintf("Not matched entries:   %d", p.EntryChanges.NotMatchedEntries),
 		fmt.Sprintf("Entries to archive:    %d", p.EntryChanges.EntriesToArchive),
 	}
+	for _, t := range p.TranslationChanges {
+		lines = append(lines, fmt.Sprintf("Translations [%s]: %d new, %d updated, %d to remove",
+			t.LocaleID, t.NewTranslations, t.UpdatedTranslations, t.TranslationsToRemove))
+	}
 	for _, w := range p.Warnings {
 		lines = append(lines, fmt.Sprintf("Warning [%s]: %s", w.Key, w.Message))
 	}
+	if p.Preview {
+		lines = append(lines,
+			"Preview only: the glossary is unchanged until the import is confirmed.",
+			fmt.Sprintf("  apply:   smartling-cli glossaries import confirm %s --glossary %s", p.ImportUID, p.GlossaryUID),
+			fmt.Sprintf("  discard: smartling-cli glossaries import cancel %s --glossary %s", p.ImportUID, p.GlossaryUID),
+		)
+	}
 	return lines
 }
 
@@ -124,37 +156,115 @@ func (s service) RunImport(ctx context.Context, params ImportParams) (ImportOutp
 	if err != nil {
 		return ImportOutput{}, fmt.Errorf("failed to build import glossary request: %w", err)
 	}
+	if !params.SkipValidation {
+		if err := s.validateImportFile(ctx, params, glossaryUID, apiImportGlossaryRequest.File); err != nil {
+			return ImportOutput{}, err
+		}
+	}
+	if len(params.Labels) > 0 {
+		if apiImportGlossaryRequest.File, err = s.labelImportFile(ctx, params, apiImportGlossaryRequest.File); err != nil {
+			return ImportOutput{}, err
+		}
+	}
 	importGlossaryResponse, err := s.glossaryApi.Import(ctx, params.AccountUID, glossaryUID, apiImportGlossaryRequest)
 	if err != nil {
 		return ImportOutput{}, fmt.Errorf("failed to run glossary import: %w", err)
 	}
-	importConfirmed, err := s.glossaryApi.ImportConfirm(ctx, params.AccountUID, glossaryUID, importGlossaryResponse.ImportUID)
+	if params.Preview {
+		return toImportOutput(glossaryUID, params.ImportFile.Path, true, importGlossaryResponse), nil
+	}
+	finalResponse := importGlossaryResponse
+	finalResponse.ImportStatus, err = s.confirmImport(ctx, params.AccountUID, glossaryUID, importGlossaryResponse.ImportUID)
+	if err != nil {
+		return ImportOutput{}, err
+	}
+	return toImportOutput(glossaryUID, params.ImportFile.Path, false, finalResponse), nil
+}
+
+// validateImportFile runs the checks of RunValidate against the glossary the
+// file is imported into. Files of unknown media type are left to the server.
+func (s service) validateImportFile(ctx context.Context, params ImportParams, glossaryUID string, data []byte) error {
+	format := glossaryfile.FormatFromMediaType(params.ImportFile.MediaType)
+	if format == "" {
+		rlog.Debugf("skipping validation of %s: unknown media type %q", params.ImportFile.Path, params.ImportFile.MediaType)
+		return nil
+	}
+	gl, err := s.glossaryApi.Get(ctx, params.AccountUID, glossaryUID)
+	if err != nil {
+		return fmt.Errorf("get glossary %q: %w", glossaryUID, err)
+	}
+	f, err := glossaryfile.Parse(data, format)
+	if err != nil {
+		return err
+	}
+	out, err := validateFile(params.ImportFile.Path, f, glossaryUID, append([]string{}, gl.LocaleIDs...))
+	var invalid clierror.InvalidFileError
+	if errors.As(err, &invalid) {
+		invalid.Details = out.details()
+		return invalid
+	}
+	if err != nil {
+		return err
+	}
+	for i, issue := range out.Issues {
+		rlog.Infof("%s:%s: %s: %s", out.File, out.locations[i], issue.Severity, issue.Message)
+	}
+	return nil
+}
+
+// labelImportFile adds the labels to the Labels column of every entry of a
+// CSV or XLSX import file, keeping all other columns of the file.
+func (s service) labelImportFile(ctx context.Context, params ImportParams, data []byte) ([]byte, error) {
+	labels, err := s.findLabels(ctx, params.AccountUID, params.Labels)
+	if err != nil {
+		return nil, err
+	}
+	format := glossaryfile.FormatFromMediaType(params.ImportFile.MediaType)
+	f, err := glossaryfile.Parse(data, format)
 	if err != nil {
-		return ImportOutput{}, fmt.Errorf("failed to confirm glossary import: %w", err)
+		return nil, err
+	}
+	if errs := glossaryfile.Errors(f.Issues); errs > 0 {
+		return nil, fmt.Errorf("cannot add labels to %s: it has %d error(s), see \"glossaries validate\"", params.ImportFile.Path, errs)
+	}
+	names := make([]string, 0, len(labels))
+	for _, l := range labels {
+		names = append(names, l.LabelName)
+	}
+	labeled, err := glossaryfile.AddLabels(data, format, names)
+	if err != nil {
+		return nil, fmt.Errorf("add labels to %s: %w", params.ImportFile.Path, err)
+	}
+	return labeled, nil
+}
+
+// confirmImport confirms a pending import and polls its status until it
+// succeeds or fails. It returns the final import status.
+func (s service) confirmImport(ctx context.Context, accountUID uid.AccountUID, glossaryUID, importUID string) (string, error) {
+	importConfirmed, err := s.glossaryApi.ImportConfirm(ctx, accountUID, glossaryUID, importUID)
+	if err != nil {
+		return "", fmt.Errorf("failed to confirm glossary import: %w", err)
 	}
 	if !importConfirmed {
-		return ImportOutput{}, errImportConfirmationFailed
+		return "", errImportConfirmationFailed
 	}
-	finalResponse := importGlossaryResponse
 	for {
-		importStatusResponse, err := s.glossaryApi.ImportStatus(ctx, params.AccountUID, glossaryUID, importGlossaryResponse.ImportUID)
+		importStatusResponse, err := s.glossaryApi.ImportStatus(ctx, accountUID, glossaryUID, importUID)
 		if err != nil {
-			return ImportOutput{}, fmt.Errorf("failed to get glossary import status: %w", err)
-		}
-		if importStatusResponse.ImportStatus == api.FailedImportStatus {
-			return ImportOutput{}, errFailedImport
+			return "", fmt.Errorf("failed to get glossary import status: %w", err)
 		}
-		if importStatusResponse.ImportStatus == api.SuccessfulImportStatus {
-			finalResponse.ImportStatus = importStatusResponse.ImportStatus
-			break
+		switch importStatusResponse.ImportStatus {
+		case api.FailedImportStatus:
+			return "", errFailedImport
+		case api.SuccessfulImportStatus:
+			return importStatusResponse.ImportStatus, nil
 		}
 		select {
 		case <-ctx.Done():
-			return ImportOutput{}, ctx.Err()
+			return "", ctx.Err()
 		case <-time.After(pollingInterval):
 		}
 	}
-	return toImportOutput(glossaryUID, params.ImportFile.Path, finalResponse), nil
 }
 
 func toApiImportGlossaryRequest(params ImportParams) (api.ImportGlossaryRequest, error) {
@@ -170,30 +280,36 @@ func toApiImportGlossaryRequest(params ImportParams) (api.ImportGlossaryRequest,
 	}, nil
 }
 
-func toImportOutput(glossaryUID, sourceFile string, resp api.ImportGlossaryResponse) ImportOutput {
+func toImportOutput(glossaryUID, sourceFile string, preview bool, resp api.ImportGlossaryResponse) ImportOutput {
 	res := ImportOutput{
-		GlossaryUID:  glossaryUID,
-		ImportUID:    resp.ImportUID,
-		ImportStatus: resp.ImportStatus,
-		SourceFile:   sourceFile,
-		EntryChanges: resp.EntryChanges,
-		Warnings:     resp.Warnings,
+		GlossaryUID:        glossaryUID,
+		ImportUID:          resp.ImportUID,
+		ImportStatus:       resp.ImportStatus,
+		SourceFile:         sourceFile,
+		Preview:            preview,
+		EntryChanges:       resp.EntryChanges,
+		TranslationChanges: resp.TranslationChanges,
+		Warnings:           resp.Warnings,
 	}
 
 	summary := struct {
-		GlossaryUID  string                 `json:"glossaryUid"`
-		ImportUID    string                 `json:"importUid"`
-		ImportStatus string                 `json:"importStatus"`
-		SourceFile   string                 `json:"sourceFile"`
-		EntryChanges api.ImportEntryChanges `json:"entryChanges"`
-		Warnings     []api.ImportWarning    `json:"warnings,omitempty"`
+		GlossaryUID        string                         `json:"glossaryUid"`
+		ImportUID          string                         `json:"importUid"`
+		ImportStatus       string                         `json:"importStatus"`
+		SourceFile         string                         `json:"sourceFile"`
+		Preview            bool                           `json:"preview,omitempty"`
+		EntryChanges       api.ImportEntryChanges         `json:"entryChanges"`
+		TranslationChanges []api.ImportTranslationChanges `json:"translationChanges,omitempty"`
+		Warnings           []api.ImportWarning            `json:"warnings,omitempty"`
 	}{
-		GlossaryUID:  glossaryUID,
-		ImportUID:    resp.ImportUID,
-		ImportStatus: resp.ImportStatus,
-		SourceFile:   sourceFile,
-		EntryChanges: resp.EntryChanges,
-		Warnings:     resp.Warnings,
+		GlossaryUID:        glossaryUID,
+		ImportUID:          resp.ImportUID,
+		ImportStatus:       resp.ImportStatus,
+		SourceFile:         sourceFile,
+		Preview:            preview,
+		EntryChanges:       resp.EntryChanges,
+		TranslationChanges: resp.TranslationChanges,
+		Warnings:           resp.Warnings,
 	}
 	b, err := json.Marshal(summary)
 	if err != nil {
@@ -0,0 +1,106 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryresolver"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// CanceledImportStatus is reported for an import discarded by RunImportCancel.
+const CanceledImportStatus = "CANCELED"
+
+// ImportActionParams addresses a pending import to confirm or cancel.
+type ImportActionParams struct {
+	AccountUID        uid.AccountUID
+	GlossaryUIDOrName string
+	ImportUID         string
+}
+
+// Validate enforces the fields required to address a glossary import.
+func (p ImportActionParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.GlossaryUIDOrName == "" {
+		return smerror.ErrEmptyParam("GlossaryUIDOrName")
+	}
+	if p.ImportUID == "" {
+		return smerror.ErrEmptyParam("ImportUID")
+	}
+	return nil
+}
+
+// ImportActionOutput represents the result of confirming or canceling an import.
+type ImportActionOutput struct {
+	GlossaryUID  string `json:"glossaryUid"`
+	ImportUID    string `json:"importUid"`
+	ImportStatus string `json:"importStatus"`
+	JSON         []byte `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the output.
+func (p ImportActionOutput) JSONBytes() []byte { return p.JSON }
+
+// SimpleLines returns a human-readable summary of the import.
+func (p ImportActionOutput) SimpleLines() []string {
+	return []string{
+		fmt.Sprintf("Glossary UID:  %s", p.GlossaryUID),
+		fmt.Sprintf("Import UID:    %s", p.ImportUID),
+		fmt.Sprintf("Import status: %s", p.ImportStatus),
+	}
+}
+
+// TableData returns the import as a single-row table.
+func (p ImportActionOutput) TableData() ([]string, [][]string) {
+	return []string{"GLOSSARY UID", "IMPORT UID", "STATUS"},
+		[][]string{{p.GlossaryUID, p.ImportUID, p.ImportStatus}}
+}
+
+// RunImportConfirm confirms an import left pending by a preview and waits
+// until the glossary is updated.
+func (s service) RunImportConfirm(ctx context.Context, params ImportActionParams) (ImportActionOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ImportActionOutput{}, fmt.Errorf("invalid import confirm params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return ImportActionOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	status, err := s.confirmImport(ctx, params.AccountUID, glossaryUID, params.ImportUID)
+	if err != nil {
+		return ImportActionOutput{}, err
+	}
+	return toImportActionOutput(glossaryUID, params.ImportUID, status), nil
+}
+
+// RunImportCancel discards an import left pending by a preview.
+func (s service) RunImportCancel(ctx context.Context, params ImportActionParams) (ImportActionOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ImportActionOutput{}, fmt.Errorf("invalid import cancel params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return ImportActionOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	if err := s.glossaryExtApi.ImportCancel(ctx, params.AccountUID, glossaryUID, params.ImportUID); err != nil {
+		return ImportActionOutput{}, err
+	}
+	return toImportActionOutput(glossaryUID, params.ImportUID, CanceledImportStatus), nil
+}
+
+func toImportActionOutput(glossaryUID, importUID, status string) ImportActionOutput {
+	res := ImportActionOutput{GlossaryUID: glossaryUID, ImportUID: importUID, ImportStatus: status}
+	b, err := json.Marshal(res)
+	if err != nil {
+		rlog.Errorf("failed to marshal import output to JSON: %v", err)
+		return res
+	}
+	res.JSON = b
+	return res
+}
@@ -0,0 +1,103 @@
+package glossary
+
+import (
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	rlog.Init()
+	os.Exit(m.Run())
+}
+
+func TestImportPreview_ConfirmAndCancel(t *testing.T) {
+	ctx := t.Context()
+	t.Cleanup(func() { pollingInterval = time.Second })
+	pollingInterval = 0
+
+	server := httptest.NewServer(devserver.NewServer(devserver.Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		ProjectName:    "Project",
+		SourceLocaleID: "en-US",
+	}))
+	t.Cleanup(server.Close)
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret")
+	client.Client.BaseURL = server.URL
+	glossaryApi := glossaryapi.NewGlossary(client.Client)
+	s := NewService(glossaryApi, NewAPI(client.Client))
+
+	_, err := glossaryApi.Create(ctx, "account", glossaryapi.CreateGlossaryRequest{
+		GlossaryName: "Terms",
+		LocaleIDs:    []string{"en-US", "de-DE"},
+	})
+	require.NoError(t, err)
+
+	csv := []byte("Term (en-US),Term (de-DE)\nhello,hallo\nbye,tschüss\n")
+	path := filepath.Join(t.TempDir(), "terms.csv")
+	require.NoError(t, os.WriteFile(path, csv, 0o600))
+	params := ImportParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Terms",
+		ImportFile:        ImportFile{Path: path, Name: "terms.csv", MediaType: "text/csv"},
+		Preview:           true,
+	}
+	exportCSV := func(glossaryUID string) []byte {
+		exported, err := glossaryApi.Export(ctx, "account", glossaryUID, glossaryapi.ExportGlossaryRequest{Format: "csv"})
+		require.NoError(t, err)
+		defer func() { _ = exported.Data.Close() }()
+		content, err := io.ReadAll(exported.Data)
+		require.NoError(t, err)
+		return content
+	}
+
+	preview, err := s.RunImport(ctx, params)
+	require.NoError(t, err)
+	assert.True(t, preview.Preview)
+	assert.Equal(t, glossaryapi.PendingImportStatus, preview.ImportStatus)
+	assert.Equal(t, 2, preview.EntryChanges.NewEntries)
+	assert.Contains(t, string(preview.JSON), `"preview":true`)
+	assert.NotEqual(t, csv, exportCSV(preview.GlossaryUID))
+
+	action := ImportActionParams{AccountUID: "account", GlossaryUIDOrName: "Terms", ImportUID: preview.ImportUID}
+	confirmed, err := s.RunImportConfirm(ctx, action)
+	require.NoError(t, err)
+	assert.Equal(t, glossaryapi.SuccessfulImportStatus, confirmed.ImportStatus)
+	assert.Equal(t, csv, exportCSV(preview.GlossaryUID))
+
+	params.ImportFile.Path = filepath.Join(t.TempDir(), "other.csv")
+	require.NoError(t, os.WriteFile(params.ImportFile.Path, []byte("Term (en-US)\nother\n"), 0o600))
+	discarded, err := s.RunImport(ctx, params)
+	require.NoError(t, err)
+	action.ImportUID = discarded.ImportUID
+	canceled, err := s.RunImportCancel(ctx, action)
+	require.NoError(t, err)
+	assert.Equal(t, CanceledImportStatus, canceled.ImportStatus)
+	assert.Equal(t, csv, exportCSV(preview.GlossaryUID))
+
+	_, err = s.RunImportConfirm(ctx, action)
+	assert.Error(t, err, "a canceled import cannot be confirmed")
+
+	action.ImportUID = "missing"
+	_, err = s.RunImportCancel(ctx, action)
+	assert.ErrorIs(t, err, glossaryapi.ErrImportNotFound)
+}
+
+func TestImportActionParams_Validate(t *testing.T) {
+	assert.NoError(t, ImportActionParams{AccountUID: "account", GlossaryUIDOrName: "Terms", ImportUID: "import"}.Validate())
+	assert.Error(t, ImportActionParams{GlossaryUIDOrName: "Terms", ImportUID: "import"}.Validate())
+	assert.Error(t, ImportActionParams{AccountUID: "account", ImportUID: "import"}.Validate())
+	assert.Error(t, ImportActionParams{AccountUID: "account", GlossaryUIDOrName: "Terms"}.Validate())
+}
@@ -95,7 +95,7 @@ func Test_service_RunImport(t *testing.T) {
 		testImportUID    = "import-uid-001"
 	)
 
-	fileContent := []byte("term,translation\nhello,hola")
+	fileContent := []byte("Term (en-US),Term (es-ES)\nhello,hola")
 
 	makeImportFile := func(t *testing.T) ImportFile {
 		t.Helper()
@@ -113,6 +113,16 @@ func Test_service_RunImport(t *testing.T) {
 			}, nil)
 	}
 
+	// setupGlossary also expects the Get which reads the glossary locales
+	// for validating the file.
+	setupGlossary := func(m *sdkmocks.MockGlossary) {
+		setupGetByName(m)
+		m.EXPECT().Get(ctx, testAccountUID, testGlossaryUID).
+			Return(glossaryapi.GetGlossaryResponse{
+				GlossaryUID: testGlossaryUID, Name: testGlossaryName, LocaleIDs: []string{"en-US", "es-ES"},
+			}, nil)
+	}
+
 	baseParams := func(f ImportFile) ImportParams {
 		return ImportParams{
 			AccountUID:        testAccountUID,
@@ -179,9 +189,41 @@ func Test_service_RunImport(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Import API error",
+			name: "validation error — locale is not a glossary locale",
+			setup: func(m *sdkmocks.MockGlossary, _ ImportFile) {
+				setupGlossary(m)
+			},
+			params: func(_ ImportFile) ImportParams {
+				path := filepath.Join(t.TempDir(), "terms.csv")
+				if err := os.WriteFile(path, []byte("Term (en-US),Term (fr-FR)\nhello,bonjour"), 0o600); err != nil {
+					t.Fatalf("write temp file: %v", err)
+				}
+				return ImportParams{
+					AccountUID:        testAccountUID,
+					GlossaryUIDOrName: testGlossaryName,
+					ImportFile:        ImportFile{Path: path, Name: "terms.csv", MediaType: "text/csv"},
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "skip validation — glossary locales are not read",
 			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
 				setupGetByName(m)
+				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
+					Return(importResponse, nil)
+			},
+			params: func(f ImportFile) ImportParams {
+				p := baseParams(f)
+				p.SkipValidation = true
+				p.Preview = true
+				return p
+			},
+		},
+		{
+			name: "Import API error",
+			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
+				setupGlossary(m)
 				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
 					Return(glossaryapi.ImportGlossaryResponse{}, errors.New("import API error"))
 			},
@@ -191,7 +233,7 @@ func Test_service_RunImport(t *testing.T) {
 		{
 			name: "ImportConfirm error",
 			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
-				setupGetByName(m)
+				setupGlossary(m)
 				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
 					Return(importResponse, nil)
 				m.EXPECT().ImportConfirm(ctx, testAccountUID, testGlossaryUID, testImportUID).
@@ -203,7 +245,7 @@ func Test_service_RunImport(t *testing.T) {
 		{
 			name: "ImportConfirm returns false",
 			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
-				setupGetByName(m)
+				setupGlossary(m)
 				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
 					Return(importResponse, nil)
 				m.EXPECT().ImportConfirm(ctx, testAccountUID, testGlossaryUID, testImportUID).
@@ -215,7 +257,7 @@ func Test_service_RunImport(t *testing.T) {
 		{
 			name: "ImportStatus error",
 			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
-				setupGetByName(m)
+				setupGlossary(m)
 				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
 					Return(importResponse, nil)
 				m.EXPECT().ImportConfirm(ctx, testAccountUID, testGlossaryUID, testImportUID).
@@ -229,7 +271,7 @@ func Test_service_RunImport(t *testing.T) {
 		{
 			name: "ImportStatus returns FAILED",
 			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
-				setupGetByName(m)
+				setupGlossary(m)
 				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
 					Return(importResponse, nil)
 				m.EXPECT().ImportConfirm(ctx, testAccountUID, testGlossaryUID, testImportUID).
@@ -243,7 +285,7 @@ func Test_service_RunImport(t *testing.T) {
 		{
 			name: "success — SUCCESSFUL on first poll",
 			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
-				setupGetByName(m)
+				setupGlossary(m)
 				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
 					Return(importResponse, nil)
 				m.EXPECT().ImportConfirm(ctx, testAccountUID, testGlossaryUID, testImportUID).
@@ -273,7 +315,7 @@ func Test_service_RunImport(t *testing.T) {
 		{
 			name: "success — PENDING then SUCCESSFUL",
 			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
-				setupGetByName(m)
+				setupGlossary(m)
 				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
 					Return(importResponse, nil)
 				m.EXPECT().ImportConfirm(ctx, testAccountUID, testGlossaryUID, testImportUID).
@@ -292,10 +334,34 @@ func Test_service_RunImport(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "preview — stops before confirmation",
+			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
+				setupGlossary(m)
+				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, expectedReq(f)).
+					Return(importResponse, nil)
+			},
+			params: func(f ImportFile) ImportParams {
+				p := baseParams(f)
+				p.Preview = true
+				return p
+			},
+			check: func(t *testing.T, got ImportOutput) {
+				if !got.Preview {
+					t.Error("Preview should be set")
+				}
+				if got.ImportStatus != glossaryapi.PendingImportStatus {
+					t.Errorf("ImportStatus = %v, want %v", got.ImportStatus, glossaryapi.PendingImportStatus)
+				}
+				if got.EntryChanges.ExistingEntryUpdates != 1 {
+					t.Errorf("ExistingEntryUpdates = %v, want 1", got.EntryChanges.ExistingEntryUpdates)
+				}
+			},
+		},
 		{
 			name: "success — archive mode",
 			setup: func(m *sdkmocks.MockGlossary, f ImportFile) {
-				setupGetByName(m)
+				setupGlossary(m)
 				m.EXPECT().Import(ctx, testAccountUID, testGlossaryUID, glossaryapi.ImportGlossaryRequest{
 					File:        fileContent,
 					FileName:    f.Name,
@@ -0,0 +1,217 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// LabelsParams addresses the glossary labels of an account.
+type LabelsParams struct {
+	AccountUID uid.AccountUID
+}
+
+// Validate checks that LabelsParams carry the required fields.
+func (p LabelsParams) Validate() error {
+	return p.AccountUID.Validate()
+}
+
+// LabelsOutput represents the glossary labels of an account.
+type LabelsOutput struct {
+	Labels []Label
+	JSON   []byte
+}
+
+// JSONBytes returns the JSON representation of the labels.
+func (o LabelsOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns one line per label.
+func (o LabelsOutput) SimpleLines() []string {
+	if len(o.Labels) == 0 {
+		return []string{"No labels found."}
+	}
+	lines := make([]string, 0, len(o.Labels))
+	for _, l := range o.Labels {
+		lines = append(lines, fmt.Sprintf("%s  %s", l.LabelUID, l.LabelName))
+	}
+	return lines
+}
+
+// TableData returns one row per label.
+func (o LabelsOutput) TableData() ([]string, [][]string) {
+	headers := []string{"LABEL UID", "NAME", "CREATED"}
+	rows := make([][]string, 0, len(o.Labels))
+	for _, l := range o.Labels {
+		rows = append(rows, []string{l.LabelUID, l.LabelName, l.CreatedDate})
+	}
+	return headers, rows
+}
+
+// RunListLabels lists the glossary labels of the account.
+func (s service) RunListLabels(ctx context.Context, params LabelsParams) (LabelsOutput, error) {
+	if err := params.Validate(); err != nil {
+		return LabelsOutput{}, fmt.Errorf("invalid list labels params: %w", err)
+	}
+	labels, err := s.glossaryExtApi.ListLabels(ctx, params.AccountUID)
+	if err != nil {
+		return LabelsOutput{}, err
+	}
+	if labels == nil {
+		labels = []Label{}
+	}
+	out := LabelsOutput{Labels: labels}
+	if out.JSON, err = json.Marshal(labels); err != nil {
+		return LabelsOutput{}, fmt.Errorf("marshal labels to JSON: %w", err)
+	}
+	return out, nil
+}
+
+// LabelParams addresses a single glossary label.
+type LabelParams struct {
+	AccountUID uid.AccountUID
+	// LabelUIDOrName is the name of the label to create, or the UID or name
+	// of the label to delete.
+	LabelUIDOrName string
+}
+
+// Validate checks that LabelParams carry the required fields.
+func (p LabelParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.LabelUIDOrName) == "" {
+		return smerror.ErrEmptyParam("LabelUIDOrName")
+	}
+	return nil
+}
+
+// LabelOutput represents the result of a label create or delete.
+type LabelOutput struct {
+	Action string `json:"action"`
+	Label
+	JSON []byte `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the result.
+func (o LabelOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns a one-line summary.
+func (o LabelOutput) SimpleLines() []string {
+	return []string{fmt.Sprintf("%s label %s (%s)", o.Action, o.LabelName, o.LabelUID)}
+}
+
+// TableData returns the result as a single-row table.
+func (o LabelOutput) TableData() ([]string, [][]string) {
+	return []string{"ACTION", "LABEL UID", "NAME"}, [][]string{{o.Action, o.LabelUID, o.LabelName}}
+}
+
+// RunCreateLabel creates a glossary label. A label with the same name,
+// ignoring case, makes the call fail.
+func (s service) RunCreateLabel(ctx context.Context, params LabelParams) (LabelOutput, error) {
+	if err := params.Validate(); err != nil {
+		return LabelOutput{}, fmt.Errorf("invalid create label params: %w", err)
+	}
+	name := strings.TrimSpace(params.LabelUIDOrName)
+	if strings.Contains(name, ",") {
+		return LabelOutput{}, fmt.Errorf("label name %q must not contain a comma, which separates labels in glossary files", name)
+	}
+	labels, err := s.glossaryExtApi.ListLabels(ctx, params.AccountUID)
+	if err != nil {
+		return LabelOutput{}, err
+	}
+	for _, l := range labels {
+		if strings.EqualFold(l.LabelName, name) {
+			return LabelOutput{}, fmt.Errorf("label %q already exists (%s)", l.LabelName, l.LabelUID)
+		}
+	}
+	label, err := s.glossaryExtApi.CreateLabel(ctx, params.AccountUID, name)
+	if err != nil {
+		return LabelOutput{}, err
+	}
+	return toLabelOutput("Created", label)
+}
+
+// RunDeleteLabel deletes a glossary label, which removes it from every entry
+// that has it.
+func (s service) RunDeleteLabel(ctx context.Context, params LabelParams) (LabelOutput, error) {
+	if err := params.Validate(); err != nil {
+		return LabelOutput{}, fmt.Errorf("invalid delete label params: %w", err)
+	}
+	labels, err := s.glossaryExtApi.ListLabels(ctx, params.AccountUID)
+	if err != nil {
+		return LabelOutput{}, err
+	}
+	label, err := findLabel(labels, params.LabelUIDOrName)
+	if err != nil {
+		return LabelOutput{}, err
+	}
+	if err := s.glossaryExtApi.DeleteLabel(ctx, params.AccountUID, label.LabelUID); err != nil {
+		return LabelOutput{}, err
+	}
+	return toLabelOutput("Deleted", label)
+}
+
+// labelUIDs resolves label names or UIDs to label UIDs.
+func (s service) labelUIDs(ctx context.Context, accountUID uid.AccountUID, labelUIDsOrNames []string) ([]string, error) {
+	labels, err := s.findLabels(ctx, accountUID, labelUIDsOrNames)
+	if err != nil {
+		return nil, err
+	}
+	uids := make([]string, 0, len(labels))
+	for _, l := range labels {
+		uids = append(uids, l.LabelUID)
+	}
+	return uids, nil
+}
+
+// findLabels resolves label names or UIDs to the labels of the account.
+func (s service) findLabels(ctx context.Context, accountUID uid.AccountUID, labelUIDsOrNames []string) ([]Label, error) {
+	labels, err := s.glossaryExtApi.ListLabels(ctx, accountUID)
+	if err != nil {
+		return nil, err
+	}
+	found := make([]Label, 0, len(labelUIDsOrNames))
+	for _, ref := range labelUIDsOrNames {
+		label, err := findLabel(labels, ref)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, label)
+	}
+	return found, nil
+}
+
+// findLabel looks a label up by UID, then by name, then by name ignoring
+// case.
+func findLabel(labels []Label, labelUIDOrName string) (Label, error) {
+	ref := strings.TrimSpace(labelUIDOrName)
+	for _, l := range labels {
+		if l.LabelUID == ref {
+			return l, nil
+		}
+	}
+	for _, l := range labels {
+		if l.LabelName == ref {
+			return l, nil
+		}
+	}
+	for _, l := range labels {
+		if strings.EqualFold(l.LabelName, ref) {
+			return l, nil
+		}
+	}
+	return Label{}, fmt.Errorf("%w: %q", ErrLabelNotFound, labelUIDOrName)
+}
+
+func toLabelOutput(action string, label Label) (LabelOutput, error) {
+	out := LabelOutput{Action: action, Label: label}
+	var err error
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return LabelOutput{}, fmt.Errorf("marshal label to JSON: %w", err)
+	}
+	return out, nil
+}
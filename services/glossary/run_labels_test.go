@@ -0,0 +1,126 @@
+package glossary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLabels(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Shared": "Definition,Term (en-US),Term (de-DE)\ngreeting,hello,hallo\n",
+	})
+	ctx := t.Context()
+
+	list, err := s.RunListLabels(ctx, LabelsParams{AccountUID: "account"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"No labels found."}, list.SimpleLines())
+	assert.JSONEq(t, `[]`, string(list.JSON))
+
+	web, err := s.RunCreateLabel(ctx, LabelParams{AccountUID: "account", LabelUIDOrName: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, "Created label web ("+web.LabelUID+")", web.SimpleLines()[0])
+	_, err = s.RunCreateLabel(ctx, LabelParams{AccountUID: "account", LabelUIDOrName: "mobile"})
+	require.NoError(t, err)
+
+	_, err = s.RunCreateLabel(ctx, LabelParams{AccountUID: "account", LabelUIDOrName: "Web"})
+	assert.ErrorContains(t, err, `label "web" already exists`)
+	_, err = s.RunCreateLabel(ctx, LabelParams{AccountUID: "account", LabelUIDOrName: "ios, android"})
+	assert.ErrorContains(t, err, "must not contain a comma")
+
+	list, err = s.RunListLabels(ctx, LabelsParams{AccountUID: "account"})
+	require.NoError(t, err)
+	require.Len(t, list.Labels, 2)
+	assert.Equal(t, "mobile", list.Labels[0].LabelName)
+
+	deleted, err := s.RunDeleteLabel(ctx, LabelParams{AccountUID: "account", LabelUIDOrName: web.LabelUID})
+	require.NoError(t, err)
+	assert.Equal(t, "Deleted", deleted.Action)
+	assert.Equal(t, "web", deleted.LabelName)
+
+	_, err = s.RunDeleteLabel(ctx, LabelParams{AccountUID: "account", LabelUIDOrName: "web"})
+	assert.ErrorIs(t, err, ErrLabelNotFound)
+}
+
+func TestRunImport_Labels(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Shared": "Definition,Term (en-US),Term (de-DE)\ngreeting,hello,hallo\n",
+	})
+	ctx := t.Context()
+	for _, name := range []string{"web", "mobile"} {
+		_, err := s.RunCreateLabel(ctx, LabelParams{AccountUID: "account", LabelUIDOrName: name})
+		require.NoError(t, err)
+	}
+	dir := t.TempDir()
+	importFile := func(name, content string) ImportFile {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return ImportFile{Path: path, Name: name, MediaType: "text/csv"}
+	}
+
+	_, err := s.RunImport(ctx, ImportParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Shared",
+		ImportFile:        importFile("terms.csv", "Definition,Labels,Term (en-US),Variations (en-US),Term (de-DE)\ngreeting,web,hello,hi,hallo\ncart,,cart,,Warenkorb\nthanks,,thank you,thanks,danke\n"),
+		Labels:            []string{"Mobile"},
+	})
+	require.NoError(t, err)
+
+	entries, err := s.RunListEntries(ctx, ListEntriesParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Shared",
+		Filter:            ExportFilter{Labels: []string{"web"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, entries.Entries, 1)
+	assert.Equal(t, "greeting", entries.Entries[0].Definition)
+	assert.Len(t, entries.Entries[0].LabelUIDs, 2)
+
+	entries, err = s.RunListEntries(ctx, ListEntriesParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Shared",
+		Filter:            ExportFilter{Labels: []string{"mobile"}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, entries.Entries, 3)
+
+	outFile := filepath.Join(dir, "web.csv")
+	_, err = s.RunExport(ctx, ExportParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Shared",
+		OutFile:           outFile,
+		FileType:          "csv",
+		Filter:            ExportFilter{Labels: []string{"web"}},
+	})
+	require.NoError(t, err)
+	exported, err := glossaryfile.Read(outFile, glossaryfile.FormatCSV)
+	require.NoError(t, err)
+	require.Len(t, exported.Entries, 1)
+	assert.Equal(t, []string{"web", "mobile"}, exported.Entries[0].Labels)
+
+	_, err = s.RunExport(ctx, ExportParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Shared",
+		OutFile:           outFile,
+		FileType:          "csv",
+		Filter:            ExportFilter{Labels: []string{"desktop"}},
+	})
+	assert.ErrorIs(t, err, ErrLabelNotFound)
+
+	_, err = s.RunImport(ctx, ImportParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Shared",
+		ImportFile:        importFile("more.csv", "Term (en-US)\nbye\n"),
+		Labels:            []string{"desktop"},
+	})
+	assert.ErrorIs(t, err, ErrLabelNotFound)
+
+	tbx := ImportFile{Path: filepath.Join(dir, "terms.tbx"), Name: "terms.tbx", MediaType: "text/xml"}
+	_, err = s.RunImport(ctx, ImportParams{AccountUID: "account", GlossaryUIDOrName: "Shared", ImportFile: tbx, Labels: []string{"web"}})
+	assert.ErrorContains(t, err, "labels is incompatible with: TBX import file")
+}
@@ -0,0 +1,386 @@
+package glossary
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// Statuses of a mirrored file.
+const (
+	MirrorAdded     = "added"
+	MirrorUpdated   = "updated"
+	MirrorUnchanged = "unchanged"
+	MirrorRemoved   = "removed"
+	// MirrorStale marks a file of the layout which no glossary produced and
+	// which was kept because pruning was not requested.
+	MirrorStale  = "stale"
+	MirrorFailed = "failed"
+)
+
+// MirrorParams defines a mirror of every glossary of the account to a
+// directory.
+type MirrorParams struct {
+	AccountUID uid.AccountUID
+	Directory  string
+	// FileType is "csv", which writes <name>/<localeId>.csv, or "tbx", which
+	// writes <name>.tbx.
+	FileType   string
+	TbxVersion string
+	// Prune removes files of the layout which no glossary produced.
+	Prune bool
+}
+
+// Validate enforces the fields required to mirror glossaries.
+func (p MirrorParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.Directory == "" {
+		return smerror.ErrEmptyParam("Directory")
+	}
+	switch p.FileType {
+	case string(glossaryfile.FormatCSV):
+		if p.TbxVersion != "" {
+			return clierror.ErrIncompatibleParams("tbx version", []string{"csv file type"})
+		}
+	case TbxExportFileType:
+		if !slices.Contains(AllowedExportTbxVersions, p.TbxVersion) {
+			return fmt.Errorf("unsupported tbx version %q: allowed values are %v", p.TbxVersion, AllowedExportTbxVersions)
+		}
+	default:
+		return fmt.Errorf("unsupported file type %q: allowed values are [csv tbx]", p.FileType)
+	}
+	return nil
+}
+
+// MirrorFile is the outcome for one file of the mirror.
+type MirrorFile struct {
+	Path         string `json:"path"`
+	GlossaryUID  string `json:"glossaryUid,omitempty"`
+	GlossaryName string `json:"glossaryName,omitempty"`
+	LocaleID     string `json:"localeId,omitempty"`
+	Status       string `json:"status"`
+	Error        string `json:"error,omitempty"`
+}
+
+// MirrorOutput represents the result of a glossary mirror.
+type MirrorOutput struct {
+	Directory  string         `json:"directory"`
+	Glossaries int            `json:"glossaries"`
+	Files      []MirrorFile   `json:"files"`
+	Summary    map[string]int `json:"summary"`
+	JSON       []byte         `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the mirror result.
+func (o MirrorOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines lists the files which changed, failed or are stale, followed by
+// the change summary.
+func (o MirrorOutput) SimpleLines() []string {
+	lines := make([]string, 0, len(o.Files)+1)
+	for _, f := range o.Files {
+		switch f.Status {
+		case MirrorUnchanged:
+			continue
+		case MirrorFailed:
+			lines = append(lines, fmt.Sprintf("%-9s %s: %s", f.Status, f.Path, f.Error))
+		default:
+			lines = append(lines, fmt.Sprintf("%-9s %s", f.Status, f.Path))
+		}
+	}
+	summary := fmt.Sprintf("Mirrored %d glossaries to %s: %d added, %d updated, %d unchanged, %d removed",
+		o.Glossaries, o.Directory, o.Summary[MirrorAdded], o.Summary[MirrorUpdated], o.Summary[MirrorUnchanged], o.Summary[MirrorRemoved])
+	for _, status := range []string{MirrorStale, MirrorFailed} {
+		if n := o.Summary[status]; n > 0 {
+			summary += fmt.Sprintf(", %d %s", n, status)
+		}
+	}
+	return append(lines, summary+".")
+}
+
+// TableData returns one row per file of the mirror.
+func (o MirrorOutput) TableData() ([]string, [][]string) {
+	headers := []string{"PATH", "GLOSSARY", "LOCALE", "STATUS", "ERROR"}
+	rows := make([][]string, 0, len(o.Files))
+	for _, f := range o.Files {
+		rows = append(rows, []string{f.Path, f.GlossaryName, f.LocaleID, f.Status, f.Error})
+	}
+	return headers, rows
+}
+
+// RunMirror exports every glossary of the account to a stable layout under
+// the directory: <name>/<localeId>.csv per locale, or one <name>.tbx per
+// glossary. Exports are normalized, with entries sorted by entry UID, so
+// that unchanged glossaries produce identical bytes; such files are not
+// rewritten. When some exports fail the others are still written and the
+// output is returned together with a clierror.PartialFailureError.
+func (s service) RunMirror(ctx context.Context, params MirrorParams) (MirrorOutput, error) {
+	if err := params.Validate(); err != nil {
+		return MirrorOutput{}, fmt.Errorf("invalid mirror params: %w", err)
+	}
+	list, err := s.RunList(ctx, ListParams{AccountUID: params.AccountUID})
+	if err != nil {
+		return MirrorOutput{}, err
+	}
+	if err := os.MkdirAll(params.Directory, 0o755); err != nil {
+		return MirrorOutput{}, fmt.Errorf("create mirror directory %q: %w", params.Directory, err)
+	}
+	tmpDir, err := os.MkdirTemp("", "smartling-glossary-mirror-")
+	if err != nil {
+		return MirrorOutput{}, fmt.Errorf("create export directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			rlog.Errorf("failed to remove export directory %q: %v", tmpDir, err)
+		}
+	}()
+
+	out := MirrorOutput{Directory: params.Directory, Glossaries: len(list.Glossaries)}
+	written := map[string]bool{}
+	for _, target := range mirrorTargets(list.Glossaries, params.FileType) {
+		file := MirrorFile{
+			Path:         target.path,
+			GlossaryUID:  target.glossary.GlossaryUID,
+			GlossaryName: target.glossary.Name,
+			LocaleID:     target.localeID,
+		}
+		written[target.path] = true
+		content, err := s.mirrorContent(ctx, params, target, tmpDir)
+		if err == nil {
+			file.Status, err = writeIfChanged(filepath.Join(params.Directory, target.path), content)
+		}
+		if err != nil {
+			rlog.Errorf("failed to mirror %s: %v", target.path, err)
+			file.Status, file.Error = MirrorFailed, err.Error()
+		}
+		out.Files = append(out.Files, file)
+	}
+
+	stale, err := staleMirrorFiles(params.Directory, params.FileType, written)
+	if err != nil {
+		return MirrorOutput{}, err
+	}
+	for _, path := range stale {
+		file := MirrorFile{Path: path, Status: MirrorStale}
+		if params.Prune {
+			file.Status = MirrorRemoved
+			if err := removeMirrorFile(params.Directory, path); err != nil {
+				file.Status, file.Error = MirrorFailed, err.Error()
+			}
+		}
+		out.Files = append(out.Files, file)
+	}
+
+	slices.SortStableFunc(out.Files, func(a, b MirrorFile) int { return cmp.Compare(a.Path, b.Path) })
+	if out.Files == nil {
+		out.Files = []MirrorFile{}
+	}
+	out.Summary = map[string]int{}
+	for _, f := range out.Files {
+		out.Summary[f.Status]++
+	}
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return MirrorOutput{}, fmt.Errorf("marshal mirror result to JSON: %w", err)
+	}
+	if failed := out.Summary[MirrorFailed]; failed > 0 {
+		return out, clierror.PartialFailureError{Failed: failed, Total: len(out.Files)}
+	}
+	return out, nil
+}
+
+// mirrorTarget is a file of the mirror layout and the export which fills it.
+type mirrorTarget struct {
+	path     string
+	glossary Item
+	// localeID is the single locale of a CSV file; empty for TBX.
+	localeID string
+}
+
+// mirrorTargets lays the glossaries out sorted by name. Glossaries whose
+// names map to the same directory, ignoring case, are told apart by their
+// UID.
+func mirrorTargets(glossaries []Item, fileType string) []mirrorTarget {
+	glossaries = slices.Clone(glossaries)
+	slices.SortFunc(glossaries, func(a, b Item) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.GlossaryUID, b.GlossaryUID))
+	})
+	names := map[string]int{}
+	for _, g := range glossaries {
+		names[strings.ToLower(mirrorName(g.Name))]++
+	}
+
+	var targets []mirrorTarget
+	for _, g := range glossaries {
+		name := mirrorName(g.Name)
+		if name == "" {
+			name = g.GlossaryUID
+		} else if names[strings.ToLower(name)] > 1 {
+			name += "-" + g.GlossaryUID
+		}
+		if fileType == TbxExportFileType {
+			targets = append(targets, mirrorTarget{path: name + ".tbx", glossary: g})
+			continue
+		}
+		localeIDs := slices.Clone(g.LocaleIDs)
+		slices.Sort(localeIDs)
+		for _, localeID := range localeIDs {
+			targets = append(targets, mirrorTarget{path: filepath.Join(name, localeID+".csv"), glossary: g, localeID: localeID})
+		}
+	}
+	return targets
+}
+
+// mirrorName makes a glossary name usable as a file name on every platform.
+func mirrorName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < ' ' || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	return strings.Trim(name, " .")
+}
+
+// mirrorContent exports the target and normalizes the export.
+func (s service) mirrorContent(ctx context.Context, params MirrorParams, target mirrorTarget, tmpDir string) ([]byte, error) {
+	exportParams := ExportParams{
+		AccountUID:        params.AccountUID,
+		GlossaryUIDOrName: target.glossary.GlossaryUID,
+		OutFile:           filepath.Join(tmpDir, "export."+params.FileType),
+		FileType:          params.FileType,
+		TbxVersion:        params.TbxVersion,
+	}
+	if target.localeID != "" {
+		exportParams.LocaleIDs = []string{target.localeID}
+	}
+	if _, err := s.RunExport(ctx, exportParams); err != nil {
+		return nil, fmt.Errorf("export glossary %q: %w", target.glossary.Name, err)
+	}
+	data, err := os.ReadFile(exportParams.OutFile)
+	if err != nil {
+		return nil, fmt.Errorf("read export of glossary %q: %w", target.glossary.Name, err)
+	}
+	return normalizeExport(data, glossaryfile.Format(params.FileType), params.TbxVersion), nil
+}
+
+// normalizeExport rewrites an export with entries sorted by entry UID and
+// then by terms and with sorted labels, so that the same glossary content
+// always produces the same bytes. Locales keep the order of the export, which
+// puts the source locale first. Exports which cannot be
+// parsed cleanly are kept as they are.
+func normalizeExport(data []byte, format glossaryfile.Format, tbxVersion string) []byte {
+	f, err := glossaryfile.Parse(data, format)
+	if err != nil || glossaryfile.Errors(f.Issues) > 0 {
+		return data
+	}
+	for i := range f.Entries {
+		slices.Sort(f.Entries[i].Labels)
+	}
+	slices.SortStableFunc(f.Entries, func(a, b glossaryfile.Entry) int {
+		if c := cmp.Compare(a.EntryUID, b.EntryUID); c != 0 {
+			return c
+		}
+		for _, localeID := range f.Locales {
+			at, _ := a.Term(localeID)
+			bt, _ := b.Term(localeID)
+			if c := cmp.Compare(at.Text, bt.Text); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	var buf bytes.Buffer
+	if format == glossaryfile.FormatTBX {
+		err = glossaryfile.WriteTBX(&buf, f, tbxVersion)
+	} else {
+		err = glossaryfile.WriteCSV(&buf, f)
+	}
+	if err != nil {
+		return data
+	}
+	return buf.Bytes()
+}
+
+// writeIfChanged writes the content unless the file already holds it, and
+// reports which of MirrorAdded, MirrorUpdated and MirrorUnchanged applies.
+func writeIfChanged(path string, content []byte) (string, error) {
+	status := MirrorUpdated
+	existing, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		status = MirrorAdded
+	case err != nil:
+		return "", fmt.Errorf("read %q: %w", path, err)
+	case bytes.Equal(existing, content):
+		return MirrorUnchanged, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("create directory of %q: %w", path, err)
+	}
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return "", fmt.Errorf("write %q: %w", path, err)
+	}
+	return status, nil
+}
+
+// staleMirrorFiles lists the files of the layout, <dir>/<name>/*.csv or
+// <dir>/*.tbx, which are not among the written ones. Hidden files and
+// directories, such as .git, are never part of the layout.
+func staleMirrorFiles(dir, fileType string, written map[string]bool) ([]string, error) {
+	pattern := "*.tbx"
+	if fileType == string(glossaryfile.FormatCSV) {
+		pattern = filepath.Join("*", "*.csv")
+	}
+	matches, err := filepath.Glob(filepath.Join(dir, pattern))
+	if err != nil {
+		return nil, fmt.Errorf("list mirror directory %q: %w", dir, err)
+	}
+	var stale []string
+	for _, match := range matches {
+		path, err := filepath.Rel(dir, match)
+		if err != nil {
+			return nil, err
+		}
+		if written[path] || strings.HasPrefix(path, ".") || strings.HasPrefix(filepath.Base(path), ".") {
+			continue
+		}
+		if info, err := os.Stat(match); err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		stale = append(stale, path)
+	}
+	return stale, nil
+}
+
+// removeMirrorFile removes a stale file and, for CSV layouts, its glossary
+// directory once it is empty.
+func removeMirrorFile(dir, path string) error {
+	if err := os.Remove(filepath.Join(dir, path)); err != nil {
+		return err
+	}
+	if parent := filepath.Dir(path); parent != "." {
+		entries, err := os.ReadDir(filepath.Join(dir, parent))
+		if err == nil && len(entries) == 0 {
+			return os.Remove(filepath.Join(dir, parent))
+		}
+	}
+	return nil
+}
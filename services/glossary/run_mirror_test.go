@@ -0,0 +1,143 @@
+package glossary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMirror(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Web":        "Definition,Term (en-US),Term (de-DE)\ngreeting,hello,hallo\nfarewell,bye,tschüss\n",
+		"Mobile/iOS": "Definition,Term (en-US),Term (de-DE)\nthanks,thank you,danke\n",
+	})
+	dir := filepath.Join(t.TempDir(), "glossaries")
+	params := MirrorParams{AccountUID: "account", Directory: dir, FileType: "csv"}
+
+	out, err := s.RunMirror(t.Context(), params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Mobile_iOS/de-DE.csv", "Mobile_iOS/en-US.csv", "Web/de-DE.csv", "Web/en-US.csv",
+	}, mirrorPaths(out))
+	assert.Equal(t, map[string]int{MirrorAdded: 4}, out.Summary)
+	assert.Equal(t, "Mirrored 2 glossaries to "+dir+": 4 added, 0 updated, 0 unchanged, 0 removed.", out.SimpleLines()[4])
+
+	de, err := glossaryfile.Read(filepath.Join(dir, "Web", "de-DE.csv"), glossaryfile.FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"de-DE"}, de.Locales)
+	require.Len(t, de.Entries, 2)
+	assert.Less(t, de.Entries[0].EntryUID, de.Entries[1].EntryUID)
+
+	first, err := os.ReadFile(filepath.Join(dir, "Web", "en-US.csv"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Web", "de-DE.csv"), []byte("edited\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "Old"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Old", "fr-FR.csv"), nil, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), nil, 0o644))
+
+	out, err = s.RunMirror(t.Context(), params)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{MirrorUpdated: 1, MirrorUnchanged: 3, MirrorStale: 1}, out.Summary)
+	assert.Equal(t, []string{
+		"stale     Old/fr-FR.csv",
+		"updated   Web/de-DE.csv",
+		"Mirrored 2 glossaries to " + dir + ": 0 added, 1 updated, 3 unchanged, 0 removed, 1 stale.",
+	}, out.SimpleLines())
+	second, err := os.ReadFile(filepath.Join(dir, "Web", "en-US.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	params.Prune = true
+	out, err = s.RunMirror(t.Context(), params)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{MirrorUnchanged: 4, MirrorRemoved: 1}, out.Summary)
+	assert.NoDirExists(t, filepath.Join(dir, "Old"))
+	assert.FileExists(t, filepath.Join(dir, "README.md"))
+}
+
+func TestRunMirror_TBX(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Web": "Definition,Term (en-US),Term (de-DE)\ngreeting,hello,hallo\n",
+	})
+	dir := t.TempDir()
+
+	out, err := s.RunMirror(t.Context(), MirrorParams{AccountUID: "account", Directory: dir, FileType: "tbx", TbxVersion: "v3"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Web.tbx"}, mirrorPaths(out))
+
+	f, err := glossaryfile.Read(filepath.Join(dir, "Web.tbx"), glossaryfile.FormatTBX)
+	require.NoError(t, err)
+	assert.Equal(t, glossaryfile.TBXVersion3, f.TBXVersion)
+	assert.Equal(t, []string{"en-US", "de-DE"}, f.Locales)
+	assert.Empty(t, f.Issues)
+}
+
+func TestRunMirror_PartialFailure(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Web": "Definition,Term (en-US),Term (de-DE)\ngreeting,hello,hallo\n",
+	})
+	dir := t.TempDir()
+	// A directory in place of the file makes the write fail.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "Web", "de-DE.csv"), 0o755))
+
+	out, err := s.RunMirror(t.Context(), MirrorParams{AccountUID: "account", Directory: dir, FileType: "csv"})
+	var partial clierror.PartialFailureError
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, clierror.PartialFailureError{Failed: 1, Total: 2}, partial)
+	assert.Equal(t, map[string]int{MirrorAdded: 1, MirrorFailed: 1}, out.Summary)
+	assert.NotNil(t, out.JSON)
+}
+
+func TestMirrorTargets(t *testing.T) {
+	targets := mirrorTargets([]Item{
+		{GlossaryUID: "u3", Name: "web", LocaleIDs: []string{"fr-FR"}},
+		{GlossaryUID: "u2", Name: "Web", LocaleIDs: []string{"en-US"}},
+		{GlossaryUID: "u1", Name: " ..", LocaleIDs: []string{"en-US"}},
+		{GlossaryUID: "u4", Name: `A: "B"?`, LocaleIDs: []string{"es-ES", "de-DE"}},
+	}, "csv")
+	var paths []string
+	for _, target := range targets {
+		paths = append(paths, filepath.ToSlash(target.path))
+	}
+	assert.Equal(t, []string{
+		"u1/en-US.csv",
+		"A_ _B__/de-DE.csv",
+		"A_ _B__/es-ES.csv",
+		"Web-u2/en-US.csv",
+		"web-u3/fr-FR.csv",
+	}, paths)
+}
+
+func TestMirrorParams_Validate(t *testing.T) {
+	valid := MirrorParams{AccountUID: "account", Directory: "glossaries", FileType: "csv"}
+	require.NoError(t, valid.Validate())
+
+	tbx := valid
+	tbx.FileType, tbx.TbxVersion = "tbx", "v2"
+	require.NoError(t, tbx.Validate())
+
+	tbx.TbxVersion = ""
+	assert.ErrorContains(t, tbx.Validate(), "unsupported tbx version")
+
+	csvVersion := valid
+	csvVersion.TbxVersion = "v3"
+	assert.ErrorContains(t, csvVersion.Validate(), "tbx version is incompatible with: csv file type")
+
+	xlsx := valid
+	xlsx.FileType = "xlsx"
+	assert.ErrorContains(t, xlsx.Validate(), "unsupported file type")
+}
+
+func mirrorPaths(out MirrorOutput) []string {
+	paths := make([]string, 0, len(out.Files))
+	for _, f := range out.Files {
+		paths = append(paths, filepath.ToSlash(f.Path))
+	}
+	return paths
+}
@@ -0,0 +1,164 @@
+package glossary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryresolver"
+
+	api "github.com/Smartling/api-sdk-go/api/glossary"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// UpdateParams carries a glossary update from CLI to service. Nil fields are
+// left unchanged; the glossary is read first and written back whole.
+type UpdateParams struct {
+	AccountUID        uid.AccountUID
+	GlossaryUIDOrName string
+	GlossaryName      *string
+	Description       *string
+	VerificationMode  *bool
+	AddLocaleIDs      []string
+	RemoveLocaleIDs   []string
+	// FallbackLocales replace the mappings of their fallback locales.
+	FallbackLocales         []FallbackLocale
+	RemoveFallbackLocaleIDs []string
+}
+
+// Validate checks that UpdateParams carry the required fields and at least
+// one change.
+func (p UpdateParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.GlossaryUIDOrName == "" {
+		return smerror.ErrEmptyParam("GlossaryUIDOrName")
+	}
+	if p.GlossaryName != nil && *p.GlossaryName == "" {
+		return smerror.ErrEmptyParam("GlossaryName")
+	}
+	for _, localeID := range p.AddLocaleIDs {
+		if slices.Contains(p.RemoveLocaleIDs, localeID) {
+			return fmt.Errorf("locale %s is both added and removed", localeID)
+		}
+	}
+	for _, fallbackLocale := range p.FallbackLocales {
+		if fallbackLocale.FallbackLocaleID == "" {
+			return smerror.ErrEmptyParam("FallbackLocale.FallbackLocaleID")
+		}
+		if len(fallbackLocale.LocaleIDs) == 0 {
+			return smerror.ErrEmptyParam("FallbackLocale.LocaleIDs")
+		}
+	}
+	if p.GlossaryName == nil && p.Description == nil && p.VerificationMode == nil &&
+		len(p.AddLocaleIDs) == 0 && len(p.RemoveLocaleIDs) == 0 &&
+		len(p.FallbackLocales) == 0 && len(p.RemoveFallbackLocaleIDs) == 0 {
+		return errors.New("nothing to update: give at least one change")
+	}
+	return nil
+}
+
+// RunUpdate applies the changes to the glossary metadata and returns the
+// glossary as `glossaries view` shows it.
+func (s service) RunUpdate(ctx context.Context, params UpdateParams) (ViewOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ViewOutput{}, fmt.Errorf("invalid update params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return ViewOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	details, err := s.glossaryExtApi.GetGlossary(ctx, params.AccountUID, glossaryUID)
+	if err != nil {
+		return ViewOutput{}, fmt.Errorf("get glossary %q: %w", glossaryUID, err)
+	}
+	req, err := params.apply(details)
+	if err != nil {
+		return ViewOutput{}, err
+	}
+	if _, err := s.glossaryExtApi.UpdateGlossary(ctx, params.AccountUID, glossaryUID, req); err != nil {
+		return ViewOutput{}, fmt.Errorf("glossary update API call failed: %w", err)
+	}
+	return s.view(ctx, params.AccountUID, glossaryUID)
+}
+
+// apply builds the update request from the current glossary metadata.
+// Removed locales are also dropped from the fallback mappings, and mappings
+// left without locales are dropped.
+func (p UpdateParams) apply(details GlossaryDetails) (api.CreateGlossaryRequest, error) {
+	req := api.CreateGlossaryRequest{
+		GlossaryName:     details.GlossaryName,
+		Description:      details.Description,
+		VerificationMode: details.VerificationMode,
+		LocaleIDs:        slices.Clone(details.LocaleIDs),
+	}
+	if p.GlossaryName != nil {
+		req.GlossaryName = *p.GlossaryName
+	}
+	if p.Description != nil {
+		req.Description = *p.Description
+	}
+	if p.VerificationMode != nil {
+		req.VerificationMode = *p.VerificationMode
+	}
+
+	for _, localeID := range p.AddLocaleIDs {
+		if !slices.Contains(req.LocaleIDs, localeID) {
+			req.LocaleIDs = append(req.LocaleIDs, localeID)
+		}
+	}
+	for _, localeID := range p.RemoveLocaleIDs {
+		i := slices.Index(req.LocaleIDs, localeID)
+		if i < 0 {
+			return api.CreateGlossaryRequest{}, fmt.Errorf("locale %s is not a locale of glossary %q", localeID, details.GlossaryName)
+		}
+		req.LocaleIDs = slices.Delete(req.LocaleIDs, i, i+1)
+	}
+	if len(req.LocaleIDs) == 0 {
+		return api.CreateGlossaryRequest{}, fmt.Errorf("glossary %q must keep at least one locale", details.GlossaryName)
+	}
+
+	fallbackLocales := make([]api.FallbackLocale, 0, len(details.FallbackLocales)+len(p.FallbackLocales))
+	for _, fallbackLocale := range details.FallbackLocales {
+		fallbackLocales = append(fallbackLocales, api.FallbackLocale{
+			FallbackLocaleID: fallbackLocale.FallbackLocaleID,
+			LocaleIDs:        slices.Clone(fallbackLocale.LocaleIDs),
+		})
+	}
+	indexOf := func(fallbackLocaleID string) int {
+		return slices.IndexFunc(fallbackLocales, func(f api.FallbackLocale) bool { return f.FallbackLocaleID == fallbackLocaleID })
+	}
+	for _, fallbackLocaleID := range p.RemoveFallbackLocaleIDs {
+		i := indexOf(fallbackLocaleID)
+		if i < 0 {
+			return api.CreateGlossaryRequest{}, fmt.Errorf("glossary %q has no fallback locale %s", details.GlossaryName, fallbackLocaleID)
+		}
+		fallbackLocales = slices.Delete(fallbackLocales, i, i+1)
+	}
+	for _, fallbackLocale := range p.FallbackLocales {
+		mapping := api.FallbackLocale{FallbackLocaleID: fallbackLocale.FallbackLocaleID, LocaleIDs: slices.Clone(fallbackLocale.LocaleIDs)}
+		if i := indexOf(fallbackLocale.FallbackLocaleID); i >= 0 {
+			fallbackLocales[i] = mapping
+		} else {
+			fallbackLocales = append(fallbackLocales, mapping)
+		}
+	}
+	// Removed locales are dropped from the mappings, both as fallback locale
+	// and as locales falling back.
+	req.FallbackLocales = fallbackLocales[:0]
+	for _, fallbackLocale := range fallbackLocales {
+		if slices.Contains(p.RemoveLocaleIDs, fallbackLocale.FallbackLocaleID) {
+			continue
+		}
+		fallbackLocale.LocaleIDs = slices.DeleteFunc(fallbackLocale.LocaleIDs, func(localeID string) bool {
+			return slices.Contains(p.RemoveLocaleIDs, localeID)
+		})
+		if len(fallbackLocale.LocaleIDs) > 0 {
+			req.FallbackLocales = append(req.FallbackLocales, fallbackLocale)
+		}
+	}
+	return req, nil
+}
@@ -0,0 +1,147 @@
+package glossary
+
+import (
+	"testing"
+
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunUpdate(t *testing.T) {
+	s := newDevserverService(t, map[string]string{"Web": "Term (en-US)\nhello\n"})
+	ctx := t.Context()
+
+	name, description, verification := "Website", "Terms of the website", true
+	out, err := s.RunUpdate(ctx, UpdateParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		GlossaryName:      &name,
+		Description:       &description,
+		VerificationMode:  &verification,
+		AddLocaleIDs:      []string{"es-ES", "es-MX"},
+		FallbackLocales:   []FallbackLocale{{FallbackLocaleID: "es-ES", LocaleIDs: []string{"es-MX"}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Website", out.GlossaryName)
+	assert.Equal(t, "Terms of the website", out.Description)
+	assert.True(t, out.VerificationMode)
+	assert.Equal(t, []string{"en-US", "de-DE", "es-ES", "es-MX"}, out.LocaleIDs)
+	assert.Equal(t, "es-ES:es-MX", fallbackLocalesSummary(out.FallbackLocales))
+	assert.Equal(t, 1, out.ActiveEntries)
+
+	out, err = s.RunUpdate(ctx, UpdateParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Website",
+		RemoveLocaleIDs:   []string{"es-MX"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"en-US", "de-DE", "es-ES"}, out.LocaleIDs)
+	assert.Empty(t, out.FallbackLocales)
+	assert.Equal(t, "Terms of the website", out.Description)
+
+	_, err = s.RunUpdate(ctx, UpdateParams{AccountUID: "account", GlossaryUIDOrName: "Website", RemoveLocaleIDs: []string{"fr-FR"}})
+	assert.EqualError(t, err, `locale fr-FR is not a locale of glossary "Website"`)
+}
+
+func TestUpdateParams_apply(t *testing.T) {
+	details := GlossaryDetails{
+		GlossaryName: "Web",
+		Description:  "terms",
+		LocaleIDs:    []string{"en-US", "es-ES", "es-MX", "pt-BR"},
+		FallbackLocales: []glossaryapi.FallbackLocale{
+			{FallbackLocaleID: "es-ES", LocaleIDs: []string{"es-MX"}},
+			{FallbackLocaleID: "pt-PT", LocaleIDs: []string{"pt-BR"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		params  UpdateParams
+		want    glossaryapi.CreateGlossaryRequest
+		wantErr string
+	}{
+		{
+			name:   "replace a mapping and remove another",
+			params: UpdateParams{FallbackLocales: []FallbackLocale{{FallbackLocaleID: "es-ES", LocaleIDs: []string{"es-MX", "es-AR"}}}, RemoveFallbackLocaleIDs: []string{"pt-PT"}},
+			want: glossaryapi.CreateGlossaryRequest{
+				GlossaryName: "Web", Description: "terms", LocaleIDs: []string{"en-US", "es-ES", "es-MX", "pt-BR"},
+				FallbackLocales: []glossaryapi.FallbackLocale{{FallbackLocaleID: "es-ES", LocaleIDs: []string{"es-MX", "es-AR"}}},
+			},
+		},
+		{
+			name:   "removed locale leaves fallback mappings",
+			params: UpdateParams{RemoveLocaleIDs: []string{"pt-BR"}, AddLocaleIDs: []string{"en-US", "fr-FR"}},
+			want: glossaryapi.CreateGlossaryRequest{
+				GlossaryName: "Web", Description: "terms", LocaleIDs: []string{"en-US", "es-ES", "es-MX", "fr-FR"},
+				FallbackLocales: []glossaryapi.FallbackLocale{{FallbackLocaleID: "es-ES", LocaleIDs: []string{"es-MX"}}},
+			},
+		},
+		{
+			name:   "removed fallback locale drops its mapping",
+			params: UpdateParams{RemoveLocaleIDs: []string{"es-ES"}},
+			want: glossaryapi.CreateGlossaryRequest{
+				GlossaryName: "Web", Description: "terms", LocaleIDs: []string{"en-US", "es-MX", "pt-BR"},
+				FallbackLocales: []glossaryapi.FallbackLocale{{FallbackLocaleID: "pt-PT", LocaleIDs: []string{"pt-BR"}}},
+			},
+		},
+		{
+			name:    "unknown fallback locale",
+			params:  UpdateParams{RemoveFallbackLocaleIDs: []string{"fr-FR"}},
+			wantErr: `glossary "Web" has no fallback locale fr-FR`,
+		},
+		{
+			name:    "every locale removed",
+			params:  UpdateParams{RemoveLocaleIDs: []string{"en-US", "es-ES", "es-MX", "pt-BR"}},
+			wantErr: `glossary "Web" must keep at least one locale`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.params.apply(details)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	assert.Len(t, details.FallbackLocales[0].LocaleIDs, 1, "apply must not modify the glossary details")
+
+	params := UpdateParams{
+		FallbackLocales: []FallbackLocale{{FallbackLocaleID: "es-ES", LocaleIDs: []string{"pt-BR", "es-MX"}}},
+		RemoveLocaleIDs: []string{"pt-BR"},
+	}
+	got, err := params.apply(details)
+	require.NoError(t, err)
+	assert.Equal(t, []glossaryapi.FallbackLocale{{FallbackLocaleID: "es-ES", LocaleIDs: []string{"es-MX"}}}, got.FallbackLocales)
+	assert.Equal(t, []string{"pt-BR", "es-MX"}, params.FallbackLocales[0].LocaleIDs, "apply must not modify the params")
+}
+
+func TestUpdateParams_Validate(t *testing.T) {
+	name, empty := "Web", ""
+	tests := []struct {
+		name    string
+		params  UpdateParams
+		wantErr bool
+	}{
+		{name: "rename", params: UpdateParams{AccountUID: "account", GlossaryUIDOrName: "g", GlossaryName: &name}},
+		{name: "clear description", params: UpdateParams{AccountUID: "account", GlossaryUIDOrName: "g", Description: &empty}},
+		{name: "nothing to update", params: UpdateParams{AccountUID: "account", GlossaryUIDOrName: "g"}, wantErr: true},
+		{name: "empty name", params: UpdateParams{AccountUID: "account", GlossaryUIDOrName: "g", GlossaryName: &empty}, wantErr: true},
+		{name: "missing glossary", params: UpdateParams{AccountUID: "account", GlossaryName: &name}, wantErr: true},
+		{name: "locale added and removed", params: UpdateParams{AccountUID: "account", GlossaryUIDOrName: "g", AddLocaleIDs: []string{"de-DE"}, RemoveLocaleIDs: []string{"de-DE"}}, wantErr: true},
+		{name: "fallback without locales", params: UpdateParams{AccountUID: "account", GlossaryUIDOrName: "g", FallbackLocales: []FallbackLocale{{FallbackLocaleID: "es"}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
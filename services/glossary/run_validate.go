@@ -0,0 +1,152 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryfile"
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryresolver"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// ValidateParams defines validate params.
+type ValidateParams struct {
+	AccountUID uid.AccountUID
+	// GlossaryUIDOrName is the glossary whose locales the file must use;
+	// empty skips the locale check and keeps validation offline.
+	GlossaryUIDOrName string
+	Path              string
+	Format            glossaryfile.Format
+}
+
+// Validate enforces the fields required to validate a glossary file.
+func (p ValidateParams) Validate() error {
+	if p.Path == "" {
+		return smerror.ErrEmptyParam("Path")
+	}
+	if p.Format == "" {
+		return fmt.Errorf("unknown glossary file format of %q: use a .csv, .xlsx or .tbx file", p.Path)
+	}
+	if p.GlossaryUIDOrName != "" {
+		return p.AccountUID.Validate()
+	}
+	return nil
+}
+
+// ValidateOutput represents the result of a glossary file validation.
+type ValidateOutput struct {
+	File        string               `json:"file"`
+	Format      string               `json:"format"`
+	TBXVersion  string               `json:"tbxVersion,omitempty"`
+	GlossaryUID string               `json:"glossaryUid,omitempty"`
+	Locales     []string             `json:"locales"`
+	Entries     int                  `json:"entries"`
+	Errors      int                  `json:"errors"`
+	Warnings    int                  `json:"warnings"`
+	Issues      []glossaryfile.Issue `json:"issues"`
+	JSON        []byte               `json:"-"`
+
+	// locations renders issue lines as "line N" or "row N".
+	locations []string
+}
+
+// JSONBytes returns the JSON representation of the validation result.
+func (p ValidateOutput) JSONBytes() []byte { return p.JSON }
+
+// SimpleLines returns one line per issue followed by a summary.
+func (p ValidateOutput) SimpleLines() []string {
+	lines := make([]string, 0, len(p.Issues)+1)
+	for i, issue := range p.Issues {
+		lines = append(lines, fmt.Sprintf("%s:%s: %s: %s", p.File, p.locations[i], issue.Severity, issue.Message))
+	}
+	return append(lines, fmt.Sprintf("%s: %d entries, %d error(s), %d warning(s)", p.File, p.Entries, p.Errors, p.Warnings))
+}
+
+// TableData returns one row per issue.
+func (p ValidateOutput) TableData() ([]string, [][]string) {
+	headers := []string{"LOCATION", "SEVERITY", "MESSAGE"}
+	rows := make([][]string, 0, len(p.Issues))
+	for i, issue := range p.Issues {
+		rows = append(rows, []string{p.locations[i], issue.Severity, issue.Message})
+	}
+	return headers, rows
+}
+
+// details lists the error issues for an InvalidFileError.
+func (p ValidateOutput) details() []string {
+	var details []string
+	for i, issue := range p.Issues {
+		if issue.Severity == glossaryfile.SeverityError {
+			details = append(details, p.locations[i]+": "+issue.Message)
+		}
+	}
+	return details
+}
+
+// RunValidate parses a glossary file locally and checks it the way the import
+// would. When the file has errors the output is returned together with a
+// clierror.InvalidFileError.
+func (s service) RunValidate(ctx context.Context, params ValidateParams) (ValidateOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ValidateOutput{}, fmt.Errorf("invalid validate params: %w", err)
+	}
+	var glossaryUID string
+	var locales []string
+	if params.GlossaryUIDOrName != "" {
+		var err error
+		glossaryUID, err = glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+		if err != nil {
+			return ValidateOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+		}
+		gl, err := s.glossaryApi.Get(ctx, params.AccountUID, glossaryUID)
+		if err != nil {
+			return ValidateOutput{}, fmt.Errorf("get glossary %q: %w", glossaryUID, err)
+		}
+		locales = gl.LocaleIDs
+		if locales == nil {
+			locales = []string{}
+		}
+	}
+	f, err := glossaryfile.Read(params.Path, params.Format)
+	if err != nil {
+		return ValidateOutput{}, fmt.Errorf("read glossary file %q: %w", params.Path, err)
+	}
+	return validateFile(params.Path, f, glossaryUID, locales)
+}
+
+func validateFile(path string, f glossaryfile.File, glossaryUID string, locales []string) (ValidateOutput, error) {
+	issues := glossaryfile.Validate(f, locales)
+	out := ValidateOutput{
+		File:        path,
+		Format:      string(f.Format),
+		TBXVersion:  f.TBXVersion,
+		GlossaryUID: glossaryUID,
+		Locales:     f.Locales,
+		Entries:     len(f.Entries),
+		Errors:      glossaryfile.Errors(issues),
+		Issues:      issues,
+		locations:   make([]string, len(issues)),
+	}
+	out.Warnings = len(issues) - out.Errors
+	for i, issue := range issues {
+		out.locations[i] = f.Location(issue.Line)
+	}
+	if out.Locales == nil {
+		out.Locales = []string{}
+	}
+	if out.Issues == nil {
+		out.Issues = []glossaryfile.Issue{}
+	}
+	var err error
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return ValidateOutput{}, fmt.Errorf("marshal validation result to JSON: %w", err)
+	}
+	if out.Errors > 0 {
+		return out, clierror.InvalidFileError{Path: path, Errors: out.Errors}
+	}
+	return out, nil
+}
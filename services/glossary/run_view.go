@@ -0,0 +1,146 @@
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Smartling/smartling-cli/services/glossary/glossaryresolver"
+
+	api "github.com/Smartling/api-sdk-go/api/glossary"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+)
+
+// Entry states of the Glossary Entries API.
+const (
+	entryStateActive   = "ACTIVE"
+	entryStateArchived = "ARCHIVED"
+)
+
+// GlossaryParams identifies the glossary to view, archive or delete.
+type GlossaryParams struct {
+	AccountUID        uid.AccountUID
+	GlossaryUIDOrName string
+}
+
+// Validate checks that GlossaryParams carry the required fields.
+func (p GlossaryParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.GlossaryUIDOrName == "" {
+		return smerror.ErrEmptyParam("GlossaryUIDOrName")
+	}
+	return nil
+}
+
+// ViewOutput is the full metadata of a glossary with its entry counts.
+type ViewOutput struct {
+	GlossaryDetails
+	ActiveEntries   int    `json:"activeEntries"`
+	ArchivedEntries int    `json:"archivedEntries"`
+	JSON            []byte `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the glossary.
+func (o ViewOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns a human-readable detail block.
+func (o ViewOutput) SimpleLines() []string {
+	return []string{
+		fmt.Sprintf("Glossary UID:      %s", o.GlossaryUID),
+		fmt.Sprintf("Name:              %s", o.GlossaryName),
+		fmt.Sprintf("Description:       %s", o.Description),
+		fmt.Sprintf("Locales:           %s", strings.Join(o.LocaleIDs, ", ")),
+		fmt.Sprintf("Fallback locales:  %s", fallbackLocalesSummary(o.FallbackLocales)),
+		fmt.Sprintf("Verification mode: %t", o.VerificationMode),
+		fmt.Sprintf("Archived:          %t", o.Archived),
+		fmt.Sprintf("Active entries:    %d", o.ActiveEntries),
+		fmt.Sprintf("Archived entries:  %d", o.ArchivedEntries),
+		fmt.Sprintf("Created date:      %s", o.CreatedDate),
+		fmt.Sprintf("Modified date:     %s", o.ModifiedDate),
+	}
+}
+
+// TableData returns the detail as a two-column field/value table.
+func (o ViewOutput) TableData() ([]string, [][]string) {
+	headers := []string{"FIELD", "VALUE"}
+	rows := [][]string{
+		{"GLOSSARY UID", o.GlossaryUID},
+		{"NAME", o.GlossaryName},
+		{"DESCRIPTION", o.Description},
+		{"LOCALES", strings.Join(o.LocaleIDs, ", ")},
+		{"FALLBACK LOCALES", fallbackLocalesSummary(o.FallbackLocales)},
+		{"VERIFICATION MODE", strconv.FormatBool(o.VerificationMode)},
+		{"ARCHIVED", strconv.FormatBool(o.Archived)},
+		{"ACTIVE ENTRIES", strconv.Itoa(o.ActiveEntries)},
+		{"ARCHIVED ENTRIES", strconv.Itoa(o.ArchivedEntries)},
+		{"CREATED DATE", o.CreatedDate},
+		{"MODIFIED DATE", o.ModifiedDate},
+	}
+	return headers, rows
+}
+
+// fallbackLocalesSummary renders fallback locales the way the
+// --fallback-locale flag takes them, e.g. "es:es-MX,es-AR; pt:pt-BR".
+func fallbackLocalesSummary(fallbackLocales []api.FallbackLocale) string {
+	parts := make([]string, 0, len(fallbackLocales))
+	for _, fallbackLocale := range fallbackLocales {
+		parts = append(parts, fallbackLocale.FallbackLocaleID+":"+strings.Join(fallbackLocale.LocaleIDs, ","))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// RunView resolves the glossary by UID or name and returns its full metadata
+// together with the number of active and archived entries.
+func (s service) RunView(ctx context.Context, params GlossaryParams) (ViewOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ViewOutput{}, fmt.Errorf("invalid view params: %w", err)
+	}
+	glossaryUID, err := glossaryresolver.GetGlossaryUID(ctx, s.glossaryApi, params.AccountUID, params.GlossaryUIDOrName)
+	if err != nil {
+		return ViewOutput{}, fmt.Errorf("failed to get glossary UID: %w", err)
+	}
+	return s.view(ctx, params.AccountUID, glossaryUID)
+}
+
+// view reads the glossary back so that update renders like `glossaries view`.
+func (s service) view(ctx context.Context, accountUID uid.AccountUID, glossaryUID string) (ViewOutput, error) {
+	details, err := s.glossaryExtApi.GetGlossary(ctx, accountUID, glossaryUID)
+	if err != nil {
+		return ViewOutput{}, fmt.Errorf("get glossary %q: %w", glossaryUID, err)
+	}
+	out := ViewOutput{GlossaryDetails: details}
+	if out.ActiveEntries, err = s.countEntries(ctx, accountUID, glossaryUID, entryStateActive); err != nil {
+		return ViewOutput{}, err
+	}
+	if out.ArchivedEntries, err = s.countEntries(ctx, accountUID, glossaryUID, entryStateArchived); err != nil {
+		return ViewOutput{}, err
+	}
+	if out.LocaleIDs == nil {
+		out.LocaleIDs = []string{}
+	}
+	if out.FallbackLocales == nil {
+		out.FallbackLocales = []api.FallbackLocale{}
+	}
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return ViewOutput{}, fmt.Errorf("marshal glossary to JSON: %w", err)
+	}
+	return out, nil
+}
+
+// countEntries returns the number of glossary entries in the given state,
+// reading a single search result for its total count.
+func (s service) countEntries(ctx context.Context, accountUID uid.AccountUID, glossaryUID, entryState string) (int, error) {
+	list, err := s.glossaryExtApi.SearchEntries(ctx, accountUID, glossaryUID, EntryFilter{ExportGlossaryFilter: api.ExportGlossaryFilter{
+		EntryState: entryState,
+		Paging:     api.ExportGlossaryPaging{Limit: 1},
+	}})
+	if err != nil {
+		return 0, fmt.Errorf("count %s entries: %w", strings.ToLower(entryState), err)
+	}
+	return list.TotalCount, nil
+}
@@ -0,0 +1,38 @@
+package glossary
+
+import (
+	"testing"
+
+	glossaryapi "github.com/Smartling/api-sdk-go/api/glossary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunView(t *testing.T) {
+	s := newDevserverService(t, map[string]string{
+		"Web": "Definition,Term (en-US),Term (de-DE)\ngreeting,hello,hallo\nfarewell,bye,\n",
+	})
+	ctx := t.Context()
+
+	list, err := s.RunListEntries(ctx, ListEntriesParams{AccountUID: "account", GlossaryUIDOrName: "Web"})
+	require.NoError(t, err)
+	_, err = s.RunArchiveEntries(ctx, ArchiveEntriesParams{
+		AccountUID:        "account",
+		GlossaryUIDOrName: "Web",
+		EntryUIDs:         []string{list.Entries[0].EntryUID},
+	})
+	require.NoError(t, err)
+
+	out, err := s.RunView(ctx, GlossaryParams{AccountUID: "account", GlossaryUIDOrName: "Web"})
+	require.NoError(t, err)
+	assert.Equal(t, "Web", out.GlossaryName)
+	assert.Equal(t, []string{"en-US", "de-DE"}, out.LocaleIDs)
+	assert.Equal(t, 1, out.ActiveEntries)
+	assert.Equal(t, 1, out.ArchivedEntries)
+	assert.Contains(t, out.SimpleLines(), "Locales:           en-US, de-DE")
+	assert.Contains(t, string(out.JSON), `"fallbackLocales":[]`)
+	assert.Contains(t, string(out.JSON), `"archivedEntries":1`)
+
+	_, err = s.RunView(ctx, GlossaryParams{AccountUID: "account", GlossaryUIDOrName: "Missing"})
+	assert.ErrorIs(t, err, glossaryapi.ErrGlossaryNotFound)
+}
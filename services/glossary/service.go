@@ -9,16 +9,37 @@ import (
 // Service is the glossary business-logic interface.
 type Service interface {
 	RunImport(ctx context.Context, params ImportParams) (ImportOutput, error)
+	RunImportConfirm(ctx context.Context, params ImportActionParams) (ImportActionOutput, error)
+	RunImportCancel(ctx context.Context, params ImportActionParams) (ImportActionOutput, error)
+	RunValidate(ctx context.Context, params ValidateParams) (ValidateOutput, error)
+	RunConvert(ctx context.Context, params ConvertParams) (ConvertOutput, error)
+	RunDiff(ctx context.Context, params DiffParams) (DiffOutput, error)
+	RunCheck(ctx context.Context, params CheckParams) (CheckOutput, error)
+	RunListEntries(ctx context.Context, params ListEntriesParams) (EntriesOutput, error)
+	RunGetEntry(ctx context.Context, params EntryParams) (EntryOutput, error)
+	RunAddEntry(ctx context.Context, params AddEntryParams) (EntryOutput, error)
+	RunUpdateEntry(ctx context.Context, params UpdateEntryParams) (EntryOutput, error)
+	RunArchiveEntries(ctx context.Context, params ArchiveEntriesParams) (ArchiveEntriesOutput, error)
 	RunExport(ctx context.Context, params ExportParams) (ExportOutput, error)
+	RunMirror(ctx context.Context, params MirrorParams) (MirrorOutput, error)
 	RunCreate(ctx context.Context, params CreateParams) (CreateOutput, error)
+	RunView(ctx context.Context, params GlossaryParams) (ViewOutput, error)
+	RunUpdate(ctx context.Context, params UpdateParams) (ViewOutput, error)
+	RunArchive(ctx context.Context, params GlossaryParams) (ArchiveOutput, error)
+	RunDelete(ctx context.Context, params GlossaryParams) (ArchiveOutput, error)
 	RunList(ctx context.Context, params ListParams) (ListOutput, error)
+	RunListLabels(ctx context.Context, params LabelsParams) (LabelsOutput, error)
+	RunCreateLabel(ctx context.Context, params LabelParams) (LabelOutput, error)
+	RunDeleteLabel(ctx context.Context, params LabelParams) (LabelOutput, error)
 }
 
-// NewService builds a glossary Service.
-func NewService(glossaryApi api.Glossary) Service {
-	return service{glossaryApi: glossaryApi}
+// NewService builds a glossary Service. The SDK glossary API covers most
+// calls; glossaryExtApi covers the ones the SDK does not have yet.
+func NewService(glossaryApi api.Glossary, glossaryExtApi API) Service {
+	return service{glossaryApi: glossaryApi, glossaryExtApi: glossaryExtApi}
 }
 
 type service struct {
-	glossaryApi api.Glossary
+	glossaryApi    api.Glossary
+	glossaryExtApi API
 }
@@ -0,0 +1,145 @@
+package clierror
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	sdkjob "github.com/Smartling/api-sdk-go/api/job"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/reconquest/hierr-go"
+)
+
+// Exit codes of the CLI process. The table is part of the public contract,
+// so existing values must never change meaning.
+const (
+	// ExitOK means the command succeeded.
+	ExitOK = 0
+	// ExitGeneral is any failure not covered by a more specific code.
+	ExitGeneral = 1
+	// ExitValidation means invalid arguments, flags or configuration, or a
+	// validation error returned by Smartling.
+	ExitValidation = 2
+	// ExitAuth means credentials were rejected.
+	ExitAuth = 3
+	// ExitNotFound means the project, job, file or glossary does not exist.
+	ExitNotFound = 4
+	// ExitPartial means some items of a multi-item operation failed.
+	ExitPartial = 5
+	// ExitNetwork means Smartling could not be reached.
+	ExitNetwork = 6
+	// ExitTimeout means a waiting command gave up before its condition was met.
+	ExitTimeout = 7
+	// ExitCancelled means the awaited job was cancelled.
+	ExitCancelled = 8
+)
+
+// Error codes reported in the JSON error envelope, one per exit code.
+const (
+	CodeGeneral    = "GENERAL_ERROR"
+	CodeValidation = "VALIDATION_ERROR"
+	CodeAuth       = "AUTHENTICATION_ERROR"
+	CodeNotFound   = "NOT_FOUND_ERROR"
+	CodePartial    = "PARTIAL_FAILURE"
+	CodeNetwork    = "NETWORK_ERROR"
+	CodeTimeout    = "TIMEOUT"
+	CodeCancelled  = "CANCELLED"
+)
+
+var codeByExit = map[int]string{
+	ExitGeneral:    CodeGeneral,
+	ExitValidation: CodeValidation,
+	ExitAuth:       CodeAuth,
+	ExitNotFound:   CodeNotFound,
+	ExitPartial:    CodePartial,
+	ExitNetwork:    CodeNetwork,
+	ExitTimeout:    CodeTimeout,
+	ExitCancelled:  CodeCancelled,
+}
+
+// ExitCode maps error to the process exit code. The whole chain is
+// inspected, including hierr nesting, and the first recognized error wins.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	for _, e := range chain(err) {
+		if code, ok := exitCodeOf(e); ok {
+			return code
+		}
+	}
+	return ExitGeneral
+}
+
+// Code returns the JSON envelope code matching ExitCode(err).
+func Code(err error) string {
+	if err == nil {
+		return ""
+	}
+	return codeByExit[ExitCode(err)]
+}
+
+func exitCodeOf(err error) (int, bool) {
+	switch e := err.(type) {
+	case smerror.NotAuthorizedError, *smerror.NotAuthorizedError:
+		return ExitAuth, true
+	case smerror.NotFoundError, *smerror.NotFoundError, ProjectNotFoundError:
+		return ExitNotFound, true
+	case smerror.ValidationError, *smerror.ValidationError,
+		MissingConfigValueError, InvalidConfigValueError, InvalidFileError, *errIncompatibleParams:
+		return ExitValidation, true
+	case PartialFailureError:
+		return ExitPartial, true
+	case TimeoutError:
+		return ExitTimeout, true
+	case CancelledError:
+		return ExitCancelled, true
+	case smerror.APIError:
+		switch strings.ToUpper(e.Code) {
+		case CodeAuth:
+			return ExitAuth, true
+		case CodeNotFound:
+			return ExitNotFound, true
+		case CodeValidation:
+			return ExitValidation, true
+		}
+	case net.Error:
+		return ExitNetwork, true
+	}
+	if err == sdkjob.ErrNotFound {
+		return ExitNotFound, true
+	}
+	if smerror.IsErrEmptyParam(err) {
+		return ExitValidation, true
+	}
+	return 0, false
+}
+
+// chain flattens err into the list of errors it wraps, outermost first.
+// Unlike errors.Unwrap it also descends into hierr nested errors.
+func chain(err error) []error {
+	var (
+		result []error
+		queue  = []error{err}
+	)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == nil {
+			continue
+		}
+		result = append(result, current)
+
+		switch e := current.(type) {
+		case hierr.Error:
+			if nested, ok := e.Nested.(error); ok {
+				queue = append(queue, nested)
+			}
+		case interface{ Unwrap() []error }:
+			queue = append(queue, e.Unwrap()...)
+		default:
+			queue = append(queue, errors.Unwrap(current))
+		}
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package clierror
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	sdkjob "github.com/Smartling/api-sdk-go/api/job"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/reconquest/hierr-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitOK},
+		{"plain", errors.New("boom"), ExitGeneral},
+		{"auth in cli error", NewError(smerror.NotAuthorizedError{}, "check credentials"), ExitAuth},
+		{"not found in hierr", hierr.Errorf(smerror.NotFoundError{}, "unable to get file"), ExitNotFound},
+		{"job not found wrapped", fmt.Errorf("resolve job: %w", sdkjob.ErrNotFound), ExitNotFound},
+		{"project not found", ProjectNotFoundError{}, ExitNotFound},
+		{"validation in ui error", UIError{Err: smerror.ValidationError{}, Operation: "Create"}, ExitValidation},
+		{"missing config", MissingConfigValueError{ValueName: "project ID"}, ExitValidation},
+		{"incompatible params", ErrIncompatibleParams("a", []string{"b"}), ExitValidation},
+		{"empty param", smerror.ErrEmptyParam("ProjectID"), ExitValidation},
+		{"invalid file", fmt.Errorf("import: %w", InvalidFileError{Path: "terms.csv", Errors: 2}), ExitValidation},
+		{"partial", fmt.Errorf("push: %w", PartialFailureError{Failed: 1, Total: 3}), ExitPartial},
+		{"network", fmt.Errorf("unable to perform HTTP request: %w", &net.OpError{Op: "dial", Err: errors.New("refused")}), ExitNetwork},
+		{"timeout", UIError{Err: TimeoutError{What: "job", After: time.Hour}}, ExitTimeout},
+		{"cancelled", fmt.Errorf("wait: %w", CancelledError{What: "job"}), ExitCancelled},
+		{"api error code", smerror.APIError{Cause: errors.New("x"), Code: "NOT_FOUND_ERROR"}, ExitNotFound},
+		{"rendered partial", Rendered(UIError{Err: PartialFailureError{Failed: 1, Total: 2}}), ExitPartial},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExitCode(tt.err))
+		})
+	}
+	assert.Equal(t, CodeNotFound, Code(ProjectNotFoundError{}))
+	assert.NoError(t, Rendered(nil))
+}
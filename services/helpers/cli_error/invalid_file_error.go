@@ -0,0 +1,23 @@
+package clierror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvalidFileError reports that a local file failed validation before it was
+// sent to Smartling. Details, when set, list the problems one per line.
+type InvalidFileError struct {
+	Path    string
+	Errors  int
+	Details []string
+}
+
+// Error returns string representation.
+func (err InvalidFileError) Error() string {
+	msg := fmt.Sprintf("%s failed validation with %d error(s)", err.Path, err.Errors)
+	if len(err.Details) == 0 {
+		return msg
+	}
+	return msg + ":\n  " + strings.Join(err.Details, "\n  ")
+}
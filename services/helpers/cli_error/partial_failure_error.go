@@ -0,0 +1,15 @@
+package clierror
+
+import "fmt"
+
+// PartialFailureError reports that some items of a multi-item operation failed
+// while others succeeded.
+type PartialFailureError struct {
+	Failed int
+	Total  int
+}
+
+// Error returns string representation.
+func (err PartialFailureError) Error() string {
+	return fmt.Sprintf("%d of %d items failed", err.Failed, err.Total)
+}
@@ -0,0 +1,26 @@
+package clierror
+
+// RenderedError marks an error returned by a command which has already
+// rendered its result, so that no error document follows the result
+// under JSON output.
+type RenderedError struct {
+	Err error
+}
+
+// Error returns string representation.
+func (err RenderedError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the marked error.
+func (err RenderedError) Unwrap() error {
+	return err.Err
+}
+
+// Rendered marks err as returned after the result was rendered. Nil stays nil.
+func Rendered(err error) error {
+	if err == nil {
+		return nil
+	}
+	return RenderedError{Err: err}
+}
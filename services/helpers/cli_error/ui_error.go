@@ -14,3 +14,8 @@ func (e UIError) Error() string {
 	}
 	return e.Description
 }
+
+// Unwrap returns cause of the error.
+func (e UIError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,29 @@
+package clierror
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeoutError reports that a waiting command gave up before the awaited
+// condition was met.
+type TimeoutError struct {
+	What  string
+	After time.Duration
+}
+
+// Error returns string representation.
+func (err TimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for %s", err.After, err.What)
+}
+
+// CancelledError reports that the awaited entity was cancelled, so the
+// condition will never be met.
+type CancelledError struct {
+	What string
+}
+
+// Error returns string representation.
+func (err CancelledError) Error() string {
+	return fmt.Sprintf("%s was cancelled", err.What)
+}
@@ -5,4 +5,8 @@ type Config struct {
 	Insecure     bool
 	Proxy        string
 	SmartlingURL string
+	// RecordDir is a directory to record HTTP exchanges into.
+	RecordDir string
+	// ReplayDir is a directory to serve recorded HTTP exchanges from.
+	ReplayDir string
 }
@@ -12,7 +12,6 @@ import (
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	sdk "github.com/Smartling/api-sdk-go"
-	"github.com/kovetskiy/lorg"
 	"github.com/reconquest/hierr-go"
 )
 
@@ -51,6 +50,33 @@ func CreateClient(ctx context.Context, clientConfig Config, config config.Config
 		transport.Proxy = http.ProxyURL(proxy)
 	}
 
+	if clientConfig.RecordDir != "" && clientConfig.ReplayDir != "" {
+		return sdk.HttpAPIClient{}, clierror.ErrIncompatibleParams("record", []string{"replay"})
+	}
+
+	if clientConfig.RecordDir != "" {
+		recorder, err := NewRecordingTransport(httpClient.Transport, clientConfig.RecordDir)
+		if err != nil {
+			return sdk.HttpAPIClient{}, err
+		}
+		httpClient.Transport = recorder
+	}
+
+	if clientConfig.ReplayDir != "" {
+		replayer, err := NewReplayTransport(clientConfig.ReplayDir)
+		if err != nil {
+			return sdk.HttpAPIClient{}, clierror.NewError(
+				err,
+				`Replay directory should contain exchanges recorded with --record.`,
+			)
+		}
+		httpClient.Transport = replayer
+	}
+
+	if rlog.IsJSON() {
+		httpClient.Transport = NewLoggingTransport(httpClient.Transport)
+	}
+
 	client := sdk.NewHttpAPIClient(httpClient, config.UserID, config.Secret)
 
 	if clientConfig.SmartlingURL != "" {
@@ -59,7 +85,7 @@ func CreateClient(ctx context.Context, clientConfig Config, config config.Config
 
 	client.Client.UserAgent = "smartling-cli/" + version
 
-	setLogger(client, rlog.Logger(), verbose)
+	setLogger(client, verbose)
 
 	rlog.HideRegexp(
 		regexp.MustCompile(`"(?:access|refresh)Token": "([^"]+)"`),
@@ -76,7 +102,8 @@ func CreateClient(ctx context.Context, clientConfig Config, config config.Config
 	return *client, nil
 }
 
-func setLogger(client *sdk.HttpAPIClient, logger lorg.Logger, verbosity uint8) {
+func setLogger(client *sdk.HttpAPIClient, verbosity uint8) {
+	logger := rlog.WithFields(rlog.Fields{"source": "sdk"})
 	switch verbosity {
 	case 0:
 		return
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+// requestIDHeader carries the Smartling request ID used by support to trace calls.
+const requestIDHeader = "X-SL-RequestID"
+
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+// NewLoggingTransport returns a RoundTripper which logs method, URL, status,
+// duration and Smartling request ID of every request as structured fields.
+func NewLoggingTransport(next http.RoundTripper) http.RoundTripper {
+	return loggingTransport{next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+
+	fields := rlog.Fields{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		rlog.WithFields(fields).Infof("http request failed")
+		return nil, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	if requestID := resp.Header.Get(requestIDHeader); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	rlog.WithFields(fields).Infof("http request")
+	return resp, nil
+}
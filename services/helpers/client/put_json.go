@@ -0,0 +1,82 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	smclient "github.com/Smartling/api-sdk-go/helpers/sm_client"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+)
+
+// PutJSON performs PUT request, which smclient.Client has no helper for, and
+// decodes the response data into result unless it is nil. Errors are mapped
+// to the same smerror types as the client uses.
+func PutJSON(ctx context.Context, client *smclient.Client, reqURL string, payload []byte, result any) (int, error) {
+	if err := client.Authenticate(ctx); err != nil {
+		return 0, fmt.Errorf("unable to authenticate: %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, client.BaseURL+reqURL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("unable to create HTTP request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", client.UserAgent)
+	if token := client.Credentials.AccessToken; token != nil {
+		request.Header.Set("Authorization", "Bearer "+token.Value)
+	}
+	client.Logger.Debugf("<- PUT %s [payload %d bytes]\n%s", reqURL, len(payload), payload)
+
+	reply, err := client.HTTP.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("unable to perform HTTP request: %w", err)
+	}
+	defer func() { _ = reply.Body.Close() }()
+
+	code := reply.StatusCode
+	body, err := io.ReadAll(reply.Body)
+	if err != nil {
+		return code, smerror.APIError{Cause: err, URL: reqURL, Payload: payload}
+	}
+	client.Logger.Debugf("-> %s\n%s", reply.Status, body)
+
+	var response struct {
+		Response struct {
+			Code   string
+			Data   json.RawMessage
+			Errors []struct {
+				Key     string
+				Message string
+			}
+		}
+	}
+	_ = json.Unmarshal(body, &response)
+
+	switch {
+	case code == http.StatusOK || code == http.StatusAccepted:
+		if result == nil || len(response.Response.Data) == 0 {
+			return code, nil
+		}
+		if err := json.Unmarshal(response.Response.Data, result); err != nil {
+			return code, smerror.APIError{Cause: fmt.Errorf("unable to decode API response data: %w", err), URL: reqURL, Response: body}
+		}
+		return code, nil
+	case code == http.StatusUnauthorized:
+		return code, smerror.NotAuthorizedError{}
+	case code == http.StatusNotFound:
+		return code, smerror.NotFoundError{}
+	case strings.EqualFold(response.Response.Code, "validation_error"):
+		return code, smerror.ValidationError{Errors: response.Response.Errors}
+	}
+	return code, smerror.APIError{
+		Cause:    fmt.Errorf("API call returned unexpected HTTP code: %d", code),
+		URL:      reqURL,
+		Payload:  payload,
+		Response: body,
+		Headers:  &reply.Header,
+	}
+}
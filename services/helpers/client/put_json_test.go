@@ -0,0 +1,53 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth-api/v2/authenticate" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"response":{"code":"SUCCESS","data":{"accessToken":"token","refreshToken":"refresh"}}}`)
+			return
+		}
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/items/1":
+			body, _ := io.ReadAll(r.Body)
+			_, _ = io.WriteString(w, `{"response":{"code":"SUCCESS","data":`+string(body)+`}}`)
+		case "/items/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"response":{"code":"VALIDATION_ERROR","errors":[{"key":"name","message":"is required"}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret").Client
+	client.BaseURL = server.URL
+
+	var item struct{ Name string }
+	code, err := PutJSON(t.Context(), client, "/items/1", []byte(`{"name":"renamed"}`), &item)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "renamed", item.Name)
+
+	code, err = PutJSON(t.Context(), client, "/items/2", nil, nil)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.ErrorAs(t, err, new(smerror.NotFoundError))
+
+	_, err = PutJSON(t.Context(), client, "/items/invalid", []byte(`{}`), nil)
+	var validationErr smerror.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Len(t, validationErr.Errors, 1)
+}
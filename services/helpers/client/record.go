@@ -0,0 +1,148 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+)
+
+// redactedHeaders lists headers which values are never written to disk.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// recordPatterns complement rlog patterns with compact JSON forms of
+// credentials, which are sent over the wire without indentation.
+var recordPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`"(?:access|refresh)Token":\s*"([^"]+)"`),
+	regexp.MustCompile(`"userSecret":\s*"([^"]+)"`),
+}
+
+// exchange is a single recorded request/response pair.
+type exchange struct {
+	Method          string      `json:"method"`
+	URI             string      `json:"uri"`
+	RequestHeaders  http.Header `json:"requestHeaders,omitempty"`
+	RequestBody     string      `json:"requestBody,omitempty"`
+	Status          int         `json:"status"`
+	ResponseHeaders http.Header `json:"responseHeaders,omitempty"`
+	ResponseBody    string      `json:"responseBody,omitempty"`
+	// ResponseBase64 is set when response body is binary and stored base64 encoded.
+	ResponseBase64 bool `json:"responseBase64,omitempty"`
+}
+
+func (e exchange) key() string {
+	return e.Method + " " + e.URI
+}
+
+// recordingTransport passes requests to the underlying transport and
+// stores every request/response pair in a directory.
+type recordingTransport struct {
+	next http.RoundTripper
+	dir  string
+
+	mu  sync.Mutex
+	seq int
+}
+
+// NewRecordingTransport returns http.RoundTripper which records
+// every exchange made through next into dir as numbered JSON files.
+// Secrets and tokens are redacted before writing.
+func NewRecordingTransport(next http.RoundTripper, dir string) (http.RoundTripper, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("unable to create record directory %q: %w", dir, err)
+	}
+	return &recordingTransport{next: next, dir: dir}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		requestBody = body
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	record := exchange{
+		Method:          req.Method,
+		URI:             req.URL.RequestURI(),
+		RequestHeaders:  redactHeaders(req.Header),
+		Status:          resp.StatusCode,
+		ResponseHeaders: redactHeaders(resp.Header),
+	}
+	if utf8.Valid(requestBody) {
+		record.RequestBody = redact(string(requestBody))
+	}
+	if utf8.Valid(responseBody) {
+		record.ResponseBody = redact(string(responseBody))
+	} else {
+		record.ResponseBody = base64.StdEncoding.EncodeToString(responseBody)
+		record.ResponseBase64 = true
+	}
+
+	if err := t.write(record); err != nil {
+		rlog.Errorf("unable to record %s %s: %s", req.Method, req.URL.Path, err)
+	}
+
+	return resp, nil
+}
+
+func (t *recordingTransport) write(record exchange) error {
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.seq++
+	name := filepath.Join(t.dir, fmt.Sprintf("%05d.json", t.seq))
+	return os.WriteFile(name, data, 0o600)
+}
+
+func redact(value string) string {
+	value = rlog.Redact(value)
+	for _, pattern := range recordPatterns {
+		value = pattern.ReplaceAllStringFunc(value, func(match string) string {
+			i := pattern.FindStringSubmatchIndex(match)
+			return match[:i[2]] + "***" + match[i[3]:]
+		})
+	}
+	return value
+}
+
+func redactHeaders(header http.Header) http.Header {
+	result := header.Clone()
+	for _, name := range redactedHeaders {
+		if result.Get(name) != "" {
+			result.Set(name, "***")
+		}
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	rlog.Init()
+	os.Exit(m.Run())
+}
+
+func TestRecordReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/auth-api/v2/authenticate":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"response":{"code":"SUCCESS","data":{"accessToken":"secret-access-token","refreshToken":"secret-refresh-token"}}}`)
+		case "/files":
+			_, _ = w.Write([]byte{0xff, 0x00, 0xfe})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	recorder, err := NewRecordingTransport(http.DefaultTransport, dir)
+	require.NoError(t, err)
+	recordClient := &http.Client{Transport: recorder}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/auth-api/v2/authenticate",
+		strings.NewReader(`{"userIdentifier":"user","userSecret":"super-secret"}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret-access-token")
+	resp, err := recordClient.Do(req)
+	require.NoError(t, err)
+	authBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Contains(t, string(authBody), "secret-access-token")
+
+	resp, err = recordClient.Get(server.URL + "/files?uri=a")
+	require.NoError(t, err)
+	fileBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, names, 2)
+	for _, name := range names {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "secret-access-token")
+		assert.NotContains(t, string(data), "secret-refresh-token")
+		assert.NotContains(t, string(data), "super-secret")
+	}
+
+	replayer, err := NewReplayTransport(dir)
+	require.NoError(t, err)
+	replayClient := &http.Client{Transport: replayer}
+
+	resp, err = replayClient.Get("https://api.example.com/files?uri=a")
+	require.NoError(t, err)
+	replayed, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, fileBody, replayed)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = replayClient.Post("https://api.example.com/auth-api/v2/authenticate", "application/json", nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = replayClient.Get("https://api.example.com/unknown")
+	assert.Error(t, err)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// replayTransport serves responses previously stored by recordingTransport.
+// Exchanges are matched by method and request URI, host is ignored.
+// Repeated requests to the same URI get recorded responses in order; the last
+// one is served again once the sequence is exhausted.
+type replayTransport struct {
+	mu        sync.Mutex
+	exchanges map[string][]exchange
+	served    map[string]int
+}
+
+// NewReplayTransport returns http.RoundTripper which serves responses
+// recorded into dir instead of performing network requests.
+func NewReplayTransport(dir string) (http.RoundTripper, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list replay directory %q: %w", dir, err)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no recorded exchanges found in %q", dir)
+	}
+	sort.Strings(names)
+
+	transport := &replayTransport{
+		exchanges: make(map[string][]exchange),
+		served:    make(map[string]int),
+	}
+	for _, name := range names {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %q: %w", name, err)
+		}
+		var record exchange
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("unable to parse %q: %w", name, err)
+		}
+		transport.exchanges[record.key()] = append(transport.exchanges[record.key()], record)
+	}
+	return transport, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		_ = req.Body.Close()
+	}
+
+	key := req.Method + " " + req.URL.RequestURI()
+
+	t.mu.Lock()
+	records := t.exchanges[key]
+	if len(records) == 0 {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("no recorded response for %s", key)
+	}
+	i := min(t.served[key], len(records)-1)
+	t.served[key]++
+	record := records[i]
+	t.mu.Unlock()
+
+	body := []byte(record.ResponseBody)
+	if record.ResponseBase64 {
+		decoded, err := base64.StdEncoding.DecodeString(record.ResponseBody)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode recorded response for %s: %w", key, err)
+		}
+		body = decoded
+	}
+
+	header := record.ResponseHeaders.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", record.Status, http.StatusText(record.Status)),
+		StatusCode:    record.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
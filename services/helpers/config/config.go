@@ -62,6 +62,8 @@ type Config struct {
 
 	Files map[string]FileConfig `yaml:"files"`
 
+	Jobs map[string]JobTemplate `yaml:"jobs,omitzero"`
+
 	Proxy string `yaml:"proxy,omitzero"`
 
 	Path string `yaml:"-"`
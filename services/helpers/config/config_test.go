@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestLoadConfigFromFile_Threads(t *testing.T) {
@@ -45,3 +47,78 @@ func TestLoadConfigFromFile_Threads(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadConfigFromFile_JobTemplates(t *testing.T) {
+	yml := `user_id: u
+secret: s
+jobs:
+  release:
+    name: "Release {branch} {date} ({user})"
+    description: Release strings
+    due_in: 5d
+    locales: [fr-FR, de-DE]
+    reference_number: REL
+    callback_url: https://example.com/hook
+    custom_fields:
+      field1: value1
+`
+	path := filepath.Join(t.TempDir(), "smartling.yml")
+	if err := os.WriteFile(path, []byte(yml), 0o644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	cfg, err := LoadConfigFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadConfigFromFile: %v", err)
+	}
+
+	tmpl, err := cfg.JobTemplate("release")
+	if err != nil {
+		t.Fatalf("JobTemplate: %v", err)
+	}
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	name := tmpl.JobName(JobTemplateVars{Branch: "main", User: "alice", Now: now})
+	if want := "Release main 2026-03-10 (alice)"; name != want {
+		t.Errorf("JobName = %q, want %q", name, want)
+	}
+	due, err := tmpl.DueDate(now)
+	if err != nil {
+		t.Fatalf("DueDate: %v", err)
+	}
+	if want := now.AddDate(0, 0, 5); !due.Equal(want) {
+		t.Errorf("DueDate = %v, want %v", due, want)
+	}
+	if len(tmpl.Locales) != 2 || tmpl.CustomFields["field1"] != "value1" || tmpl.ReferenceNumber != "REL" {
+		t.Errorf("unexpected template: %+v", tmpl)
+	}
+
+	if _, err := cfg.JobTemplate("nightly"); err == nil || !strings.Contains(err.Error(), "available: release") {
+		t.Errorf("JobTemplate(nightly) error = %v, want listing of available templates", err)
+	}
+}
+
+func TestJobTemplate_DueDate(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		dueIn   string
+		want    time.Time
+		wantErr bool
+	}{
+		{dueIn: "", want: time.Time{}},
+		{dueIn: "36h", want: now.Add(36 * time.Hour)},
+		{dueIn: "2d", want: now.AddDate(0, 0, 2)},
+		{dueIn: "0d", wantErr: true},
+		{dueIn: "-1h", wantErr: true},
+		{dueIn: "soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dueIn, func(t *testing.T) {
+			got, err := JobTemplate{DueIn: tt.dueIn}.DueDate(now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DueDate error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("DueDate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
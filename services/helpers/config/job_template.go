@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JobTemplate is a named translation job template from the `jobs` section
+// of the config file. Name may contain {branch}, {date} and {user}
+// placeholders.
+type JobTemplate struct {
+	Name            string            `yaml:"name"`
+	Description     string            `yaml:"description,omitzero"`
+	DueIn           string            `yaml:"due_in,omitzero"`
+	Locales         []string          `yaml:"locales,omitzero,flow"`
+	ReferenceNumber string            `yaml:"reference_number,omitzero"`
+	CallbackURL     string            `yaml:"callback_url,omitzero"`
+	CustomFields    map[string]string `yaml:"custom_fields,omitzero"`
+}
+
+// JobTemplateVars are the values substituted into job template placeholders.
+type JobTemplateVars struct {
+	Branch string
+	User   string
+	Now    time.Time
+}
+
+// JobTemplate returns the job template with the given name.
+func (config *Config) JobTemplate(name string) (JobTemplate, error) {
+	template, ok := config.Jobs[name]
+	if !ok {
+		names := make([]string, 0, len(config.Jobs))
+		for key := range config.Jobs {
+			names = append(names, key)
+		}
+		slices.Sort(names)
+		if len(names) == 0 {
+			return JobTemplate{}, fmt.Errorf("job template %q not found: config file has no jobs section", name)
+		}
+		return JobTemplate{}, fmt.Errorf("job template %q not found, available: %s", name, strings.Join(names, ", "))
+	}
+	if template.Name == "" {
+		return JobTemplate{}, fmt.Errorf("job template %q has no name", name)
+	}
+	return template, nil
+}
+
+// UsesBranch reports whether the job name depends on the branch.
+func (t JobTemplate) UsesBranch() bool {
+	return strings.Contains(t.Name, "{branch}")
+}
+
+// JobName expands the name placeholders: {branch}, {date} as YYYY-MM-DD
+// and {user}.
+func (t JobTemplate) JobName(vars JobTemplateVars) string {
+	return strings.NewReplacer(
+		"{branch}", vars.Branch,
+		"{date}", vars.Now.Format(time.DateOnly),
+		"{user}", vars.User,
+	).Replace(t.Name)
+}
+
+// DueDate returns the due date relative to now, or zero time if the template
+// has no due date offset. DueIn is either a Go duration ("36h") or a number
+// of days ("5d").
+func (t JobTemplate) DueDate(now time.Time) (time.Time, error) {
+	if t.DueIn == "" {
+		return time.Time{}, nil
+	}
+	if days, ok := strings.CutSuffix(t.DueIn, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return time.Time{}, fmt.Errorf("invalid due_in %q: expected a positive number of days", t.DueIn)
+		}
+		return now.AddDate(0, 0, n), nil
+	}
+	offset, err := time.ParseDuration(t.DueIn)
+	if err != nil || offset <= 0 {
+		return time.Time{}, fmt.Errorf("invalid due_in %q: expected a positive duration like 36h or days like 5d", t.DueIn)
+	}
+	return now.Add(offset), nil
+}
@@ -0,0 +1,102 @@
+// Package pager walks offset-paginated API listings page by page, fetching
+// a bounded number of pages concurrently while keeping their order.
+package pager
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultConcurrency is the number of pages fetched at the same time.
+const DefaultConcurrency = 4
+
+// Page is a single page of a listing.
+type Page[T any] struct {
+	Items      []T
+	TotalCount int
+}
+
+// FetchFunc fetches the page of at most limit items starting at offset.
+type FetchFunc[T any] func(ctx context.Context, limit, offset uint32) (Page[T], error)
+
+// Params configures Walk.
+type Params struct {
+	PageSize uint32
+	// Concurrency bounds the pages in flight; zero means DefaultConcurrency.
+	Concurrency int
+}
+
+type pageResult[T any] struct {
+	page Page[T]
+	err  error
+}
+
+// Walk fetches the first page to learn the total count, then the remaining
+// pages with at most Concurrency requests in flight. Items are passed to emit
+// in listing order; no more than Concurrency pages are held in memory.
+// Walk stops at the first fetch or emit error.
+func Walk[T any](ctx context.Context, params Params, fetch FetchFunc[T], emit func(T) error) error {
+	if params.PageSize == 0 {
+		return fmt.Errorf("page size must be positive")
+	}
+	concurrency := params.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	first, err := fetch(ctx, params.PageSize, 0)
+	if err != nil {
+		return err
+	}
+	if err := emitAll(first.Items, emit); err != nil {
+		return err
+	}
+	if len(first.Items) == 0 {
+		return nil
+	}
+
+	start := func(offset uint32) chan pageResult[T] {
+		ch := make(chan pageResult[T], 1)
+		go func() {
+			page, err := fetch(ctx, params.PageSize, offset)
+			ch <- pageResult[T]{page: page, err: err}
+		}()
+		return ch
+	}
+
+	total := uint32(max(first.TotalCount, 0))
+	next := params.PageSize
+	var pending []chan pageResult[T]
+	for {
+		for len(pending) < concurrency && next < total {
+			pending = append(pending, start(next))
+			next += params.PageSize
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		result := <-pending[0]
+		pending = pending[1:]
+		if result.err != nil {
+			return result.err
+		}
+		if err := emitAll(result.page.Items, emit); err != nil {
+			return err
+		}
+		if len(result.page.Items) == 0 {
+			// The listing shrank while walking it.
+			return nil
+		}
+	}
+}
+
+func emitAll[T any](items []T, emit func(T) error) error {
+	for _, item := range items {
+		if err := emit(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package pager
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listing(total int, inFlight, peak *atomic.Int32) FetchFunc[int] {
+	return func(ctx context.Context, limit, offset uint32) (Page[int], error) {
+		if inFlight != nil {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				old := peak.Load()
+				if n <= old || peak.CompareAndSwap(old, n) {
+					break
+				}
+			}
+			// Later pages answer first to exercise ordering.
+			time.Sleep(time.Duration(total-int(offset)) * 50 * time.Microsecond)
+		}
+		var items []int
+		for i := int(offset); i < total && i < int(offset+limit); i++ {
+			items = append(items, i)
+		}
+		return Page[int]{Items: items, TotalCount: total}, nil
+	}
+}
+
+func TestWalk_KeepsOrderWithinBound(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	var got []int
+	err := Walk(context.Background(), Params{PageSize: 3, Concurrency: 2}, listing(20, &inFlight, &peak), func(i int) error {
+		got = append(got, i)
+		return nil
+	})
+	require.NoError(t, err)
+
+	want := make([]int, 20)
+	for i := range want {
+		want[i] = i
+	}
+	assert.Equal(t, want, got)
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestWalk_Empty(t *testing.T) {
+	calls := 0
+	err := Walk(context.Background(), Params{PageSize: 10}, func(context.Context, uint32, uint32) (Page[int], error) {
+		calls++
+		return Page[int]{}, nil
+	}, func(int) error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWalk_StopsOnError(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(ctx context.Context, limit, offset uint32) (Page[int], error) {
+		if offset == 4 {
+			return Page[int]{}, boom
+		}
+		return listing(10, nil, nil)(ctx, limit, offset)
+	}
+	var got []int
+	err := Walk(context.Background(), Params{PageSize: 2}, fetch, func(i int) error {
+		got = append(got, i)
+		return nil
+	})
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, []int{0, 1, 2, 3}, got)
+}
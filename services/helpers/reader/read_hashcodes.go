@@ -0,0 +1,142 @@
+package reader
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ReadHashcodesFrom reads string hashcodes from the named file, or from stdin
+// when name is "-". See ReadHashcodes for the accepted formats.
+func ReadHashcodesFrom(name string) ([]string, error) {
+	if name == "-" {
+		return ReadHashcodes(os.Stdin)
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("open hashcodes file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+	return ReadHashcodes(file)
+}
+
+// ReadHashcodes reads string hashcodes in one of the formats:
+//
+//   - JSON: an array of hashcodes, or any document whose objects carry a
+//     "hashcode" field or a "hashcodes" array, such as the JSON output of
+//     `jobs strings list` or `jobs find-by-strings`;
+//   - CSV with a "hashcode" header column, the other columns are ignored;
+//   - plain hashcodes separated by newlines and/or commas.
+//
+// Blank values are skipped and duplicates are removed keeping the first
+// occurrence.
+func ReadHashcodes(r io.Reader) ([]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read hashcodes: %w", err)
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	var hashcodes []string
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+		var doc any
+		if err := json.Unmarshal(trimmed, &doc); err != nil {
+			return nil, fmt.Errorf("parse hashcodes JSON: %w", err)
+		}
+		hashcodes = hashcodesFromJSON(doc, true)
+	} else {
+		hashcodes, err = hashcodesFromCSV(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return uniqueNonBlank(hashcodes), nil
+}
+
+// hashcodesFromJSON collects "hashcode" fields and "hashcodes" arrays at any
+// depth; arrays of strings elsewhere are taken only at the top level so that
+// e.g. locale lists are not mistaken for hashcodes.
+func hashcodesFromJSON(node any, top bool) []string {
+	var result []string
+	switch v := node.(type) {
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				if top {
+					result = append(result, s)
+				}
+				continue
+			}
+			result = append(result, hashcodesFromJSON(item, false)...)
+		}
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			value := v[key]
+			switch {
+			case key == "hashcode":
+				if s, ok := value.(string); ok {
+					result = append(result, s)
+				}
+			case key == "hashcodes":
+				result = append(result, hashcodesFromJSON(value, true)...)
+			default:
+				result = append(result, hashcodesFromJSON(value, false)...)
+			}
+		}
+	}
+	return result
+}
+
+func hashcodesFromCSV(data []byte) ([]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("parse hashcodes CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	column := -1
+	for i, header := range records[0] {
+		if strings.EqualFold(strings.TrimSpace(header), "hashcode") {
+			column = i
+			break
+		}
+	}
+	var hashcodes []string
+	if column < 0 {
+		for _, record := range records {
+			hashcodes = append(hashcodes, record...)
+		}
+		return hashcodes, nil
+	}
+	for _, record := range records[1:] {
+		if column < len(record) {
+			hashcodes = append(hashcodes, record[column])
+		}
+	}
+	return hashcodes, nil
+}
+
+func uniqueNonBlank(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package reader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadHashcodes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "newline separated with blanks and duplicates",
+			input: "h1\n\nh2\r\nh1\n  h3  \n",
+			want:  []string{"h1", "h2", "h3"},
+		},
+		{
+			name:  "comma separated",
+			input: "h1,h2, h3",
+			want:  []string{"h1", "h2", "h3"},
+		},
+		{
+			name:  "CSV with hashcode column",
+			input: "\xef\xbb\xbfstring,Hashcode,locale\n\"Hello, world\",h1,fr-FR\nBye,h2,de-DE\n",
+			want:  []string{"h1", "h2"},
+		},
+		{
+			name:  "JSON array",
+			input: `["h1", "h2", "h1"]`,
+			want:  []string{"h1", "h2"},
+		},
+		{
+			name:  "jobs strings list JSON",
+			input: `{"totalCount": 2, "items": [{"targetLocaleId": "fr-FR", "hashcode": "h1"}, {"targetLocaleId": "de-DE", "hashcode": "h2"}]}`,
+			want:  []string{"h1", "h2"},
+		},
+		{
+			name:  "hashcodes arrays ignore other string arrays",
+			input: `[{"localeIds": ["fr-FR"], "hashcodes": ["h1", "h2"]}]`,
+			want:  []string{"h1", "h2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadHashcodes(strings.NewReader(tt.input))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadHashcodes_InvalidJSON(t *testing.T) {
+	_, err := ReadHashcodes(strings.NewReader(`["h1",`))
+	assert.ErrorContains(t, err, "parse hashcodes JSON")
+}
+
+func TestReadHashcodesFrom_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hashcodes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("h1\nh2\n"), 0o600))
+	got, err := ReadHashcodesFrom(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"h1", "h2"}, got)
+
+	_, err = ReadHashcodesFrom(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,183 @@
+// Package result aggregates per-item outcomes of commands which operate on
+// many files or locales, so that failures are reported consistently.
+package result
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/table"
+)
+
+// Status is the outcome of a single item.
+type Status string
+
+// Item statuses.
+const (
+	StatusOK      Status = "ok"
+	StatusSkipped Status = "skipped"
+	StatusFailed  Status = "failed"
+)
+
+// Item is the outcome for one file, or one file × locale pair.
+type Item struct {
+	File   string `json:"file"`
+	Locale string `json:"locale,omitempty"`
+	Status Status `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Summary counts items by status.
+type Summary struct {
+	Total   int `json:"total"`
+	OK      int `json:"ok"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+// Report collects items of one operation. It is safe for concurrent use.
+type Report struct {
+	operation string
+
+	mutex sync.Mutex
+	items []Item
+}
+
+// NewReport returns an empty report for the named operation.
+func NewReport(operation string) *Report {
+	return &Report{operation: operation}
+}
+
+// OK records a successful item.
+func (r *Report) OK(file, locale string) {
+	r.add(Item{File: file, Locale: locale, Status: StatusOK})
+}
+
+// Skipped records an item which was intentionally not processed.
+func (r *Report) Skipped(file, locale, reason string) {
+	r.add(Item{File: file, Locale: locale, Status: StatusSkipped, Reason: reason})
+}
+
+// Failed records a failed item with err as the reason.
+func (r *Report) Failed(file, locale string, err error) {
+	r.add(Item{File: file, Locale: locale, Status: StatusFailed, Reason: err.Error()})
+}
+
+func (r *Report) add(item Item) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.items = append(r.items, item)
+}
+
+// Items returns recorded items ordered by file and locale.
+func (r *Report) Items() []Item {
+	r.mutex.Lock()
+	items := append([]Item(nil), r.items...)
+	r.mutex.Unlock()
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].File != items[j].File {
+			return items[i].File < items[j].File
+		}
+		return items[i].Locale < items[j].Locale
+	})
+	return items
+}
+
+// Summary returns item counts by status.
+func (r *Report) Summary() Summary {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	summary := Summary{Total: len(r.items)}
+	for _, item := range r.items {
+		switch item.Status {
+		case StatusOK:
+			summary.OK++
+		case StatusSkipped:
+			summary.Skipped++
+		case StatusFailed:
+			summary.Failed++
+		}
+	}
+	return summary
+}
+
+// Err returns clierror.PartialFailureError if any item failed, nil otherwise.
+func (r *Report) Err() error {
+	summary := r.Summary()
+	if summary.Failed == 0 {
+		return nil
+	}
+	return clierror.PartialFailureError{Failed: summary.Failed, Total: summary.Total}
+}
+
+// RenderSummary writes a table of skipped and failed items followed by
+// the totals line. Successful items are omitted to keep output short.
+func (r *Report) RenderSummary(target io.Writer) error {
+	writer := table.NewTableWriter(target)
+	var rows int
+	for _, item := range r.Items() {
+		if item.Status == StatusOK {
+			continue
+		}
+		if rows == 0 {
+			_, _ = fmt.Fprintln(writer, "FILE\tLOCALE\tSTATUS\tREASON")
+		}
+		rows++
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", item.File, item.Locale, item.Status, item.Reason)
+	}
+	if err := table.Render(writer); err != nil {
+		return err
+	}
+
+	summary := r.Summary()
+	_, err := fmt.Fprintf(target, "%s: %d ok, %d skipped, %d failed (%d total)\n",
+		r.operation, summary.OK, summary.Skipped, summary.Failed, summary.Total)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *Report) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Operation string  `json:"operation"`
+		Summary   Summary `json:"summary"`
+		Items     []Item  `json:"items"`
+	}{
+		Operation: r.operation,
+		Summary:   r.Summary(),
+		Items:     r.Items(),
+	})
+}
+
+// WriteFile writes the report as JSON to path. Empty path is a no-op.
+func (r *Report) WriteFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("unable to write report: %w", err)
+	}
+	return nil
+}
+
+// Finish renders the summary to target, writes the JSON report to path when
+// given and returns Err.
+func (r *Report) Finish(target io.Writer, path string) error {
+	if err := r.RenderSummary(target); err != nil {
+		return err
+	}
+	if err := r.WriteFile(path); err != nil {
+		return err
+	}
+	return r.Err()
+}
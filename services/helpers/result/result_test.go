@@ -0,0 +1,71 @@
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	report := NewReport("pull")
+	report.OK("b.json", "fr-FR")
+	report.Failed("b.json", "de-DE", errors.New("boom"))
+	report.Skipped("a.json", "de-DE", "already exists")
+
+	assert.Equal(t, Summary{Total: 3, OK: 1, Skipped: 1, Failed: 1}, report.Summary())
+	assert.Equal(t, []Item{
+		{File: "a.json", Locale: "de-DE", Status: StatusSkipped, Reason: "already exists"},
+		{File: "b.json", Locale: "de-DE", Status: StatusFailed, Reason: "boom"},
+		{File: "b.json", Locale: "fr-FR", Status: StatusOK},
+	}, report.Items())
+
+	var partial clierror.PartialFailureError
+	require.ErrorAs(t, report.Err(), &partial)
+	assert.Equal(t, clierror.PartialFailureError{Failed: 1, Total: 3}, partial)
+
+	var out bytes.Buffer
+	require.NoError(t, report.RenderSummary(&out))
+	assert.Contains(t, out.String(), "a.json")
+	assert.Contains(t, out.String(), "boom")
+	assert.NotContains(t, out.String(), "fr-FR")
+	assert.Contains(t, out.String(), "pull: 1 ok, 1 skipped, 1 failed (3 total)")
+}
+
+func TestReportNoFailures(t *testing.T) {
+	report := NewReport("delete")
+	report.OK("a.json", "")
+
+	var out bytes.Buffer
+	require.NoError(t, report.Finish(&out, ""))
+	assert.Equal(t, "delete: 1 ok, 0 skipped, 0 failed (1 total)\n", out.String())
+}
+
+func TestReportWriteFile(t *testing.T) {
+	report := NewReport("push")
+	report.OK("a.json", "")
+	report.Failed("b.json", "", errors.New("rejected"))
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, report.WriteFile(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var decoded struct {
+		Operation string  `json:"operation"`
+		Summary   Summary `json:"summary"`
+		Items     []Item  `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "push", decoded.Operation)
+	assert.Equal(t, Summary{Total: 2, OK: 1, Failed: 1}, decoded.Summary)
+	assert.Len(t, decoded.Items, 2)
+	assert.Equal(t, "rejected", decoded.Items[1].Reason)
+}
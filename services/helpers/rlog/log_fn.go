@@ -1,21 +1,43 @@
 package rlog
 
+import (
+	"fmt"
+
+	"github.com/kovetskiy/lorg"
+)
+
 // Infof does logger.Infof
 func Infof(format string, value ...any) {
+	if logger.json {
+		logger.emit(lorg.LevelInfo, nil, fmt.Sprintf(format, value...))
+		return
+	}
 	logger.Infof(format, value...)
 }
 
 // Error does logger.Error
 func Error(value ...any) {
+	if logger.json {
+		logger.emit(lorg.LevelError, nil, fmt.Sprint(value...))
+		return
+	}
 	logger.Error(value...)
 }
 
 // Errorf does logger.Errorf
 func Errorf(format string, value ...any) {
+	if logger.json {
+		logger.emit(lorg.LevelError, nil, fmt.Sprintf(format, value...))
+		return
+	}
 	logger.Errorf(format, value...)
 }
 
 // Debugf does logger.Debugf
 func Debugf(format string, value ...any) {
+	if logger.json {
+		logger.emit(lorg.LevelDebug, nil, fmt.Sprintf(format, value...))
+		return
+	}
 	logger.Debugf(format, value...)
 }
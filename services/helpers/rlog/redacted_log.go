@@ -2,8 +2,10 @@ package rlog
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"regexp"
+	"sync"
 
 	"github.com/kovetskiy/lorg"
 )
@@ -15,13 +17,18 @@ type RedactedLog struct {
 	*lorg.Log
 
 	writer *redactedWriter
+
+	// json, command and mutex drive structured output, see SetLogFormat.
+	json    bool
+	command string
+	mutex   sync.Mutex
 }
 
 // Init initializes RedactedLog.
 func Init() {
 	logger = &RedactedLog{
 		Log:    lorg.NewLog(),
-		writer: &redactedWriter{},
+		writer: &redactedWriter{output: os.Stderr},
 	}
 	logger.SetOutput(logger.writer)
 }
@@ -67,6 +74,11 @@ func SetLevel(level lorg.Level) {
 	logger.SetLevel(level)
 }
 
+// SetOutput redirects log output, which goes to stderr by default.
+func SetOutput(output io.Writer) {
+	logger.writer.output = output
+}
+
 // Logger returns the RedactedLog instance.
 func Logger() *RedactedLog {
 	return logger
@@ -75,16 +87,28 @@ func Logger() *RedactedLog {
 type redactedWriter struct {
 	patterns []*regexp.Regexp
 	enabled  bool
+	output   io.Writer
+}
+
+// Redact returns value with all registered sensitive patterns masked.
+// Unlike log output, masking is applied regardless of ToggleRedact state.
+func Redact(value string) string {
+	if logger == nil {
+		return value
+	}
+	return logger.writer.redact(value)
 }
 
 // Write write without sensitive information
 func (writer redactedWriter) Write(buffer []byte) (int, error) {
 	if !writer.enabled {
-		return os.Stderr.Write(buffer)
+		return writer.output.Write(buffer)
 	}
 
-	output := string(buffer)
+	return writer.output.Write([]byte(writer.redact(string(buffer))))
+}
 
+func (writer redactedWriter) redact(output string) string {
 	placeholder := "***"
 
 	for _, pattern := range writer.patterns {
@@ -107,5 +131,5 @@ func (writer redactedWriter) Write(buffer []byte) (int, error) {
 		)
 	}
 
-	return os.Stderr.Write([]byte(output))
+	return output
 }
@@ -0,0 +1,120 @@
+package rlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kovetskiy/lorg"
+)
+
+// Log formats accepted by SetLogFormat.
+const (
+	TextFormat = "text"
+	JSONFormat = "json"
+)
+
+// Fields holds structured context attached to a single log line.
+// Fields are emitted only in JSON format and ignored in text format.
+type Fields map[string]any
+
+// Entry is a log line builder carrying Fields.
+type Entry struct {
+	fields Fields
+}
+
+// SetLogFormat switches between human-readable text lines and one JSON
+// object per line.
+func SetLogFormat(format string) error {
+	switch format {
+	case "", TextFormat:
+		logger.json = false
+	case JSONFormat:
+		logger.json = true
+	default:
+		return fmt.Errorf("unsupported log format %q, expected %q or %q", format, TextFormat, JSONFormat)
+	}
+	return nil
+}
+
+// IsJSON reports whether log lines are emitted as JSON.
+func IsJSON() bool {
+	return logger.json
+}
+
+// SetCommand sets the command path attached to every JSON log line.
+func SetCommand(path string) {
+	logger.command = path
+}
+
+// WithFields returns an Entry which attaches fields to the logged line.
+func WithFields(fields Fields) Entry {
+	return Entry{fields: fields}
+}
+
+// Infof logs at info level.
+func (e Entry) Infof(format string, value ...any) {
+	if logger.json {
+		logger.emit(lorg.LevelInfo, e.fields, fmt.Sprintf(format, value...))
+		return
+	}
+	logger.Infof(format, value...)
+}
+
+// Debugf logs at debug level.
+func (e Entry) Debugf(format string, value ...any) {
+	if logger.json {
+		logger.emit(lorg.LevelDebug, e.fields, fmt.Sprintf(format, value...))
+		return
+	}
+	logger.Debugf(format, value...)
+}
+
+// Errorf logs at error level.
+func (e Entry) Errorf(format string, value ...any) {
+	if logger.json {
+		logger.emit(lorg.LevelError, e.fields, fmt.Sprintf(format, value...))
+		return
+	}
+	logger.Errorf(format, value...)
+}
+
+// emit writes a single JSON line. Redaction is applied to the message and
+// string fields before encoding, so patterns match the raw values rather
+// than their JSON-escaped form.
+func (log *RedactedLog) emit(level lorg.Level, fields Fields, message string) {
+	if log.GetLevel() < level {
+		return
+	}
+
+	record := make(map[string]any, len(fields)+4)
+	for key, value := range fields {
+		if text, ok := value.(string); ok && log.writer.enabled {
+			value = log.writer.redact(text)
+		}
+		record[key] = value
+	}
+	if log.writer.enabled {
+		message = log.writer.redact(message)
+	}
+	record["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+	record["level"] = strings.ToLower(level.String())
+	record["msg"] = message
+	if log.command != "" {
+		record["command"] = log.command
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		line, _ = json.Marshal(map[string]string{
+			"level": strings.ToLower(level.String()),
+			"msg":   message,
+			"error": err.Error(),
+		})
+	}
+
+	log.mutex.Lock()
+	defer log.mutex.Unlock()
+	_, _ = log.writer.output.Write(append(line, '\n'))
+}
@@ -0,0 +1,52 @@
+package rlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/kovetskiy/lorg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructuredLog(t *testing.T) {
+	Init()
+	ToggleRedact(true)
+	SetLevel(lorg.LevelInfo)
+	buf := new(bytes.Buffer)
+	SetOutput(buf)
+	HideString("s3cret-value")
+	HideRegexp(regexp.MustCompile(`"accessToken": "([^"]+)"`))
+
+	require.Error(t, SetLogFormat("xml"))
+	require.NoError(t, SetLogFormat(JSONFormat))
+	SetCommand("smartling-cli files push")
+
+	WithFields(Fields{
+		"file":        "a.json",
+		"http_status": 200,
+		"secret":      "s3cret-value",
+	}).Infof(`token "accessToken": "abcdefgh"`)
+	Debugf("filtered by level")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, "info", record["level"])
+	assert.Equal(t, "smartling-cli files push", record["command"])
+	assert.Equal(t, "a.json", record["file"])
+	assert.EqualValues(t, 200, record["http_status"])
+	assert.NotContains(t, lines[0], "s3cret-value")
+	assert.NotContains(t, lines[0], "abcdefgh")
+
+	buf.Reset()
+	require.NoError(t, SetLogFormat(TextFormat))
+	Infof("plain line")
+	assert.Contains(t, buf.String(), "plain line")
+	assert.NotContains(t, buf.String(), "{")
+}
@@ -0,0 +1,160 @@
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	jobsfiles "github.com/Smartling/smartling-cli/services/jobs/files"
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+	jobstrings "github.com/Smartling/smartling-cli/services/jobs/strings"
+)
+
+// SnapshotVersion is the version of the snapshot format written by RunExport.
+const SnapshotVersion = 1
+
+// Snapshot is the membership of a translation job at the time of export.
+type Snapshot struct {
+	Version    int                     `json:"version"`
+	ExportedAt time.Time               `json:"exportedAt"`
+	ProjectID  string                  `json:"projectId"`
+	Job        JobMetadata             `json:"job"`
+	Files      []jobsfiles.JobFileItem `json:"files"`
+	Strings    []jobstrings.Item       `json:"strings"`
+
+	JSON []byte `json:"-"`
+}
+
+// JobMetadata describes the exported job.
+type JobMetadata struct {
+	TranslationJobUID string     `json:"translationJobUid"`
+	JobName           string     `json:"jobName"`
+	Description       string     `json:"description,omitempty"`
+	ReferenceNumber   string     `json:"referenceNumber,omitempty"`
+	JobStatus         string     `json:"jobStatus"`
+	DueDate           *time.Time `json:"dueDate,omitempty"`
+	TargetLocaleIDs   []string   `json:"targetLocaleIds"`
+}
+
+// JSONBytes returns the JSON representation of the snapshot.
+func (s Snapshot) JSONBytes() []byte { return s.JSON }
+
+// SimpleLines returns a human-readable summary of the snapshot.
+func (s Snapshot) SimpleLines() []string {
+	return []string{fmt.Sprintf("Exported job %s (%s): %d locale(s), %d file(s), %d string(s)",
+		s.Job.TranslationJobUID, s.Job.JobName, len(s.Job.TargetLocaleIDs), len(s.Files), len(s.Strings))}
+}
+
+// TableData returns the snapshot summary as a single-row table.
+func (s Snapshot) TableData() ([]string, [][]string) {
+	return []string{"TRANSLATION JOB UID", "NAME", "LOCALES", "FILES", "STRINGS"},
+		[][]string{{
+			s.Job.TranslationJobUID,
+			s.Job.JobName,
+			strconv.Itoa(len(s.Job.TargetLocaleIDs)),
+			strconv.Itoa(len(s.Files)),
+			strconv.Itoa(len(s.Strings)),
+		}}
+}
+
+// Write writes the snapshot as indented JSON to the file at path, or to
+// stdout when path is "-".
+func (s Snapshot) Write(path string) error {
+	b := append(s.JSON, '\n')
+	if path == "-" {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+// ReadSnapshot reads a snapshot written by Write from the file at path, or
+// from stdin when path is "-".
+func ReadSnapshot(path string) (Snapshot, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return Snapshot{}, err
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+	var snapshot Snapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return Snapshot{}, fmt.Errorf("decode snapshot: %w", err)
+	}
+	switch {
+	case snapshot.Version != SnapshotVersion:
+		return Snapshot{}, fmt.Errorf("unsupported snapshot version %d, want %d", snapshot.Version, SnapshotVersion)
+	case snapshot.ProjectID == "" || snapshot.Job.TranslationJobUID == "":
+		return Snapshot{}, errors.New("snapshot has no project or job")
+	}
+	return snapshot, nil
+}
+
+// ExportParams carries the export request from CLI to service.
+type ExportParams struct {
+	ProjectID    string
+	JobUIDOrName string
+	Now          time.Time
+}
+
+// Validate checks that ExportParams carry the required fields.
+func (p ExportParams) Validate() error {
+	switch {
+	case p.ProjectID == "":
+		return errors.New("project ID is required")
+	case p.JobUIDOrName == "":
+		return errors.New("translation job UID or name is required")
+	}
+	return nil
+}
+
+// RunExport captures the job's metadata, target locales, files and strings.
+func (s service) RunExport(ctx context.Context, params ExportParams) (Snapshot, error) {
+	if err := params.Validate(); err != nil {
+		return Snapshot{}, err
+	}
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.JobUIDOrName)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	job, files, strs, err := s.membership(ctx, params.ProjectID, jobUID)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	now := params.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	snapshot := Snapshot{
+		Version:    SnapshotVersion,
+		ExportedAt: now.UTC().Truncate(time.Second),
+		ProjectID:  params.ProjectID,
+		Job: JobMetadata{
+			TranslationJobUID: job.TranslationJobUID,
+			JobName:           job.JobName,
+			Description:       job.Description,
+			ReferenceNumber:   job.ReferenceNumber,
+			JobStatus:         job.JobStatus,
+			TargetLocaleIDs:   job.TargetLocaleIDs,
+		},
+		Files:   files,
+		Strings: strs,
+	}
+	if !job.Dates.Due.IsZero() {
+		due := job.Dates.Due
+		snapshot.Job.DueDate = &due
+	}
+	if snapshot.JSON, err = json.MarshalIndent(snapshot, "", "  "); err != nil {
+		return Snapshot{}, fmt.Errorf("marshal snapshot: %w", err)
+	}
+	return snapshot, nil
+}
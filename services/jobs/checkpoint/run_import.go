@@ -0,0 +1,347 @@
+package checkpoint
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	jobsfiles "github.com/Smartling/smartling-cli/services/jobs/files"
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+	"github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+	"github.com/Smartling/smartling-cli/services/jobs/locales"
+	jobstrings "github.com/Smartling/smartling-cli/services/jobs/strings"
+
+	"github.com/gobwas/glob"
+)
+
+// Reconciliation categories.
+const (
+	CategoryLocales = "locales"
+	CategoryFiles   = "files"
+	CategoryStrings = "strings"
+)
+
+// ImportParams carries the import request from CLI to service.
+type ImportParams struct {
+	ProjectID string
+	Snapshot  Snapshot
+	// IntoJobUIDOrName is the existing job to import into; empty means the
+	// snapshot's own job.
+	IntoJobUIDOrName string
+	// NewJobName creates a job with the snapshot's metadata under this name
+	// and imports into it.
+	NewJobName string
+	Now        time.Time
+}
+
+// Validate checks that ImportParams carry the required fields.
+func (p ImportParams) Validate() error {
+	switch {
+	case p.ProjectID == "":
+		return errors.New("project ID is required")
+	case p.Snapshot.ProjectID != p.ProjectID:
+		return fmt.Errorf("snapshot was exported from project %s, not %s", p.Snapshot.ProjectID, p.ProjectID)
+	case p.IntoJobUIDOrName != "" && p.NewJobName != "":
+		return clierror.ErrIncompatibleParams("new-job", []string{"into"})
+	}
+	return nil
+}
+
+// Reconciliation compares one category of the snapshot with the target job
+// after the import.
+type Reconciliation struct {
+	Category string   `json:"category"`
+	Expected int      `json:"expected"`
+	Restored int      `json:"restored"`
+	Missing  []string `json:"missing,omitempty"`
+	Extra    []string `json:"extra,omitempty"`
+}
+
+// ImportOutput is the reconciliation report of an import.
+type ImportOutput struct {
+	ProjectUID        string           `json:"projectUid"`
+	SourceJobUID      string           `json:"sourceJobUid"`
+	TranslationJobUID string           `json:"translationJobUid"`
+	JobName           string           `json:"jobName"`
+	Created           bool             `json:"created"`
+	Reconciliation    []Reconciliation `json:"reconciliation"`
+	Errors            []string         `json:"errors,omitempty"`
+
+	JSON []byte `json:"-"`
+}
+
+// Missing returns the number of snapshot items absent from the target job.
+func (o ImportOutput) Missing() int {
+	missing := 0
+	for _, r := range o.Reconciliation {
+		missing += len(r.Missing)
+	}
+	return missing
+}
+
+// JSONBytes returns the JSON representation of the report.
+func (o ImportOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns a human-readable report.
+func (o ImportOutput) SimpleLines() []string {
+	verb := "Imported"
+	if o.Created {
+		verb = "Created job and imported"
+	}
+	lines := []string{fmt.Sprintf("%s snapshot of job %s into job %s (%s)", verb, o.SourceJobUID, o.TranslationJobUID, o.JobName)}
+	for _, r := range o.Reconciliation {
+		lines = append(lines, fmt.Sprintf("  %-8s %d of %d restored, %d missing, %d extra",
+			r.Category, r.Restored, r.Expected, len(r.Missing), len(r.Extra)))
+		for _, item := range r.Missing {
+			lines = append(lines, "    missing: "+item)
+		}
+		for _, item := range r.Extra {
+			lines = append(lines, "    extra:   "+item)
+		}
+	}
+	for _, e := range o.Errors {
+		lines = append(lines, "Error: "+e)
+	}
+	return lines
+}
+
+// TableData returns one row per reconciled category.
+func (o ImportOutput) TableData() ([]string, [][]string) {
+	headers := []string{"CATEGORY", "EXPECTED", "RESTORED", "MISSING", "EXTRA"}
+	rows := make([][]string, 0, len(o.Reconciliation))
+	for _, r := range o.Reconciliation {
+		rows = append(rows, []string{
+			r.Category,
+			strconv.Itoa(r.Expected),
+			strconv.Itoa(r.Restored),
+			strconv.Itoa(len(r.Missing)),
+			strconv.Itoa(len(r.Extra)),
+		})
+	}
+	return headers, rows
+}
+
+// RunImport replays the snapshot's locales, files and strings into the
+// target job through the add operations, then lists the target job again and
+// reports what was restored, what is missing and what the job has beyond the
+// snapshot. Strings which arrived with their files are not added again.
+// Missing items make the import a partial failure.
+func (s service) RunImport(ctx context.Context, params ImportParams) (ImportOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ImportOutput{}, err
+	}
+	snapshot := params.Snapshot
+	out := ImportOutput{ProjectUID: params.ProjectID, SourceJobUID: snapshot.Job.TranslationJobUID}
+
+	jobUID, err := s.importTarget(ctx, params, &out)
+	if err != nil {
+		return ImportOutput{}, err
+	}
+	job, err := s.job.GetJob(ctx, params.ProjectID, jobUID)
+	if err != nil {
+		return ImportOutput{}, err
+	}
+	out.TranslationJobUID = job.TranslationJobUID
+	out.JobName = job.JobName
+
+	for _, locale := range snapshot.Job.TargetLocaleIDs {
+		if slices.Contains(job.TargetLocaleIDs, locale) {
+			continue
+		}
+		_, err := s.locales.RunAdd(ctx, locales.AddParams{ProjectID: params.ProjectID, JobUIDOrName: jobUID, TargetLocaleID: locale})
+		if err != nil {
+			out.Errors = append(out.Errors, fmt.Sprintf("add locale %s: %s", locale, err))
+		}
+	}
+
+	fileGroups := groupByLocales(snapshot.Files, func(f jobsfiles.JobFileItem) (string, []string) {
+		return f.FileURI, f.LocaleIDs
+	})
+	for _, localeSet := range slices.Sorted(maps.Keys(fileGroups)) {
+		uris := fileGroups[localeSet]
+		patterns := make([]string, len(uris))
+		for i, uri := range uris {
+			patterns[i] = glob.QuoteMeta(uri)
+		}
+		added, err := s.files.RunAdd(ctx, jobsfiles.AddParams{
+			ProjectID:       params.ProjectID,
+			JobUIDOrName:    jobUID,
+			FilePatterns:    patterns,
+			TargetLocaleIDs: splitLocales(localeSet),
+		})
+		if err != nil {
+			out.Errors = append(out.Errors, fmt.Sprintf("add files: %s", err))
+			continue
+		}
+		for _, f := range added.Files {
+			if f.Error != "" {
+				out.Errors = append(out.Errors, fmt.Sprintf("add file %s: %s", f.FileURI, f.Error))
+			}
+		}
+	}
+
+	present := map[jobstrings.Item]bool{}
+	err = s.strings.RunListAll(ctx, jobstrings.ListParams{ProjectID: params.ProjectID, JobUIDOrName: jobUID}, func(it jobstrings.Item) error {
+		present[it] = true
+		return nil
+	})
+	if err != nil {
+		return ImportOutput{}, err
+	}
+	var pending []jobstrings.Item
+	for _, it := range snapshot.Strings {
+		if !present[it] {
+			pending = append(pending, it)
+		}
+	}
+	stringGroups := groupByLocales(pending, func(it jobstrings.Item) (string, []string) {
+		return it.Hashcode, []string{it.TargetLocaleID}
+	})
+	for _, localeSet := range slices.Sorted(maps.Keys(stringGroups)) {
+		hashcodes := stringGroups[localeSet]
+		_, err := s.strings.RunAdd(ctx, jobstrings.AddParams{
+			ProjectID:       params.ProjectID,
+			JobUIDOrName:    jobUID,
+			Hashcodes:       hashcodes,
+			TargetLocaleIDs: splitLocales(localeSet),
+		})
+		if err != nil {
+			out.Errors = append(out.Errors, fmt.Sprintf("add strings for %s: %s", localeSet, err))
+		}
+	}
+
+	job, files, strs, err := s.membership(ctx, params.ProjectID, jobUID)
+	if err != nil {
+		return ImportOutput{}, err
+	}
+	out.Reconciliation = []Reconciliation{
+		reconcile(CategoryLocales, snapshot.Job.TargetLocaleIDs, job.TargetLocaleIDs),
+		reconcile(CategoryFiles, fileKeys(snapshot.Files), fileKeys(files)),
+		reconcile(CategoryStrings, stringKeys(snapshot.Strings), stringKeys(strs)),
+	}
+	if out.JSON, err = json.Marshal(out); err != nil {
+		return ImportOutput{}, fmt.Errorf("marshal import report to JSON: %w", err)
+	}
+	if missing := out.Missing(); missing > 0 {
+		total := 0
+		for _, r := range out.Reconciliation {
+			total += r.Expected
+		}
+		return out, clierror.PartialFailureError{Failed: missing, Total: total}
+	}
+	return out, nil
+}
+
+// importTarget resolves or creates the job to import into.
+func (s service) importTarget(ctx context.Context, params ImportParams, out *ImportOutput) (string, error) {
+	meta := params.Snapshot.Job
+	if params.NewJobName == "" {
+		target := cmp.Or(params.IntoJobUIDOrName, meta.TranslationJobUID)
+		return jobresolver.GetJobUID(ctx, s.job, params.ProjectID, target)
+	}
+	now := params.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	create := lifecycle.CreateParams{
+		ProjectID:       params.ProjectID,
+		JobName:         params.NewJobName,
+		Description:     meta.Description,
+		ReferenceNumber: meta.ReferenceNumber,
+		TargetLocaleIDs: meta.TargetLocaleIDs,
+	}
+	// A due date in the past is rejected by the API.
+	if meta.DueDate != nil && meta.DueDate.After(now) {
+		create.DueDate = *meta.DueDate
+	}
+	created, err := s.lifecycle.RunCreate(ctx, create)
+	if err != nil {
+		return "", fmt.Errorf("create job %q: %w", params.NewJobName, err)
+	}
+	out.Created = true
+	return created.TranslationJobUID, nil
+}
+
+// groupByLocales groups item keys by their sorted, comma-joined locales, so
+// items sharing locales are added in one request. Keys keep snapshot order.
+func groupByLocales[T any](items []T, key func(T) (string, []string)) map[string][]string {
+	localesByKey := map[string][]string{}
+	var order []string
+	for _, item := range items {
+		k, itemLocales := key(item)
+		if _, ok := localesByKey[k]; !ok {
+			order = append(order, k)
+		}
+		localesByKey[k] = append(localesByKey[k], itemLocales...)
+	}
+	groups := map[string][]string{}
+	for _, k := range order {
+		keyLocales := slices.Sorted(slices.Values(localesByKey[k]))
+		set := strings.Join(slices.Compact(keyLocales), ",")
+		groups[set] = append(groups[set], k)
+	}
+	return groups
+}
+
+func splitLocales(set string) []string {
+	if set == "" {
+		return nil
+	}
+	return strings.Split(set, ",")
+}
+
+func fileKeys(files []jobsfiles.JobFileItem) []string {
+	keys := make([]string, 0, len(files))
+	for _, f := range files {
+		if len(f.LocaleIDs) == 0 {
+			keys = append(keys, f.FileURI)
+			continue
+		}
+		for _, locale := range f.LocaleIDs {
+			keys = append(keys, f.FileURI+" ["+locale+"]")
+		}
+	}
+	return keys
+}
+
+func stringKeys(strs []jobstrings.Item) []string {
+	keys := make([]string, len(strs))
+	for i, it := range strs {
+		keys[i] = it.Hashcode + " [" + it.TargetLocaleID + "]"
+	}
+	return keys
+}
+
+// reconcile compares the expected keys with the actual ones.
+func reconcile(category string, expected, actual []string) Reconciliation {
+	want := map[string]bool{}
+	for _, k := range expected {
+		want[k] = true
+	}
+	have := map[string]bool{}
+	for _, k := range actual {
+		have[k] = true
+	}
+	r := Reconciliation{Category: category, Expected: len(want)}
+	for _, k := range slices.Sorted(maps.Keys(want)) {
+		if have[k] {
+			r.Restored++
+		} else {
+			r.Missing = append(r.Missing, k)
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(have)) {
+		if !want[k] {
+			r.Extra = append(r.Extra, k)
+		}
+	}
+	return r
+}
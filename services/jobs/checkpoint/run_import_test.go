@@ -0,0 +1,159 @@
+package checkpoint
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	jobsfiles "github.com/Smartling/smartling-cli/services/jobs/files"
+	"github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+	"github.com/Smartling/smartling-cli/services/jobs/locales"
+	jobstrings "github.com/Smartling/smartling-cli/services/jobs/strings"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	jobfile "github.com/Smartling/api-sdk-go/api/job/file"
+	joblocale "github.com/Smartling/api-sdk-go/api/job/locale"
+	jobstring "github.com/Smartling/api-sdk-go/api/job/string"
+	smfile "github.com/Smartling/api-sdk-go/helpers/sm_file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	rlog.Init()
+	os.Exit(m.Run())
+}
+
+type testEnv struct {
+	service   Service
+	lifecycle lifecycle.Service
+	files     jobsfiles.Service
+	strings   jobstrings.Service
+}
+
+func newTestEnv(t *testing.T, fileURIs ...string) testEnv {
+	t.Helper()
+	server := httptest.NewServer(devserver.NewServer(devserver.Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		ProjectName:    "Project",
+		SourceLocaleID: "en-US",
+		TargetLocales:  []string{"de-DE", "fr-FR"},
+	}))
+	t.Cleanup(server.Close)
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret")
+	client.Client.BaseURL = server.URL
+
+	for _, uri := range fileURIs {
+		req := smfile.FileUploadRequest{File: []byte(`{"k": "v"}`), FileType: smfile.FileTypeJSON}
+		req.FileURI = uri
+		_, err := client.UploadFile(context.Background(), "project", req)
+		require.NoError(t, err)
+	}
+
+	job := jobapi.NewJob(client.Client)
+	env := testEnv{
+		lifecycle: lifecycle.NewService(lifecycle.NewAPI(client.Client), job),
+		files:     jobsfiles.NewService(jobfile.NewJobFile(client.Client), job, client.ListAllFiles),
+		strings:   jobstrings.NewService(jobstring.NewJobString(client.Client), job),
+	}
+	env.service = NewService(job, env.files, env.strings, locales.NewService(joblocale.NewJobLocale(client.Client), job), env.lifecycle)
+	return env
+}
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(t, "app/[a].json", "b.json", "c.json")
+
+	source, err := env.lifecycle.RunCreate(ctx, lifecycle.CreateParams{
+		ProjectID: "project", JobName: "Source", Description: "before refactor",
+		TargetLocaleIDs: []string{"de-DE", "fr-FR"},
+	})
+	require.NoError(t, err)
+	_, err = env.files.RunAdd(ctx, jobsfiles.AddParams{
+		ProjectID: "project", JobUIDOrName: "Source", FilePatterns: []string{"app/*.json"}, TargetLocaleIDs: []string{"de-DE"},
+	})
+	require.NoError(t, err)
+	_, err = env.files.RunAdd(ctx, jobsfiles.AddParams{ProjectID: "project", JobUIDOrName: "Source", FilePatterns: []string{"b.json"}})
+	require.NoError(t, err)
+	_, err = env.strings.RunAdd(ctx, jobstrings.AddParams{ProjectID: "project", JobUIDOrName: "Source", Hashcodes: []string{"h1", "h2"}})
+	require.NoError(t, err)
+	_, err = env.strings.RunAdd(ctx, jobstrings.AddParams{
+		ProjectID: "project", JobUIDOrName: "Source", Hashcodes: []string{"h3"}, TargetLocaleIDs: []string{"fr-FR"},
+	})
+	require.NoError(t, err)
+
+	snapshot, err := env.service.RunExport(ctx, ExportParams{ProjectID: "project", JobUIDOrName: "Source"})
+	require.NoError(t, err)
+	assert.Equal(t, source.TranslationJobUID, snapshot.Job.TranslationJobUID)
+	assert.Equal(t, "before refactor", snapshot.Job.Description)
+	assert.Len(t, snapshot.Files, 2)
+	assert.Len(t, snapshot.Strings, 5)
+
+	path := filepath.Join(t.TempDir(), "job.json")
+	require.NoError(t, snapshot.Write(path))
+	read, err := ReadSnapshot(path)
+	require.NoError(t, err)
+
+	out, err := env.service.RunImport(ctx, ImportParams{ProjectID: "project", Snapshot: read, NewJobName: "Restored"})
+	require.NoError(t, err)
+	assert.True(t, out.Created)
+	assert.Equal(t, "Restored", out.JobName)
+	assert.NotEqual(t, source.TranslationJobUID, out.TranslationJobUID)
+	assert.Empty(t, out.Errors)
+	for _, r := range out.Reconciliation {
+		assert.Equal(t, r.Expected, r.Restored, r.Category)
+		assert.Empty(t, r.Missing, r.Category)
+		assert.Empty(t, r.Extra, r.Category)
+	}
+	assert.Equal(t, []Reconciliation{
+		{Category: CategoryLocales, Expected: 2, Restored: 2},
+		{Category: CategoryFiles, Expected: 4, Restored: 4},
+		{Category: CategoryStrings, Expected: 5, Restored: 5},
+	}, out.Reconciliation)
+}
+
+func TestImport_IntoExistingJobReportsExtraAndMissing(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(t, "b.json", "c.json")
+
+	_, err := env.lifecycle.RunCreate(ctx, lifecycle.CreateParams{ProjectID: "project", JobName: "Target", TargetLocaleIDs: []string{"de-DE"}})
+	require.NoError(t, err)
+	_, err = env.files.RunAdd(ctx, jobsfiles.AddParams{ProjectID: "project", JobUIDOrName: "Target", FilePatterns: []string{"c.json"}})
+	require.NoError(t, err)
+
+	snapshot := Snapshot{
+		Version:   SnapshotVersion,
+		ProjectID: "project",
+		Job:       JobMetadata{TranslationJobUID: "aaaaaaaaaaaa", TargetLocaleIDs: []string{"de-DE", "fr-FR"}},
+		Files: []jobsfiles.JobFileItem{
+			{FileURI: "b.json", LocaleIDs: []string{"fr-FR"}},
+			{FileURI: "gone.json", LocaleIDs: []string{"fr-FR"}},
+		},
+	}
+	// Job files carry every job locale, so b.json gains de-DE and c.json fr-FR.
+	out, err := env.service.RunImport(ctx, ImportParams{ProjectID: "project", Snapshot: snapshot, IntoJobUIDOrName: "Target"})
+	require.Error(t, err)
+	assert.False(t, out.Created)
+	assert.Equal(t, Reconciliation{Category: CategoryLocales, Expected: 2, Restored: 2}, out.Reconciliation[0])
+	assert.Equal(t, Reconciliation{
+		Category: CategoryFiles,
+		Expected: 2,
+		Restored: 1,
+		Missing:  []string{"gone.json [fr-FR]"},
+		Extra:    []string{"b.json [de-DE]", "c.json [de-DE]", "c.json [fr-FR]"},
+	}, out.Reconciliation[1])
+	assert.Equal(t, 1, out.Missing())
+}
+
+func TestImportParams_Validate(t *testing.T) {
+	snapshot := Snapshot{ProjectID: "project"}
+	assert.NoError(t, ImportParams{ProjectID: "project", Snapshot: snapshot}.Validate())
+	assert.Error(t, ImportParams{ProjectID: "other", Snapshot: snapshot}.Validate())
+	assert.Error(t, ImportParams{ProjectID: "project", Snapshot: snapshot, IntoJobUIDOrName: "a", NewJobName: "b"}.Validate())
+}
@@ -0,0 +1,72 @@
+// Package checkpoint snapshots which locales, files and strings belong to a
+// translation job and replays a snapshot into the same or another job.
+package checkpoint
+
+import (
+	"context"
+
+	jobsfiles "github.com/Smartling/smartling-cli/services/jobs/files"
+	"github.com/Smartling/smartling-cli/services/jobs/lifecycle"
+	"github.com/Smartling/smartling-cli/services/jobs/locales"
+	jobstrings "github.com/Smartling/smartling-cli/services/jobs/strings"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+)
+
+// Service defines behavior for exporting and importing job snapshots.
+type Service interface {
+	RunExport(ctx context.Context, params ExportParams) (Snapshot, error)
+	RunImport(ctx context.Context, params ImportParams) (ImportOutput, error)
+}
+
+// NewService creates a new implementation of the Service. The job API reads
+// job metadata; the files, strings and locales services list and replay
+// membership; lifecycle creates the job a snapshot is imported into.
+func NewService(
+	job jobapi.Job,
+	files jobsfiles.Service,
+	strings jobstrings.Service,
+	locales locales.Service,
+	lifecycle lifecycle.Service,
+) Service {
+	return service{
+		job:       job,
+		files:     files,
+		strings:   strings,
+		locales:   locales,
+		lifecycle: lifecycle,
+	}
+}
+
+type service struct {
+	job       jobapi.Job
+	files     jobsfiles.Service
+	strings   jobstrings.Service
+	locales   locales.Service
+	lifecycle lifecycle.Service
+}
+
+// membership lists the locales, files and strings of a job.
+func (s service) membership(ctx context.Context, projectID, jobUID string) (jobapi.GetJobResponse, []jobsfiles.JobFileItem, []jobstrings.Item, error) {
+	job, err := s.job.GetJob(ctx, projectID, jobUID)
+	if err != nil {
+		return jobapi.GetJobResponse{}, nil, nil, err
+	}
+	files := []jobsfiles.JobFileItem{}
+	err = s.files.RunListAll(ctx, jobsfiles.ListParams{ProjectID: projectID, JobUIDOrName: jobUID}, func(f jobsfiles.JobFileItem) error {
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return jobapi.GetJobResponse{}, nil, nil, err
+	}
+	strs := []jobstrings.Item{}
+	err = s.strings.RunListAll(ctx, jobstrings.ListParams{ProjectID: projectID, JobUIDOrName: jobUID}, func(it jobstrings.Item) error {
+		strs = append(strs, it)
+		return nil
+	})
+	if err != nil {
+		return jobapi.GetJobResponse{}, nil, nil, err
+	}
+	return job, files, strs, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Smartling/smartling-cli/services/helpers/pager"
 	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
 
 	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
@@ -39,6 +40,11 @@ type JobFileItem struct {
 	LocaleIDs []string `json:"localeIds"`
 }
 
+// SimpleLine returns the file as a single human-readable line.
+func (f JobFileItem) SimpleLine() string {
+	return fmt.Sprintf("%s  %s", f.FileURI, strings.Join(f.LocaleIDs, ","))
+}
+
 // ListOutput is the result of listing a job's files.
 type ListOutput struct {
 	Files      []JobFileItem
@@ -70,7 +76,7 @@ func (o ListOutput) SimpleLines() []string {
 	}
 	lines := make([]string, 0, len(o.Files)+1)
 	for _, f := range o.Files {
-		lines = append(lines, fmt.Sprintf("%s  %s", f.FileURI, strings.Join(f.LocaleIDs, ",")))
+		lines = append(lines, f.SimpleLine())
 	}
 	if o.truncated() {
 		lines = append(lines, o.truncationNote())
@@ -129,6 +135,37 @@ func (s service) RunList(ctx context.Context, params ListParams) (ListOutput, er
 	return res, nil
 }
 
+// RunListAll resolves the job and walks every page of its files, at most
+// pager.DefaultConcurrency pages at a time, passing each file to emit in
+// listing order. Limit is used as the page size.
+func (s service) RunListAll(ctx context.Context, params ListParams, emit func(JobFileItem) error) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid list params: %w", err)
+	}
+
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.JobUIDOrName)
+	if err != nil {
+		return fmt.Errorf("resolve job UID: %w", err)
+	}
+
+	pageSize := params.Limit
+	if pageSize == 0 {
+		pageSize = DefaultListPageLimit
+	}
+	fetch := func(ctx context.Context, limit, offset uint32) (pager.Page[JobFileItem], error) {
+		page, err := s.jobFile.List(ctx, params.ProjectID, jobUID, limit, offset)
+		if err != nil {
+			return pager.Page[JobFileItem]{}, fmt.Errorf("list files for job %q: %w", jobUID, err)
+		}
+		files := make([]JobFileItem, len(page.Items))
+		for i, file := range page.Items {
+			files[i] = JobFileItem{FileURI: file.FileURI, LocaleIDs: file.LocaleIDs}
+		}
+		return pager.Page[JobFileItem]{Items: files, TotalCount: page.TotalCount}, nil
+	}
+	return pager.Walk(ctx, pager.Params{PageSize: pageSize}, fetch, emit)
+}
+
 // filesJSON is the JSON shape for jobs-files-list output, carrying pagination
 // metadata so consumers can detect truncated pages.
 type filesJSON struct {
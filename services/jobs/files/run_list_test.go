@@ -38,3 +38,24 @@ func TestRunList_ResolvesUIDAndReturnsFiles(t *testing.T) {
 	require.Equal(t, "/a.json", out.Files[0].FileURI)
 	require.Equal(t, 1, out.TotalCount)
 }
+
+func TestRunListAll_WalksAllPages(t *testing.T) {
+	job := jobmocks.NewMockJob(t)
+	job.On("GetJob", mock.Anything, "proj-1", "aabbccdd1122").
+		Return(jobapi.GetJobResponse{TranslationJobUID: "aabbccdd1122"}, nil)
+
+	file := filesdkmocks.NewMockJobFile(t)
+	file.On("List", mock.Anything, "proj-1", "aabbccdd1122", uint32(1), uint32(0)).
+		Return(jobfile.ListResponse{TotalCount: 2, Items: []jobfile.File{{FileURI: "/a.json"}}}, nil)
+	file.On("List", mock.Anything, "proj-1", "aabbccdd1122", uint32(1), uint32(1)).
+		Return(jobfile.ListResponse{TotalCount: 2, Items: []jobfile.File{{FileURI: "/b.json"}}}, nil)
+
+	s := service{job: job, jobFile: file}
+	var uris []string
+	err := s.RunListAll(context.Background(), ListParams{ProjectID: "proj-1", JobUIDOrName: "aabbccdd1122", Limit: 1}, func(f JobFileItem) error {
+		uris = append(uris, f.FileURI)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/a.json", "/b.json"}, uris)
+}
@@ -0,0 +1,244 @@
+package jobsfiles
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+
+	api "github.com/Smartling/api-sdk-go/api/job/file"
+)
+
+// Move statuses of a single file.
+const (
+	MoveStatusPlanned    = "planned"
+	MoveStatusMoved      = "moved"
+	MoveStatusFailed     = "failed"
+	MoveStatusRolledBack = "rolled back"
+	MoveStatusSkipped    = "skipped"
+)
+
+// MoveParams carries the move-files request from CLI to service.
+type MoveParams struct {
+	ProjectID        string
+	FromJobUIDOrName string
+	ToJobUIDOrName   string
+	FilePatterns     []string
+	// TargetLocaleIDs are the locales to add the files to in the target job;
+	// empty keeps the locales each file has in the source job.
+	TargetLocaleIDs []string
+	DryRun          bool
+}
+
+// Validate checks that MoveParams carry the required fields.
+func (p MoveParams) Validate() error {
+	if err := validateMutate(p.ProjectID, p.FromJobUIDOrName, p.FilePatterns); err != nil {
+		return err
+	}
+	switch {
+	case p.ToJobUIDOrName == "":
+		return errors.New("target translation job UID or name is required")
+	case p.ToJobUIDOrName == p.FromJobUIDOrName:
+		return errors.New("source and target jobs must differ")
+	}
+	return nil
+}
+
+// MoveFileResult is the outcome of moving a single file.
+type MoveFileResult struct {
+	FileURI   string   `json:"fileUri"`
+	LocaleIDs []string `json:"localeIds"`
+	Status    string   `json:"status"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// MoveOutput is the result of moving files between jobs.
+type MoveOutput struct {
+	ProjectUID     string           `json:"projectUid"`
+	FromJobUID     string           `json:"fromJobUid"`
+	ToJobUID       string           `json:"toJobUid"`
+	DryRun         bool             `json:"dryRun"`
+	Files          []MoveFileResult `json:"files"`
+	Unmatched      []string         `json:"unmatched,omitempty"`
+	RolledBack     bool             `json:"rolledBack"`
+	RollbackErrors []string         `json:"rollbackErrors,omitempty"`
+
+	JSON []byte `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the result.
+func (o MoveOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns a human-readable summary of the result.
+func (o MoveOutput) SimpleLines() []string {
+	verb := "Moved"
+	if o.DryRun {
+		verb = "Would move"
+	}
+	moved := 0
+	for _, f := range o.Files {
+		if f.Status == MoveStatusMoved || f.Status == MoveStatusPlanned {
+			moved++
+		}
+	}
+	lines := []string{fmt.Sprintf("%s %d file(s) from job %s to job %s", verb, moved, o.FromJobUID, o.ToJobUID)}
+	for _, f := range o.Files {
+		line := fmt.Sprintf("  %s [%s]: %s", f.FileURI, strings.Join(f.LocaleIDs, ","), f.Status)
+		if f.Error != "" {
+			line += ": " + f.Error
+		}
+		lines = append(lines, line)
+	}
+	for _, pattern := range o.Unmatched {
+		lines = append(lines, fmt.Sprintf("No files of job %s matched: %s", o.FromJobUID, pattern))
+	}
+	if o.RolledBack {
+		lines = append(lines, fmt.Sprintf("Move failed, files were returned to job %s.", o.FromJobUID))
+	}
+	for _, e := range o.RollbackErrors {
+		lines = append(lines, "Rollback error: "+e)
+	}
+	return lines
+}
+
+// TableData returns one row per file.
+func (o MoveOutput) TableData() ([]string, [][]string) {
+	headers := []string{"FILE URI", "LOCALES", "STATUS", "ERROR"}
+	rows := make([][]string, 0, len(o.Files))
+	for _, f := range o.Files {
+		rows = append(rows, []string{f.FileURI, strings.Join(f.LocaleIDs, ","), f.Status, f.Error})
+	}
+	return headers, rows
+}
+
+// RunMove moves files matching the given patterns from one job to another.
+// Patterns are matched against the source job's files. Each file is removed
+// from the source job and then added to the target job. When a file fails to
+// move, the files moved so far are removed from the target job and added
+// back to the source job, so the move either completes or leaves both jobs
+// as they were.
+func (s service) RunMove(ctx context.Context, params MoveParams) (MoveOutput, error) {
+	if err := params.Validate(); err != nil {
+		return MoveOutput{}, err
+	}
+	fromUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.FromJobUIDOrName)
+	if err != nil {
+		return MoveOutput{}, fmt.Errorf("resolve source job: %w", err)
+	}
+	toUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.ToJobUIDOrName)
+	if err != nil {
+		return MoveOutput{}, fmt.Errorf("resolve target job: %w", err)
+	}
+	if fromUID == toUID {
+		return MoveOutput{}, errors.New("source and target jobs must differ")
+	}
+
+	localesByURI := map[string][]string{}
+	var candidates []string
+	err = s.RunListAll(ctx, ListParams{ProjectID: params.ProjectID, JobUIDOrName: fromUID}, func(f JobFileItem) error {
+		localesByURI[f.FileURI] = f.LocaleIDs
+		candidates = append(candidates, f.FileURI)
+		return nil
+	})
+	if err != nil {
+		return MoveOutput{}, err
+	}
+	uris, unmatched, err := matchURIs(params.FilePatterns, candidates)
+	if err != nil {
+		return MoveOutput{}, err
+	}
+
+	out := MoveOutput{
+		ProjectUID: params.ProjectID,
+		FromJobUID: fromUID,
+		ToJobUID:   toUID,
+		DryRun:     params.DryRun,
+		Unmatched:  unmatched,
+	}
+	for _, uri := range uris {
+		locales := params.TargetLocaleIDs
+		if len(locales) == 0 {
+			locales = localesByURI[uri]
+		}
+		out.Files = append(out.Files, MoveFileResult{FileURI: uri, LocaleIDs: locales, Status: MoveStatusPlanned})
+	}
+	if params.DryRun {
+		return finishMove(out, nil)
+	}
+
+	for i := range out.Files {
+		f := &out.Files[i]
+		removed, moveErr := s.moveFile(ctx, params.ProjectID, fromUID, toUID, f.FileURI, f.LocaleIDs)
+		if moveErr != nil {
+			f.Status = MoveStatusFailed
+			f.Error = moveErr.Error()
+			for j := i + 1; j < len(out.Files); j++ {
+				out.Files[j].Status = MoveStatusSkipped
+			}
+			// A file which never left the source job needs no rollback.
+			last := i
+			if !removed {
+				last--
+			}
+			s.rollbackMove(ctx, params.ProjectID, fromUID, toUID, localesByURI, &out, last, i)
+			return finishMove(out, fmt.Errorf("move %q: %w", f.FileURI, moveErr))
+		}
+		f.Status = MoveStatusMoved
+	}
+	return finishMove(out, nil)
+}
+
+// moveFile removes the file from the source job and adds it to the target
+// job. Strings the target job refuses count as a failure. It reports whether
+// the file was removed from the source job.
+func (s service) moveFile(ctx context.Context, projectID, fromUID, toUID, uri string, locales []string) (bool, error) {
+	if _, err := s.jobFile.Remove(ctx, projectID, fromUID, api.RemoveRequest{FileURI: uri}); err != nil {
+		return false, fmt.Errorf("remove from job %s: %w", fromUID, err)
+	}
+	res, err := s.jobFile.Add(ctx, projectID, toUID, api.AddRequest{FileURI: uri, TargetLocaleIDs: locales})
+	if err != nil {
+		return true, fmt.Errorf("add to job %s: %w", toUID, err)
+	}
+	if res.FailCount > 0 {
+		return true, fmt.Errorf("add to job %s: %d string(s) failed", toUID, res.FailCount)
+	}
+	return true, nil
+}
+
+// rollbackMove returns files last..0 to the source job with their original
+// locales. The failed file may be only partially added to the target job, so
+// an error removing it from there is expected and ignored.
+func (s service) rollbackMove(ctx context.Context, projectID, fromUID, toUID string, localesByURI map[string][]string, out *MoveOutput, last, failed int) {
+	out.RolledBack = last >= 0
+	for i := last; i >= 0; i-- {
+		f := &out.Files[i]
+		if _, err := s.jobFile.Remove(ctx, projectID, toUID, api.RemoveRequest{FileURI: f.FileURI}); err != nil && i != failed {
+			out.RollbackErrors = append(out.RollbackErrors, fmt.Sprintf("remove %q from job %s: %s", f.FileURI, toUID, err))
+			continue
+		}
+		req := api.AddRequest{FileURI: f.FileURI, TargetLocaleIDs: localesByURI[f.FileURI]}
+		if _, err := s.jobFile.Add(ctx, projectID, fromUID, req); err != nil {
+			out.RollbackErrors = append(out.RollbackErrors, fmt.Sprintf("add %q back to job %s: %s", f.FileURI, fromUID, err))
+			continue
+		}
+		if i != failed {
+			f.Status = MoveStatusRolledBack
+		}
+	}
+}
+
+func finishMove(out MoveOutput, moveErr error) (MoveOutput, error) {
+	b, err := json.Marshal(out)
+	if err != nil {
+		return MoveOutput{}, fmt.Errorf("marshal move result to JSON: %w", err)
+	}
+	out.JSON = b
+	if moveErr != nil && len(out.RollbackErrors) > 0 {
+		moveErr = errors.Join(moveErr, clierror.PartialFailureError{Failed: len(out.RollbackErrors), Total: len(out.Files)})
+	}
+	return out, moveErr
+}
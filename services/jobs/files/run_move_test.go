@@ -0,0 +1,132 @@
+package jobsfiles
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	filesdkmocks "github.com/Smartling/smartling-cli/services/jobs/files/sdkmocks"
+	jobsdkmocks "github.com/Smartling/smartling-cli/services/jobs/sdkmocks"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	api "github.com/Smartling/api-sdk-go/api/job/file"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	moveFromUID = "fromjob00001"
+	moveToUID   = "tojob0000001"
+)
+
+func newMoveService(t *testing.T, uris ...string) (service, *filesdkmocks.MockJobFile) {
+	t.Helper()
+	job := jobsdkmocks.NewMockJob(t)
+	for _, uid := range []string{moveFromUID, moveToUID} {
+		job.EXPECT().GetJob(mock.Anything, addProjectUID, uid).Return(jobapi.GetJobResponse{TranslationJobUID: uid}, nil)
+	}
+	file := filesdkmocks.NewMockJobFile(t)
+	items := make([]api.File, len(uris))
+	for i, uri := range uris {
+		items[i] = api.File{FileURI: uri, LocaleIDs: []string{"fr-FR"}}
+	}
+	file.EXPECT().List(mock.Anything, addProjectUID, moveFromUID, uint32(DefaultListPageLimit), uint32(0)).
+		Return(api.ListResponse{Items: items, TotalCount: len(items)}, nil)
+	return service{jobFile: file, job: job}, file
+}
+
+func TestRunMove(t *testing.T) {
+	ctx := context.Background()
+	params := MoveParams{
+		ProjectID:        addProjectUID,
+		FromJobUIDOrName: moveFromUID,
+		ToJobUIDOrName:   moveToUID,
+		FilePatterns:     []string{"*.json", "*.xml"},
+	}
+
+	t.Run("dry run makes no changes", func(t *testing.T) {
+		s, _ := newMoveService(t, "a.json", "b.json", "c.txt")
+		p := params
+		p.DryRun = true
+		got, err := s.RunMove(ctx, p)
+		if err != nil {
+			t.Fatalf("RunMove: %v", err)
+		}
+		if len(got.Files) != 2 || got.Files[0].Status != MoveStatusPlanned || got.Files[1].FileURI != "b.json" {
+			t.Errorf("files = %+v, want a.json,b.json planned", got.Files)
+		}
+		if len(got.Unmatched) != 1 || got.Unmatched[0] != "*.xml" {
+			t.Errorf("unmatched = %v, want [*.xml]", got.Unmatched)
+		}
+	})
+
+	t.Run("removes from source and adds to target keeping locales", func(t *testing.T) {
+		s, file := newMoveService(t, "a.json")
+		file.EXPECT().Remove(ctx, addProjectUID, moveFromUID, api.RemoveRequest{FileURI: "a.json"}).Return(api.Result{SuccessCount: 1}, nil)
+		file.EXPECT().Add(ctx, addProjectUID, moveToUID, api.AddRequest{FileURI: "a.json", TargetLocaleIDs: []string{"fr-FR"}}).
+			Return(api.Result{SuccessCount: 1}, nil)
+		got, err := s.RunMove(ctx, params)
+		if err != nil {
+			t.Fatalf("RunMove: %v", err)
+		}
+		if got.Files[0].Status != MoveStatusMoved || got.RolledBack {
+			t.Errorf("got %+v, want moved without rollback", got)
+		}
+	})
+
+	t.Run("failure rolls back moved files", func(t *testing.T) {
+		s, file := newMoveService(t, "a.json", "b.json")
+		for _, uri := range []string{"a.json", "b.json"} {
+			file.EXPECT().Remove(ctx, addProjectUID, moveFromUID, api.RemoveRequest{FileURI: uri}).Return(api.Result{SuccessCount: 1}, nil).Once()
+		}
+		file.EXPECT().Add(ctx, addProjectUID, moveToUID, api.AddRequest{FileURI: "a.json", TargetLocaleIDs: []string{"fr-FR"}}).
+			Return(api.Result{SuccessCount: 1}, nil).Once()
+		file.EXPECT().Add(ctx, addProjectUID, moveToUID, api.AddRequest{FileURI: "b.json", TargetLocaleIDs: []string{"fr-FR"}}).
+			Return(api.Result{}, errors.New("boom")).Once()
+		// Rollback: remove from target, add back to source.
+		file.EXPECT().Remove(ctx, addProjectUID, moveToUID, api.RemoveRequest{FileURI: "b.json"}).Return(api.Result{}, errors.New("not in job")).Once()
+		file.EXPECT().Remove(ctx, addProjectUID, moveToUID, api.RemoveRequest{FileURI: "a.json"}).Return(api.Result{SuccessCount: 1}, nil).Once()
+		for _, uri := range []string{"a.json", "b.json"} {
+			file.EXPECT().Add(ctx, addProjectUID, moveFromUID, api.AddRequest{FileURI: uri, TargetLocaleIDs: []string{"fr-FR"}}).
+				Return(api.Result{SuccessCount: 1}, nil).Once()
+		}
+
+		got, err := s.RunMove(ctx, params)
+		if err == nil {
+			t.Fatal("expected move error")
+		}
+		if !got.RolledBack || len(got.RollbackErrors) != 0 {
+			t.Errorf("RolledBack = %v, RollbackErrors = %v, want clean rollback", got.RolledBack, got.RollbackErrors)
+		}
+		if got.Files[0].Status != MoveStatusRolledBack || got.Files[1].Status != MoveStatusFailed {
+			t.Errorf("files = %+v, want rolled back / failed", got.Files)
+		}
+		if code := clierror.ExitCode(err); code != clierror.ExitGeneral {
+			t.Errorf("ExitCode = %d, want %d", code, clierror.ExitGeneral)
+		}
+	})
+
+	t.Run("failed rollback is a partial failure", func(t *testing.T) {
+		s, file := newMoveService(t, "a.json")
+		file.EXPECT().Remove(ctx, addProjectUID, moveFromUID, api.RemoveRequest{FileURI: "a.json"}).Return(api.Result{SuccessCount: 1}, nil)
+		file.EXPECT().Add(ctx, addProjectUID, moveToUID, mock.Anything).Return(api.Result{FailCount: 1}, nil)
+		file.EXPECT().Remove(ctx, addProjectUID, moveToUID, mock.Anything).Return(api.Result{}, nil)
+		file.EXPECT().Add(ctx, addProjectUID, moveFromUID, mock.Anything).Return(api.Result{}, errors.New("boom"))
+
+		got, err := s.RunMove(ctx, params)
+		if len(got.RollbackErrors) != 1 {
+			t.Errorf("RollbackErrors = %v, want one", got.RollbackErrors)
+		}
+		if code := clierror.ExitCode(err); code != clierror.ExitPartial {
+			t.Errorf("ExitCode = %d, want %d", code, clierror.ExitPartial)
+		}
+	})
+
+	t.Run("same job is rejected", func(t *testing.T) {
+		p := params
+		p.ToJobUIDOrName = moveFromUID
+		if _, err := (service{}).RunMove(ctx, p); err == nil {
+			t.Fatal("expected validation error")
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	globfiles "github.com/Smartling/smartling-cli/services/helpers/glob_files"
+	"github.com/Smartling/smartling-cli/services/helpers/result"
 
 	jobapi "github.com/Smartling/api-sdk-go/api/job"
 	api "github.com/Smartling/api-sdk-go/api/job/file"
@@ -18,8 +19,10 @@ import (
 // Service defines behavior for managing a translation job's files.
 type Service interface {
 	RunList(ctx context.Context, params ListParams) (ListOutput, error)
+	RunListAll(ctx context.Context, params ListParams, emit func(JobFileItem) error) error
 	RunAdd(ctx context.Context, params AddParams) (MutateOutput, error)
 	RunRemove(ctx context.Context, params RemoveParams) (MutateOutput, error)
+	RunMove(ctx context.Context, params MoveParams) (MoveOutput, error)
 }
 
 // NewService creates a new implementation of the Service. jobFile performs the
@@ -93,6 +96,26 @@ func (o MutateOutput) FailedFileURIs() []string {
 	return failed
 }
 
+// Report returns per-file results: files with an error or failed locales are
+// failed, patterns which matched nothing are skipped.
+func (o MutateOutput) Report() *result.Report {
+	report := result.NewReport(o.Action)
+	for _, f := range o.Files {
+		switch {
+		case f.Error != "":
+			report.Failed(f.FileURI, "", errors.New(f.Error))
+		case f.FailCount > 0:
+			report.Failed(f.FileURI, "", fmt.Errorf("%d locale(s) failed", f.FailCount))
+		default:
+			report.OK(f.FileURI, "")
+		}
+	}
+	for _, pattern := range o.Unmatched {
+		report.Skipped(pattern, "", "no files matched")
+	}
+	return report
+}
+
 // SimpleLines returns a human-readable summary of the result.
 func (o MutateOutput) SimpleLines() []string {
 	lines := []string{fmt.Sprintf("Files %s — job %s: %d succeeded, %d failed across %d file(s)",
@@ -130,6 +153,16 @@ func (s service) resolveURIs(ctx context.Context, projectID string, patterns []s
 		return nil, nil, fmt.Errorf("unable to list project files: %w", err)
 	}
 
+	candidates := make([]string, len(files))
+	for i, f := range files {
+		candidates[i] = f.FileURI
+	}
+	return matchURIs(patterns, candidates)
+}
+
+// matchURIs matches the glob patterns against candidate URIs, returning
+// matched URIs (deduped, in pattern order) and patterns that matched nothing.
+func matchURIs(patterns, candidates []string) (uris, unmatched []string, err error) {
 	seen := map[string]bool{}
 	for _, pattern := range patterns {
 		g, err := glob.Compile(pattern, '/')
@@ -137,14 +170,14 @@ func (s service) resolveURIs(ctx context.Context, projectID string, patterns []s
 			return nil, nil, fmt.Errorf("invalid --file pattern %q: %w", pattern, err)
 		}
 		matched := false
-		for _, f := range files {
-			if !g.Match(f.FileURI) {
+		for _, uri := range candidates {
+			if !g.Match(uri) {
 				continue
 			}
 			matched = true
-			if !seen[f.FileURI] {
-				seen[f.FileURI] = true
-				uris = append(uris, f.FileURI)
+			if !seen[uri] {
+				seen[uri] = true
+				uris = append(uris, uri)
 			}
 		}
 		if !matched {
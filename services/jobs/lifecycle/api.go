@@ -0,0 +1,181 @@
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/helpers/client"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	smclient "github.com/Smartling/api-sdk-go/helpers/sm_client"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+)
+
+const jobBasePath = "/jobs-api/v3/projects/"
+
+// CustomField is a job custom field value, addressed by field UID.
+type CustomField struct {
+	FieldUID   string `json:"fieldUid"`
+	FieldValue string `json:"fieldValue"`
+}
+
+// CreateRequest is the payload of the create job call.
+type CreateRequest struct {
+	JobName         string        `json:"jobName"`
+	TargetLocaleIDs []string      `json:"targetLocaleIds,omitempty"`
+	Description     string        `json:"description,omitempty"`
+	DueDate         *time.Time    `json:"dueDate,omitempty"`
+	ReferenceNumber string        `json:"referenceNumber,omitempty"`
+	CallbackURL     string        `json:"callbackUrl,omitempty"`
+	CallbackMethod  string        `json:"callbackMethod,omitempty"`
+	CustomFields    []CustomField `json:"customFields,omitempty"`
+}
+
+// UpdateRequest is the payload of the update job call. The API replaces
+// job attributes, so every field must carry the desired value.
+type UpdateRequest struct {
+	JobName         string        `json:"jobName"`
+	Description     string        `json:"description"`
+	DueDate         *time.Time    `json:"dueDate"`
+	ReferenceNumber string        `json:"referenceNumber"`
+	CustomFields    []CustomField `json:"customFields,omitempty"`
+}
+
+// LocaleWorkflow selects a target locale (and optionally its workflow) to authorize.
+type LocaleWorkflow struct {
+	TargetLocaleID string `json:"targetLocaleId"`
+	WorkflowUID    string `json:"workflowUid,omitempty"`
+}
+
+// AuthorizeRequest is the payload of the authorize job call. Empty
+// LocaleWorkflows authorizes all job locales.
+type AuthorizeRequest struct {
+	LocaleWorkflows []LocaleWorkflow `json:"localeWorkflows,omitempty"`
+}
+
+// API defines job lifecycle calls which are missing from the SDK job API.
+type API interface {
+	Create(ctx context.Context, projectID string, req CreateRequest) (string, error)
+	Update(ctx context.Context, projectID, jobUID string, req UpdateRequest) error
+	Authorize(ctx context.Context, projectID, jobUID string, req AuthorizeRequest) error
+	Cancel(ctx context.Context, projectID, jobUID, reason string) error
+	Close(ctx context.Context, projectID, jobUID string) error
+	Delete(ctx context.Context, projectID, jobUID string) error
+}
+
+// NewAPI returns new API implementation
+func NewAPI(client *smclient.Client) API {
+	return httpAPI{client: client}
+}
+
+type httpAPI struct {
+	client *smclient.Client
+}
+
+// Create creates a job and returns its UID.
+func (h httpAPI) Create(ctx context.Context, projectID string, req CreateRequest) (string, error) {
+	if projectID == "" {
+		return "", smerror.ErrEmptyParam("projectID")
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal create job request: %w", err)
+	}
+	var data struct {
+		TranslationJobUID string `json:"translationJobUid"`
+	}
+	if _, _, err := h.client.PostJSON(ctx, path.Join(jobBasePath, url.PathEscape(projectID), "jobs"), payload, &data); err != nil {
+		return "", fmt.Errorf("failed to create job: %w", err)
+	}
+	return data.TranslationJobUID, nil
+}
+
+// Update replaces job attributes.
+func (h httpAPI) Update(ctx context.Context, projectID, jobUID string, req UpdateRequest) error {
+	if err := requireParams(projectID, jobUID); err != nil {
+		return err
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal update job request: %w", err)
+	}
+	code, err := client.PutJSON(ctx, h.client, jobURL(projectID, jobUID), payload, nil)
+	if err != nil && code == http.StatusNotFound {
+		return jobapi.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+	return nil
+}
+
+// Authorize authorizes the job for translation.
+func (h httpAPI) Authorize(ctx context.Context, projectID, jobUID string, req AuthorizeRequest) error {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal authorize job request: %w", err)
+	}
+	return h.post(ctx, projectID, jobUID, "authorize", payload)
+}
+
+// Cancel cancels the job with the given reason.
+func (h httpAPI) Cancel(ctx context.Context, projectID, jobUID, reason string) error {
+	payload, err := json.Marshal(map[string]string{"reason": reason})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cancel job request: %w", err)
+	}
+	return h.post(ctx, projectID, jobUID, "cancel", payload)
+}
+
+// Close closes a completed job.
+func (h httpAPI) Close(ctx context.Context, projectID, jobUID string) error {
+	return h.post(ctx, projectID, jobUID, "close", nil)
+}
+
+// Delete deletes the job.
+func (h httpAPI) Delete(ctx context.Context, projectID, jobUID string) error {
+	if err := requireParams(projectID, jobUID); err != nil {
+		return err
+	}
+	_, code, err := h.client.DeleteJSON(ctx, jobURL(projectID, jobUID), nil)
+	if err != nil && code == http.StatusNotFound {
+		return jobapi.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete job: %w", err)
+	}
+	return nil
+}
+
+func (h httpAPI) post(ctx context.Context, projectID, jobUID, action string, payload []byte) error {
+	if err := requireParams(projectID, jobUID); err != nil {
+		return err
+	}
+	_, code, err := h.client.PostJSON(ctx, path.Join(jobURL(projectID, jobUID), action), payload, nil)
+	if err != nil && code == http.StatusNotFound {
+		return jobapi.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s job: %w", action, err)
+	}
+	return nil
+}
+
+func jobURL(projectID, jobUID string) string {
+	return path.Join(jobBasePath, url.PathEscape(projectID), "jobs", url.PathEscape(jobUID))
+}
+
+func requireParams(projectID, jobUID string) error {
+	switch {
+	case projectID == "":
+		return smerror.ErrEmptyParam("projectID")
+	case jobUID == "":
+		return smerror.ErrEmptyParam("jobUID")
+	}
+	return nil
+}
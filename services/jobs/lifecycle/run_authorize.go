@@ -0,0 +1,33 @@
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+)
+
+// AuthorizeParams carries the authorize-job request from CLI to service.
+type AuthorizeParams struct {
+	JobParams
+	// LocaleIDs limits authorization to given locales; empty means all job locales.
+	LocaleIDs []string
+}
+
+// RunAuthorize authorizes a job for translation.
+func (s service) RunAuthorize(ctx context.Context, params AuthorizeParams) (Output, error) {
+	if err := params.Validate(); err != nil {
+		return Output{}, err
+	}
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.JobUIDOrName)
+	if err != nil {
+		return Output{}, err
+	}
+	var req AuthorizeRequest
+	for _, locale := range params.LocaleIDs {
+		req.LocaleWorkflows = append(req.LocaleWorkflows, LocaleWorkflow{TargetLocaleID: locale})
+	}
+	if err := s.lifecycle.Authorize(ctx, params.ProjectID, jobUID, req); err != nil {
+		return Output{}, err
+	}
+	return newOutput("authorized", params.ProjectID, jobUID, params.LocaleIDs, "")
+}
@@ -0,0 +1,28 @@
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+)
+
+// CancelParams carries the cancel-job request from CLI to service.
+type CancelParams struct {
+	JobParams
+	Reason string
+}
+
+// RunCancel cancels a job.
+func (s service) RunCancel(ctx context.Context, params CancelParams) (Output, error) {
+	if err := params.Validate(); err != nil {
+		return Output{}, err
+	}
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.JobUIDOrName)
+	if err != nil {
+		return Output{}, err
+	}
+	if err := s.lifecycle.Cancel(ctx, params.ProjectID, jobUID, params.Reason); err != nil {
+		return Output{}, err
+	}
+	return newOutput("cancelled", params.ProjectID, jobUID, nil, params.Reason)
+}
@@ -0,0 +1,22 @@
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+)
+
+// RunClose closes a completed job.
+func (s service) RunClose(ctx context.Context, params JobParams) (Output, error) {
+	if err := params.Validate(); err != nil {
+		return Output{}, err
+	}
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.JobUIDOrName)
+	if err != nil {
+		return Output{}, err
+	}
+	if err := s.lifecycle.Close(ctx, params.ProjectID, jobUID); err != nil {
+		return Output{}, err
+	}
+	return newOutput("closed", params.ProjectID, jobUID, nil, "")
+}
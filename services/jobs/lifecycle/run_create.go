@@ -0,0 +1,61 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	"github.com/Smartling/smartling-cli/services/jobs"
+)
+
+// CreateParams carries the create-job request from CLI to service.
+type CreateParams struct {
+	ProjectID       string
+	JobName         string
+	Description     string
+	DueDate         time.Time
+	TargetLocaleIDs []string
+	ReferenceNumber string
+	CallbackURL     string
+	CustomFields    map[string]string
+}
+
+// Validate checks that CreateParams carry the required fields.
+func (p CreateParams) Validate() error {
+	switch {
+	case p.ProjectID == "":
+		return errors.New("project ID is required")
+	case p.JobName == "":
+		return errors.New("job name is required")
+	}
+	return nil
+}
+
+// RunCreate creates a translation job and returns its details.
+func (s service) RunCreate(ctx context.Context, params CreateParams) (jobs.ViewOutput, error) {
+	if err := params.Validate(); err != nil {
+		return jobs.ViewOutput{}, err
+	}
+	rlog.Debugf("running jobs create with params: %+v", params)
+
+	req := CreateRequest{
+		JobName:         params.JobName,
+		TargetLocaleIDs: params.TargetLocaleIDs,
+		Description:     params.Description,
+		ReferenceNumber: params.ReferenceNumber,
+		CallbackURL:     params.CallbackURL,
+		CustomFields:    customFields(params.CustomFields),
+	}
+	if params.CallbackURL != "" {
+		req.CallbackMethod = "GET"
+	}
+	if !params.DueDate.IsZero() {
+		req.DueDate = &params.DueDate
+	}
+	jobUID, err := s.lifecycle.Create(ctx, params.ProjectID, req)
+	if err != nil {
+		return jobs.ViewOutput{}, err
+	}
+	return s.view(ctx, params.ProjectID, jobUID)
+}
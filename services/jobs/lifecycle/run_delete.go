@@ -0,0 +1,22 @@
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+)
+
+// RunDelete deletes a job.
+func (s service) RunDelete(ctx context.Context, params JobParams) (Output, error) {
+	if err := params.Validate(); err != nil {
+		return Output{}, err
+	}
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.JobUIDOrName)
+	if err != nil {
+		return Output{}, err
+	}
+	if err := s.lifecycle.Delete(ctx, params.ProjectID, jobUID); err != nil {
+		return Output{}, err
+	}
+	return newOutput("deleted", params.ProjectID, jobUID, nil, "")
+}
@@ -0,0 +1,83 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	"github.com/Smartling/smartling-cli/services/jobs"
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+)
+
+// UpdateParams carries the update-job request from CLI to service.
+// Nil fields keep their current values.
+type UpdateParams struct {
+	JobParams
+	JobName         *string
+	Description     *string
+	DueDate         *time.Time
+	ReferenceNumber *string
+	CustomFields    map[string]string
+}
+
+// Validate checks that UpdateParams carry the required fields and at least one change.
+func (p UpdateParams) Validate() error {
+	if err := p.JobParams.Validate(); err != nil {
+		return err
+	}
+	if p.JobName == nil && p.Description == nil && p.DueDate == nil &&
+		p.ReferenceNumber == nil && len(p.CustomFields) == 0 {
+		return errors.New("nothing to update")
+	}
+	if p.JobName != nil && *p.JobName == "" {
+		return errors.New("job name cannot be empty")
+	}
+	return nil
+}
+
+// RunUpdate changes given attributes of a job and returns its details.
+// The API replaces all attributes, so current values are read first.
+func (s service) RunUpdate(ctx context.Context, params UpdateParams) (jobs.ViewOutput, error) {
+	if err := params.Validate(); err != nil {
+		return jobs.ViewOutput{}, err
+	}
+	rlog.Debugf("running jobs update with params: %+v", params)
+
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.JobUIDOrName)
+	if err != nil {
+		return jobs.ViewOutput{}, err
+	}
+	current, err := s.job.GetJob(ctx, params.ProjectID, jobUID)
+	if err != nil {
+		return jobs.ViewOutput{}, fmt.Errorf("get job %q: %w", jobUID, err)
+	}
+
+	req := UpdateRequest{
+		JobName:         current.JobName,
+		Description:     current.Description,
+		ReferenceNumber: current.ReferenceNumber,
+		CustomFields:    customFields(params.CustomFields),
+	}
+	if !current.Dates.Due.IsZero() {
+		req.DueDate = &current.Dates.Due
+	}
+	if params.JobName != nil {
+		req.JobName = *params.JobName
+	}
+	if params.Description != nil {
+		req.Description = *params.Description
+	}
+	if params.ReferenceNumber != nil {
+		req.ReferenceNumber = *params.ReferenceNumber
+	}
+	if params.DueDate != nil {
+		req.DueDate = params.DueDate
+	}
+
+	if err := s.lifecycle.Update(ctx, params.ProjectID, jobUID, req); err != nil {
+		return jobs.ViewOutput{}, err
+	}
+	return s.view(ctx, params.ProjectID, jobUID)
+}
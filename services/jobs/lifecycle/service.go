@@ -0,0 +1,129 @@
+// Package lifecycle creates translation jobs and moves them through their
+// states: update, authorize, cancel, close and delete.
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Smartling/smartling-cli/services/jobs"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+)
+
+// Service defines behavior for managing the lifecycle of translation jobs.
+type Service interface {
+	RunCreate(ctx context.Context, params CreateParams) (jobs.ViewOutput, error)
+	RunUpdate(ctx context.Context, params UpdateParams) (jobs.ViewOutput, error)
+	RunAuthorize(ctx context.Context, params AuthorizeParams) (Output, error)
+	RunCancel(ctx context.Context, params CancelParams) (Output, error)
+	RunClose(ctx context.Context, params JobParams) (Output, error)
+	RunDelete(ctx context.Context, params JobParams) (Output, error)
+}
+
+// NewService creates a new implementation of the Service. The job API is used to
+// resolve a job UID from a UID-or-name and to read job details back.
+func NewService(lifecycle API, job jobapi.Job) Service {
+	return service{
+		lifecycle: lifecycle,
+		job:       job,
+	}
+}
+
+type service struct {
+	lifecycle API
+	job       jobapi.Job
+}
+
+// JobParams identifies the job to act upon.
+type JobParams struct {
+	ProjectID    string
+	JobUIDOrName string
+}
+
+// Validate checks that JobParams carry the required fields.
+func (p JobParams) Validate() error {
+	switch {
+	case p.ProjectID == "":
+		return errors.New("project ID is required")
+	case p.JobUIDOrName == "":
+		return errors.New("translation job UID or name is required")
+	}
+	return nil
+}
+
+// Output is the result of a job state transition.
+type Output struct {
+	Action            string   `json:"action"`
+	ProjectUID        string   `json:"projectUid"`
+	TranslationJobUID string   `json:"translationJobUid"`
+	LocaleIDs         []string `json:"localeIds,omitempty"`
+	Reason            string   `json:"reason,omitempty"`
+
+	JSON []byte `json:"-"`
+}
+
+func newOutput(action, projectUID, jobUID string, localeIDs []string, reason string) (Output, error) {
+	o := Output{
+		Action:            action,
+		ProjectUID:        projectUID,
+		TranslationJobUID: jobUID,
+		LocaleIDs:         localeIDs,
+		Reason:            reason,
+	}
+	var err error
+	if o.JSON, err = json.Marshal(o); err != nil {
+		return Output{}, err
+	}
+	return o, nil
+}
+
+// JSONBytes returns the JSON representation of the result.
+func (o Output) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns a human-readable summary of the result.
+func (o Output) SimpleLines() []string {
+	line := fmt.Sprintf("Job %s %s", o.TranslationJobUID, o.Action)
+	if len(o.LocaleIDs) > 0 {
+		line += " for " + strings.Join(o.LocaleIDs, ", ")
+	}
+	if o.Reason != "" {
+		line += ": " + o.Reason
+	}
+	return []string{line}
+}
+
+// TableData returns the result as a single-row table.
+func (o Output) TableData() ([]string, [][]string) {
+	return []string{"ACTION", "PROJECT UID", "TRANSLATION JOB UID", "LOCALES", "REASON"},
+		[][]string{{o.Action, o.ProjectUID, o.TranslationJobUID, strings.Join(o.LocaleIDs, ", "), o.Reason}}
+}
+
+// view reads the job back so create/update render like `jobs view`.
+func (s service) view(ctx context.Context, projectID, jobUID string) (jobs.ViewOutput, error) {
+	detail, err := s.job.GetJob(ctx, projectID, jobUID)
+	if err != nil {
+		return jobs.ViewOutput{}, fmt.Errorf("get job %q: %w", jobUID, err)
+	}
+	return jobs.NewViewOutput(detail)
+}
+
+func customFields(fields map[string]string) []CustomField {
+	if len(fields) == 0 {
+		return nil
+	}
+	uids := make([]string, 0, len(fields))
+	for uid := range fields {
+		uids = append(uids, uid)
+	}
+	slices.Sort(uids)
+	result := make([]CustomField, 0, len(uids))
+	for _, uid := range uids {
+		result = append(result, CustomField{FieldUID: uid, FieldValue: fields[uid]})
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package lifecycle
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	rlog.Init()
+	os.Exit(m.Run())
+}
+
+func newTestService(t *testing.T) Service {
+	t.Helper()
+	server := httptest.NewServer(devserver.NewServer(devserver.Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		ProjectName:    "Project",
+		SourceLocaleID: "en-US",
+		TargetLocales:  []string{"de-DE", "fr-FR"},
+	}))
+	t.Cleanup(server.Close)
+
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret")
+	client.Client.BaseURL = server.URL
+	return NewService(NewAPI(client.Client), jobapi.NewJob(client.Client))
+}
+
+func TestService_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	due := time.Date(2026, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	created, err := s.RunCreate(ctx, CreateParams{
+		ProjectID:       "project",
+		JobName:         "Release 1",
+		Description:     "first",
+		DueDate:         due,
+		TargetLocaleIDs: []string{"de-DE"},
+		ReferenceNumber: "REF-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Release 1", created.JobName)
+	assert.Equal(t, "REF-1", created.ReferenceNumber)
+	assert.Equal(t, []string{"de-DE"}, created.TargetLocaleIDs)
+	assert.True(t, due.Equal(created.Dates.Due))
+
+	name := "Release 1.1"
+	updated, err := s.RunUpdate(ctx, UpdateParams{
+		JobParams: JobParams{ProjectID: "project", JobUIDOrName: "Release 1"},
+		JobName:   &name,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.TranslationJobUID, updated.TranslationJobUID)
+	assert.Equal(t, "Release 1.1", updated.JobName)
+	assert.Equal(t, "first", updated.Description, "unchanged fields are kept")
+	assert.True(t, due.Equal(updated.Dates.Due), "unchanged due date is kept")
+
+	job := JobParams{ProjectID: "project", JobUIDOrName: created.TranslationJobUID}
+	authorized, err := s.RunAuthorize(ctx, AuthorizeParams{JobParams: job, LocaleIDs: []string{"de-DE"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Job " + created.TranslationJobUID + " authorized for de-DE"}, authorized.SimpleLines())
+
+	cancelled, err := s.RunCancel(ctx, CancelParams{JobParams: job, Reason: "obsolete"})
+	require.NoError(t, err)
+	assert.Equal(t, "obsolete", cancelled.Reason)
+
+	_, err = s.RunClose(ctx, job)
+	var validation smerror.ValidationError
+	assert.ErrorAs(t, err, &validation, "cancelled job cannot be closed")
+
+	_, err = s.RunDelete(ctx, job)
+	require.NoError(t, err)
+	_, err = s.RunDelete(ctx, job)
+	assert.ErrorIs(t, err, jobapi.ErrNotFound)
+}
+
+func TestUpdateParams_Validate(t *testing.T) {
+	job := JobParams{ProjectID: "project", JobUIDOrName: "job"}
+	empty := ""
+
+	assert.EqualError(t, UpdateParams{JobParams: job}.Validate(), "nothing to update")
+	assert.EqualError(t, UpdateParams{JobParams: job, JobName: &empty}.Validate(), "job name cannot be empty")
+	assert.NoError(t, UpdateParams{JobParams: job, CustomFields: map[string]string{"uid": "value"}}.Validate())
+}
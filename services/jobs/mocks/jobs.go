@@ -170,6 +170,69 @@ func (_c *MockService_RunList_Call) RunAndReturn(run func(ctx context.Context, p
 	return _c
 }
 
+// RunListAll provides a mock function for the type MockService
+func (_mock *MockService) RunListAll(ctx context.Context, p jobs.ListParams, emit func(jobs.JobListItem) error) error {
+	ret := _mock.Called(ctx, p, emit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunListAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, jobs.ListParams, func(jobs.JobListItem) error) error); ok {
+		r0 = returnFunc(ctx, p, emit)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_RunListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunListAll'
+type MockService_RunListAll_Call struct {
+	*mock.Call
+}
+
+// RunListAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p jobs.ListParams
+//   - emit func(jobs.JobListItem) error
+func (_e *MockService_Expecter) RunListAll(ctx interface{}, p interface{}, emit interface{}) *MockService_RunListAll_Call {
+	return &MockService_RunListAll_Call{Call: _e.mock.On("RunListAll", ctx, p, emit)}
+}
+
+func (_c *MockService_RunListAll_Call) Run(run func(ctx context.Context, p jobs.ListParams, emit func(jobs.JobListItem) error)) *MockService_RunListAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 jobs.ListParams
+		if args[1] != nil {
+			arg1 = args[1].(jobs.ListParams)
+		}
+		var arg2 func(jobs.JobListItem) error
+		if args[2] != nil {
+			arg2 = args[2].(func(jobs.JobListItem) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RunListAll_Call) Return(err error) *MockService_RunListAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_RunListAll_Call) RunAndReturn(run func(ctx context.Context, p jobs.ListParams, emit func(jobs.JobListItem) error) error) *MockService_RunListAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunProgress provides a mock function for the type MockService
 func (_mock *MockService) RunProgress(ctx context.Context, p jobs.ProgressParams) (jobs.ProgressOutput, error) {
 	ret := _mock.Called(ctx, p)
@@ -236,6 +299,72 @@ func (_c *MockService_RunProgress_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// RunReport provides a mock function for the type MockService
+func (_mock *MockService) RunReport(ctx context.Context, p jobs.ReportParams) (jobs.ReportOutput, error) {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunReport")
+	}
+
+	var r0 jobs.ReportOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, jobs.ReportParams) (jobs.ReportOutput, error)); ok {
+		return returnFunc(ctx, p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, jobs.ReportParams) jobs.ReportOutput); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Get(0).(jobs.ReportOutput)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, jobs.ReportParams) error); ok {
+		r1 = returnFunc(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RunReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunReport'
+type MockService_RunReport_Call struct {
+	*mock.Call
+}
+
+// RunReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p jobs.ReportParams
+func (_e *MockService_Expecter) RunReport(ctx interface{}, p interface{}) *MockService_RunReport_Call {
+	return &MockService_RunReport_Call{Call: _e.mock.On("RunReport", ctx, p)}
+}
+
+func (_c *MockService_RunReport_Call) Run(run func(ctx context.Context, p jobs.ReportParams)) *MockService_RunReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 jobs.ReportParams
+		if args[1] != nil {
+			arg1 = args[1].(jobs.ReportParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RunReport_Call) Return(reportOutput jobs.ReportOutput, err error) *MockService_RunReport_Call {
+	_c.Call.Return(reportOutput, err)
+	return _c
+}
+
+func (_c *MockService_RunReport_Call) RunAndReturn(run func(ctx context.Context, p jobs.ReportParams) (jobs.ReportOutput, error)) *MockService_RunReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunView provides a mock function for the type MockService
 func (_mock *MockService) RunView(ctx context.Context, p jobs.ViewParams) (jobs.ViewOutput, error) {
 	ret := _mock.Called(ctx, p)
@@ -301,3 +430,75 @@ func (_c *MockService_RunView_Call) RunAndReturn(run func(ctx context.Context, p
 	_c.Call.Return(run)
 	return _c
 }
+
+// RunWait provides a mock function for the type MockService
+func (_mock *MockService) RunWait(ctx context.Context, p jobs.WaitParams, updates chan<- jobs.WaitUpdate) (jobs.WaitOutput, error) {
+	ret := _mock.Called(ctx, p, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunWait")
+	}
+
+	var r0 jobs.WaitOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, jobs.WaitParams, chan<- jobs.WaitUpdate) (jobs.WaitOutput, error)); ok {
+		return returnFunc(ctx, p, updates)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, jobs.WaitParams, chan<- jobs.WaitUpdate) jobs.WaitOutput); ok {
+		r0 = returnFunc(ctx, p, updates)
+	} else {
+		r0 = ret.Get(0).(jobs.WaitOutput)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, jobs.WaitParams, chan<- jobs.WaitUpdate) error); ok {
+		r1 = returnFunc(ctx, p, updates)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RunWait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunWait'
+type MockService_RunWait_Call struct {
+	*mock.Call
+}
+
+// RunWait is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p jobs.WaitParams
+//   - updates chan<- jobs.WaitUpdate
+func (_e *MockService_Expecter) RunWait(ctx interface{}, p interface{}, updates interface{}) *MockService_RunWait_Call {
+	return &MockService_RunWait_Call{Call: _e.mock.On("RunWait", ctx, p, updates)}
+}
+
+func (_c *MockService_RunWait_Call) Run(run func(ctx context.Context, p jobs.WaitParams, updates chan<- jobs.WaitUpdate)) *MockService_RunWait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 jobs.WaitParams
+		if args[1] != nil {
+			arg1 = args[1].(jobs.WaitParams)
+		}
+		var arg2 chan<- jobs.WaitUpdate
+		if args[2] != nil {
+			arg2 = args[2].(chan<- jobs.WaitUpdate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RunWait_Call) Return(waitOutput jobs.WaitOutput, err error) *MockService_RunWait_Call {
+	_c.Call.Return(waitOutput, err)
+	return _c
+}
+
+func (_c *MockService_RunWait_Call) RunAndReturn(run func(ctx context.Context, p jobs.WaitParams, updates chan<- jobs.WaitUpdate) (jobs.WaitOutput, error)) *MockService_RunWait_Call {
+	_c.Call.Return(run)
+	return _c
+}
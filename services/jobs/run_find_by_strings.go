@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/Smartling/smartling-cli/services/helpers"
@@ -25,7 +26,8 @@ type FindByStringsParams struct {
 }
 
 // Validate checks required fields and the API record limit. The endpoint counts
-// records as hashcodes×locales (hashcodes alone when no locales are given).
+// records as hashcodes×locales (hashcodes alone when no locales are given);
+// hashcodes are split across requests, so only the locales must fit in one.
 func (p FindByStringsParams) Validate() error {
 	if p.ProjectUID == "" {
 		return smerror.ErrEmptyParam("ProjectUID")
@@ -33,17 +35,19 @@ func (p FindByStringsParams) Validate() error {
 	if len(p.Hashcodes) == 0 {
 		return smerror.ErrEmptyParam("Hashcodes")
 	}
-	locales := len(p.LocaleIDs)
-	if locales == 0 {
-		locales = 1
-	}
-	if len(p.Hashcodes) > MaxFindByStringsRecords/locales {
-		return fmt.Errorf("too many records: %d hashcodes × %d locales exceeds the limit of %d",
-			len(p.Hashcodes), locales, MaxFindByStringsRecords)
+	if len(p.LocaleIDs) > MaxFindByStringsRecords {
+		return fmt.Errorf("too many records: %d locales exceeds the limit of %d",
+			len(p.LocaleIDs), MaxFindByStringsRecords)
 	}
 	return nil
 }
 
+// chunkSize is the number of hashcodes per request which keeps
+// hashcodes×locales within MaxFindByStringsRecords.
+func (p FindByStringsParams) chunkSize() int {
+	return MaxFindByStringsRecords / max(1, len(p.LocaleIDs))
+}
+
 // FindByStringsMatch is a single hashcode+locale match against one job.
 type FindByStringsMatch struct {
 	Hashcode          string `json:"hashcode"`
@@ -90,23 +94,39 @@ func (o FindByStringsOutput) TableData() ([]string, [][]string) {
 }
 
 // RunFindByStrings finds jobs containing the given strings (by hashcode) in the
-// given locales.
+// given locales. Hashcodes are split into requests within
+// MaxFindByStringsRecords and the responses are merged.
 func (s service) RunFindByStrings(ctx context.Context, params FindByStringsParams) (FindByStringsOutput, error) {
 	if err := params.Validate(); err != nil {
 		return FindByStringsOutput{}, fmt.Errorf("invalid find-by-strings params: %w", err)
 	}
-	rlog.Debugf("running jobs find-by-strings with params: %+v", params)
-
-	reqParams := jobapi.FindJobsByStringsRequest{
-		Hashcodes: params.Hashcodes,
-		LocaleIDs: params.LocaleIDs,
-	}
-	resp, err := s.job.FindJobsByStrings(ctx, params.ProjectUID, reqParams)
-	if err != nil {
-		return FindByStringsOutput{}, fmt.Errorf("failed to find jobs by strings: %w", err)
+	rlog.Debugf("running jobs find-by-strings with %d hashcode(s) in %v", len(params.Hashcodes), params.LocaleIDs)
+
+	var (
+		merged jobapi.FindJobsByStringsResponse
+		seen   = map[string]bool{}
+	)
+	for chunk := range slices.Chunk(params.Hashcodes, params.chunkSize()) {
+		reqParams := jobapi.FindJobsByStringsRequest{
+			Hashcodes: chunk,
+			LocaleIDs: params.LocaleIDs,
+		}
+		resp, err := s.job.FindJobsByStrings(ctx, params.ProjectUID, reqParams)
+		if err != nil {
+			return FindByStringsOutput{}, fmt.Errorf("failed to find jobs by strings: %w", err)
+		}
+		// A job holding strings of several chunks is counted once.
+		merged.TotalCount += resp.TotalCount
+		for _, job := range resp.Items {
+			if seen[job.TranslationJobUID] {
+				merged.TotalCount--
+			}
+			seen[job.TranslationJobUID] = true
+		}
+		merged.Items = append(merged.Items, resp.Items...)
 	}
 
-	return toFindByStringsOutput(resp)
+	return toFindByStringsOutput(merged)
 }
 
 // findByStringsJSON is the JSON shape for find-by-strings output.
@@ -89,17 +89,26 @@ func TestFindByStringsParams_Validate(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name:    "over record limit by hashcodes alone",
+			name:    "over record limit by hashcodes alone is chunked",
 			params:  FindByStringsParams{ProjectUID: "proj-1", Hashcodes: makeStrings(MaxFindByStringsRecords + 1)},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "over record limit by hashcodes×locales",
+			name: "over record limit by hashcodes×locales is chunked",
 			params: FindByStringsParams{
 				ProjectUID: "proj-1",
 				Hashcodes:  makeStrings(2001),
 				LocaleIDs:  makeStrings(10),
 			},
+			wantErr: false,
+		},
+		{
+			name: "over record limit by locales alone",
+			params: FindByStringsParams{
+				ProjectUID: "proj-1",
+				Hashcodes:  makeStrings(1),
+				LocaleIDs:  makeStrings(MaxFindByStringsRecords + 1),
+			},
 			wantErr: true,
 		},
 	}
@@ -179,3 +188,33 @@ func TestRunFindByStrings_InvalidParams(t *testing.T) {
 	require.Error(t, err)
 	m.AssertNotCalled(t, "FindJobsByStrings", mock.Anything, mock.Anything, mock.Anything)
 }
+
+func TestRunFindByStrings_ChunksAndMerges(t *testing.T) {
+	locales := []string{"fr-FR", "de-DE"}
+	perRequest := MaxFindByStringsRecords / len(locales)
+	hashcodes := makeStrings(perRequest + 1)
+	hashcodes[0], hashcodes[perRequest] = "first", "last"
+
+	m := jobmocks.NewMockJob(t)
+	m.On("FindJobsByStrings", mock.Anything, "proj-1", mock.MatchedBy(func(r jobapi.FindJobsByStringsRequest) bool {
+		return len(r.Hashcodes) == perRequest && r.Hashcodes[0] == "first"
+	})).Return(jobapi.FindJobsByStringsResponse{TotalCount: 2, Items: []jobapi.JobWithStrings{
+		{TranslationJobUID: "u1", HashcodesByLocale: []jobapi.JobHashcodesByLocale{{LocaleID: "fr-FR", Hashcodes: []string{"first"}}}},
+		{TranslationJobUID: "u2", HashcodesByLocale: []jobapi.JobHashcodesByLocale{{LocaleID: "de-DE", Hashcodes: []string{"first"}}}},
+	}}, nil).Once()
+	m.On("FindJobsByStrings", mock.Anything, "proj-1", mock.MatchedBy(func(r jobapi.FindJobsByStringsRequest) bool {
+		return len(r.Hashcodes) == 1 && r.Hashcodes[0] == "last"
+	})).Return(jobapi.FindJobsByStringsResponse{TotalCount: 1, Items: []jobapi.JobWithStrings{
+		{TranslationJobUID: "u2", HashcodesByLocale: []jobapi.JobHashcodesByLocale{{LocaleID: "fr-FR", Hashcodes: []string{"last"}}}},
+	}}, nil).Once()
+
+	out, err := NewService(m).RunFindByStrings(context.Background(), FindByStringsParams{
+		ProjectUID: "proj-1",
+		Hashcodes:  hashcodes,
+		LocaleIDs:  locales,
+	})
+	require.NoError(t, err)
+	require.Len(t, out.Matches, 3)
+	require.Equal(t, "last", out.Matches[2].Hashcode)
+	require.Equal(t, 2, out.TotalCount, "a job found by several requests counts once")
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Smartling/smartling-cli/services/helpers"
+	"github.com/Smartling/smartling-cli/services/helpers/pager"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	jobapi "github.com/Smartling/api-sdk-go/api/job"
@@ -109,6 +110,11 @@ type JobListItem struct {
 	Priority          int      `json:"priority,omitempty"`
 }
 
+// SimpleLine returns the job as a single human-readable line.
+func (j JobListItem) SimpleLine() string {
+	return fmt.Sprintf("%s  %s  %s", j.TranslationJobUID, j.JobName, j.JobStatus)
+}
+
 // ListOutput is the result of a jobs list.
 type ListOutput struct {
 	Jobs       []JobListItem
@@ -141,7 +147,7 @@ func (o ListOutput) SimpleLines() []string {
 	}
 	lines := make([]string, 0, len(o.Jobs)+1)
 	for _, j := range o.Jobs {
-		lines = append(lines, fmt.Sprintf("%s  %s  %s", j.TranslationJobUID, j.JobName, j.JobStatus))
+		lines = append(lines, j.SimpleLine())
 	}
 	if o.truncated() {
 		lines = append(lines, o.truncationNote())
@@ -180,30 +186,66 @@ func (s service) RunList(ctx context.Context, params ListParams) (ListOutput, er
 	}
 	rlog.Debugf("running jobs list with params: %+v", params)
 
-	var (
-		resp jobapi.ListJobsResponse
-		err  error
-	)
+	resp, err := s.listPage(ctx, params, params.Limit, params.Offset)
+	if err != nil {
+		return ListOutput{}, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	return toListOutput(resp, params.Account, params.Offset)
+}
+
+// RunListAll walks every page of the project or account listing, at most
+// pager.DefaultConcurrency pages at a time, and passes each job to emit in
+// listing order. The file/hashcode search is not paginated and is emitted
+// as a whole. Limit is used as the page size.
+func (s service) RunListAll(ctx context.Context, params ListParams, emit func(JobListItem) error) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid list params: %w", err)
+	}
+	rlog.Debugf("running jobs list --all with params: %+v", params)
+
+	pageSize := params.Limit
+	if pageSize == 0 {
+		pageSize = DefaultListPageLimit
+	}
+	fetch := func(ctx context.Context, limit, offset uint32) (pager.Page[jobapi.JobSummary], error) {
+		resp, err := s.listPage(ctx, params, limit, offset)
+		if err != nil {
+			return pager.Page[jobapi.JobSummary]{}, fmt.Errorf("failed to list jobs: %w", err)
+		}
+		if params.searchScope() {
+			// Search returns everything at once; stop after the first page.
+			return pager.Page[jobapi.JobSummary]{Items: resp.Items, TotalCount: len(resp.Items)}, nil
+		}
+		return pager.Page[jobapi.JobSummary]{Items: resp.Items, TotalCount: resp.TotalCount}, nil
+	}
+	return pager.Walk(ctx, pager.Params{PageSize: pageSize}, fetch, func(j jobapi.JobSummary) error {
+		return emit(toJobListItem(j))
+	})
+}
+
+// listPage requests a single page of jobs from the endpoint matching the
+// params scope.
+func (s service) listPage(ctx context.Context, params ListParams, limit, offset uint32) (jobapi.ListJobsResponse, error) {
+	if limit == 0 {
+		limit = DefaultListPageLimit
+	}
 	switch {
 	case params.searchScope():
-		resp, err = s.job.SearchJobs(ctx, params.ProjectUID, jobapi.SearchJobsRequest{
+		return s.job.SearchJobs(ctx, params.ProjectUID, jobapi.SearchJobsRequest{
 			FileURIs:           params.FileURIs,
 			Hashcodes:          params.Hashcodes,
 			TranslationJobUIDs: params.TranslationJobUIDs,
 		})
 	case params.Account:
-		limit := params.Limit
-		if limit == 0 {
-			limit = DefaultListPageLimit
-		}
-		resp, err = s.job.ListAccountJobs(ctx, string(params.AccountUID), jobapi.ListAccountJobsParams{
+		return s.job.ListAccountJobs(ctx, string(params.AccountUID), jobapi.ListAccountJobsParams{
 			JobName:      params.JobName,
 			ProjectIDs:   params.ProjectIDs,
 			JobStatus:    params.JobStatus,
 			WithPriority: params.WithPriority,
 			Page: jobapi.Page{
 				Limit:  limit,
-				Offset: params.Offset,
+				Offset: offset,
 			},
 			Sort: jobapi.Sort{
 				SortBy:        params.SortBy,
@@ -211,18 +253,14 @@ func (s service) RunList(ctx context.Context, params ListParams) (ListOutput, er
 			},
 		})
 	default:
-		limit := params.Limit
-		if limit == 0 {
-			limit = DefaultListPageLimit
-		}
-		resp, err = s.job.ListProjectJobs(ctx, params.ProjectUID, jobapi.ListProjectJobsParams{
+		return s.job.ListProjectJobs(ctx, params.ProjectUID, jobapi.ListProjectJobsParams{
 			JobName:            params.JobName,
 			JobNumber:          params.JobNumber,
 			TranslationJobUIDs: params.TranslationJobUIDs,
 			JobStatus:          params.JobStatus,
 			Page: jobapi.Page{
 				Limit:  limit,
-				Offset: params.Offset,
+				Offset: offset,
 			},
 			Sort: jobapi.Sort{
 				SortBy:        params.SortBy,
@@ -230,11 +268,6 @@ func (s service) RunList(ctx context.Context, params ListParams) (ListOutput, er
 			},
 		})
 	}
-	if err != nil {
-		return ListOutput{}, fmt.Errorf("failed to list jobs: %w", err)
-	}
-
-	return toListOutput(resp, params.Account, params.Offset)
 }
 
 // listJSON is the JSON shape for jobs-list output, carrying pagination
@@ -248,16 +281,7 @@ type listJSON struct {
 func toListOutput(resp jobapi.ListJobsResponse, account bool, offset uint32) (ListOutput, error) {
 	items := make([]JobListItem, 0, len(resp.Items))
 	for _, j := range resp.Items {
-		items = append(items, JobListItem{
-			TranslationJobUID: j.TranslationJobUID,
-			JobName:           j.JobName,
-			JobNumber:         j.JobNumber,
-			JobStatus:         j.JobStatus,
-			DueDate:           helpers.TimeToString(j.Dates.Due, time.RFC3339),
-			TargetLocaleIDs:   j.TargetLocaleIDs,
-			ProjectID:         j.ProjectID,
-			Priority:          j.Priority,
-		})
+		items = append(items, toJobListItem(j))
 	}
 	out := ListOutput{Jobs: items, Account: account, TotalCount: resp.TotalCount, Offset: offset}
 	b, err := json.Marshal(listJSON{
@@ -271,3 +295,16 @@ func toListOutput(resp jobapi.ListJobsResponse, account bool, offset uint32) (Li
 	out.JSON = b
 	return out, nil
 }
+
+func toJobListItem(j jobapi.JobSummary) JobListItem {
+	return JobListItem{
+		TranslationJobUID: j.TranslationJobUID,
+		JobName:           j.JobName,
+		JobNumber:         j.JobNumber,
+		JobStatus:         j.JobStatus,
+		DueDate:           helpers.TimeToString(j.Dates.Due, time.RFC3339),
+		TargetLocaleIDs:   j.TargetLocaleIDs,
+		ProjectID:         j.ProjectID,
+		Priority:          j.Priority,
+	}
+}
@@ -276,3 +276,25 @@ func TestListParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestRunListAll_WalksAllPages(t *testing.T) {
+	m := jobmocks.NewMockJob(t)
+	pages := map[uint32][]jobapi.JobSummary{
+		0: {{TranslationJobUID: "u1"}, {TranslationJobUID: "u2"}},
+		2: {{TranslationJobUID: "u3"}, {TranslationJobUID: "u4"}},
+		4: {{TranslationJobUID: "u5"}},
+	}
+	for offset, items := range pages {
+		m.On("ListProjectJobs", mock.Anything, "proj-1", mock.MatchedBy(func(p jobapi.ListProjectJobsParams) bool {
+			return p.Page.Offset == offset && p.Page.Limit == 2
+		})).Return(jobapi.ListJobsResponse{Items: items, TotalCount: 5}, nil)
+	}
+
+	var uids []string
+	err := NewService(m).RunListAll(context.Background(), ListParams{ProjectUID: "proj-1", Limit: 2}, func(j JobListItem) error {
+		uids = append(uids, j.TranslationJobUID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1", "u2", "u3", "u4", "u5"}, uids)
+}
@@ -2,7 +2,10 @@ package jobs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
 
@@ -13,6 +16,8 @@ import (
 type ProgressParams struct {
 	ProjectUID   string
 	JobUIDOrName string
+	// LocaleIDs limits the per-locale breakdown; empty means all target locales.
+	LocaleIDs []string
 }
 
 // Validate validates params for RunProgress.
@@ -42,40 +47,198 @@ func (s service) RunProgress(ctx context.Context, params ProgressParams) (Progre
 		return ProgressOutput{}, fmt.Errorf("get job progress for %q: %w", jobUID, err)
 	}
 
-	return ProgressOutput{
-		TranslationJobUID: jobUID,
-		TotalWordCount:    progress.TotalWordCount,
-		PercentComplete:   progress.PercentComplete,
-		JSON:              progress.JSON,
-	}, nil
+	locales, err := parseLocaleProgress(progress.JSON)
+	if err != nil {
+		return ProgressOutput{}, err
+	}
+	locales, err = filterLocaleProgress(locales, params.LocaleIDs)
+	if err != nil {
+		return ProgressOutput{}, err
+	}
+
+	return newProgressOutput(jobUID, progress.TotalWordCount, progress.PercentComplete, locales)
+}
+
+// filterLocaleProgress keeps the requested locales, in the order of the
+// report. Requesting a locale that is not part of the job is an error.
+func filterLocaleProgress(locales []LocaleProgress, localeIDs []string) ([]LocaleProgress, error) {
+	if len(localeIDs) == 0 {
+		return locales, nil
+	}
+	wanted := make(map[string]bool, len(localeIDs))
+	for _, localeID := range localeIDs {
+		wanted[localeID] = true
+	}
+	filtered := []LocaleProgress{}
+	for _, locale := range locales {
+		if wanted[locale.LocaleID] {
+			filtered = append(filtered, locale)
+			delete(wanted, locale.LocaleID)
+		}
+	}
+	if len(wanted) > 0 {
+		missing := make([]string, 0, len(wanted))
+		for localeID := range wanted {
+			missing = append(missing, localeID)
+		}
+		slices.Sort(missing)
+		return nil, fmt.Errorf("job has no target locale(s): %s", strings.Join(missing, ", "))
+	}
+	return filtered, nil
+}
+
+// LocaleProgress is the translation progress of one target locale of a job.
+type LocaleProgress struct {
+	LocaleID        string  `json:"localeId"`
+	Description     string  `json:"description"`
+	PercentComplete float64 `json:"percentComplete"`
+	TotalWordCount  int     `json:"totalWordCount"`
+	// Steps is where the locale content sits in its workflows.
+	Steps []WorkflowStepProgress `json:"steps"`
+}
+
+// WorkflowStepProgress is the amount of content of one target locale sitting
+// in one workflow step.
+type WorkflowStepProgress struct {
+	WorkflowName string `json:"workflowName"`
+	StepName     string `json:"stepName"`
+	StepType     string `json:"stepType"`
+	WordCount    int    `json:"wordCount"`
+	StringCount  int    `json:"stringCount"`
+	// Completed is set for the publish step; content in any other step is
+	// still awaiting translation.
+	Completed bool `json:"completed"`
+}
+
+// StepTypePublish is the workflow step type of published content.
+const StepTypePublish = "PUBLISH"
+
+// parseLocaleProgress extracts per-locale progress from the raw progress
+// payload, which the SDK only exposes as JSON.
+func parseLocaleProgress(raw []byte) ([]LocaleProgress, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var data struct {
+		ContentProgressReport []struct {
+			TargetLocaleID          string `json:"targetLocaleId"`
+			TargetLocaleDescription string `json:"targetLocaleDescription"`
+			Progress                struct {
+				PercentComplete float64 `json:"percentComplete"`
+				TotalWordCount  int     `json:"totalWordCount"`
+			} `json:"progress"`
+			WorkflowProgressReportList []struct {
+				WorkflowName                      string `json:"workflowName"`
+				WorkflowStepSummaryReportItemList []struct {
+					StringCount      int    `json:"stringCount"`
+					WordCount        int    `json:"wordCount"`
+					WorkflowStepName string `json:"workflowStepName"`
+					WorkflowStepType string `json:"workflowStepType"`
+				} `json:"workflowStepSummaryReportItemList"`
+			} `json:"workflowProgressReportList"`
+		} `json:"contentProgressReport"`
+	}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("decode job progress: %w", err)
+	}
+	locales := make([]LocaleProgress, 0, len(data.ContentProgressReport))
+	for _, report := range data.ContentProgressReport {
+		steps := []WorkflowStepProgress{}
+		for _, workflow := range report.WorkflowProgressReportList {
+			for _, step := range workflow.WorkflowStepSummaryReportItemList {
+				steps = append(steps, WorkflowStepProgress{
+					WorkflowName: workflow.WorkflowName,
+					StepName:     step.WorkflowStepName,
+					StepType:     step.WorkflowStepType,
+					WordCount:    step.WordCount,
+					StringCount:  step.StringCount,
+					Completed:    step.WorkflowStepType == StepTypePublish,
+				})
+			}
+		}
+		locales = append(locales, LocaleProgress{
+			LocaleID:        report.TargetLocaleID,
+			Description:     report.TargetLocaleDescription,
+			PercentComplete: report.Progress.PercentComplete,
+			TotalWordCount:  report.Progress.TotalWordCount,
+			Steps:           steps,
+		})
+	}
+	return locales, nil
 }
 
 // ProgressOutput represents the result of a job progress
 type ProgressOutput struct {
-	TranslationJobUID string
-	TotalWordCount    uint32
-	PercentComplete   float64
-	JSON              []byte
+	TranslationJobUID string           `json:"translationJobUid"`
+	TotalWordCount    uint32           `json:"totalWordCount"`
+	PercentComplete   float64          `json:"percentComplete"`
+	Locales           []LocaleProgress `json:"locales"`
+
+	JSON []byte `json:"-"`
+}
+
+func newProgressOutput(jobUID string, totalWordCount uint32, percentComplete float64, locales []LocaleProgress) (ProgressOutput, error) {
+	if locales == nil {
+		locales = []LocaleProgress{}
+	}
+	out := ProgressOutput{
+		TranslationJobUID: jobUID,
+		TotalWordCount:    totalWordCount,
+		PercentComplete:   percentComplete,
+		Locales:           locales,
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return ProgressOutput{}, fmt.Errorf("marshal job progress to JSON: %w", err)
+	}
+	out.JSON = b
+	return out, nil
 }
 
-// JSONBytes returns the raw JSON payload of the progress response.
+// JSONBytes returns the JSON representation of the progress.
 func (p ProgressOutput) JSONBytes() []byte { return p.JSON }
 
-// SimpleLines returns a human-readable summary of the progress.
+// SimpleLines returns a human-readable summary of the progress, followed by
+// the per-locale breakdown.
 func (p ProgressOutput) SimpleLines() []string {
-	return []string{
+	lines := []string{
 		fmt.Sprintf("Translation job UID: %s", p.TranslationJobUID),
 		fmt.Sprintf("Total word count:    %d", p.TotalWordCount),
 		fmt.Sprintf("Percent complete:    %v", p.PercentComplete),
 	}
+	for _, locale := range p.Locales {
+		lines = append(lines, "", fmt.Sprintf("%s: %v%% of %d words", locale.LocaleID, locale.PercentComplete, locale.TotalWordCount))
+		for _, step := range locale.Steps {
+			lines = append(lines, fmt.Sprintf("  %-30s %-9s %6d words %6d strings",
+				step.WorkflowName+" / "+step.StepName, step.state(), step.WordCount, step.StringCount))
+		}
+	}
+	return lines
 }
 
-// TableData returns the progress with one column per field and a single
-// row of values.
+// TableData returns the job totals followed by one row per locale and
+// workflow step.
 func (p ProgressOutput) TableData() ([]string, [][]string) {
-	headers := []string{"TRANSLATION JOB UID", "TOTAL WORD COUNT", "PERCENT COMPLETE"}
-	rows := [][]string{
-		{p.TranslationJobUID, fmt.Sprintf("%d", p.TotalWordCount), fmt.Sprintf("%v", p.PercentComplete)},
+	headers := []string{"LOCALE", "PERCENT COMPLETE", "WORKFLOW", "STEP", "STATE", "WORDS", "STRINGS"}
+	rows := [][]string{{"TOTAL", fmt.Sprintf("%v", p.PercentComplete), "", "", "", fmt.Sprintf("%d", p.TotalWordCount), ""}}
+	for _, locale := range p.Locales {
+		percent := fmt.Sprintf("%v", locale.PercentComplete)
+		if len(locale.Steps) == 0 {
+			rows = append(rows, []string{locale.LocaleID, percent, "", "", "", "", ""})
+		}
+		for _, step := range locale.Steps {
+			rows = append(rows, []string{
+				locale.LocaleID, percent, step.WorkflowName, step.StepName, step.state(),
+				fmt.Sprintf("%d", step.WordCount), fmt.Sprintf("%d", step.StringCount),
+			})
+		}
 	}
 	return headers, rows
 }
+
+func (s WorkflowStepProgress) state() string {
+	if s.Completed {
+		return "completed"
+	}
+	return "awaiting"
+}
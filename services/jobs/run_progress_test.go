@@ -9,7 +9,9 @@ import (
 	jobmocks "github.com/Smartling/smartling-cli/services/jobs/sdkmocks"
 
 	"github.com/Smartling/api-sdk-go/api/job"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_service_RunProgress(t *testing.T) {
@@ -63,7 +65,8 @@ func Test_service_RunProgress(t *testing.T) {
 				TranslationJobUID: validJobUID,
 				TotalWordCount:    100,
 				PercentComplete:   42.5,
-				JSON:              []byte(`{"percentComplete":42.5}`),
+				Locales:           []LocaleProgress{},
+				JSON:              []byte(`{"translationJobUid":"aabbccdd1122","totalWordCount":100,"percentComplete":42.5,"locales":[]}`),
 			},
 		},
 		{
@@ -90,6 +93,8 @@ func Test_service_RunProgress(t *testing.T) {
 				TranslationJobUID: "resolveduid01",
 				TotalWordCount:    7,
 				PercentComplete:   10,
+				Locales:           []LocaleProgress{},
+				JSON:              []byte(`{"translationJobUid":"resolveduid01","totalWordCount":7,"percentComplete":10,"locales":[]}`),
 			},
 		},
 		{
@@ -165,6 +170,8 @@ func Test_service_RunProgress(t *testing.T) {
 				TranslationJobUID: "matcheduid002",
 				TotalWordCount:    250,
 				PercentComplete:   75,
+				Locales:           []LocaleProgress{},
+				JSON:              []byte(`{"translationJobUid":"matcheduid002","totalWordCount":250,"percentComplete":75,"locales":[]}`),
 			},
 		},
 		{
@@ -249,3 +256,45 @@ func TestProgressParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_service_RunProgress_LocaleBreakdown(t *testing.T) {
+	const raw = `{"contentProgressReport":[
+		{"targetLocaleId":"de-DE","targetLocaleDescription":"German (Germany)","progress":{"percentComplete":25,"totalWordCount":40},
+		 "workflowProgressReportList":[{"workflowName":"Translation","workflowStepSummaryReportItemList":[
+			{"stringCount":3,"wordCount":30,"workflowStepName":"Translation","workflowStepType":"TRANSLATION"},
+			{"stringCount":1,"wordCount":10,"workflowStepName":"Published","workflowStepType":"PUBLISH"}]}]},
+		{"targetLocaleId":"fr-FR","progress":{"percentComplete":0,"totalWordCount":40}}]}`
+	m := jobmocks.NewMockJob(t)
+	m.On("GetJob", mock.Anything, "proj", "aabbccdd1122").
+		Return(job.GetJobResponse{TranslationJobUID: "aabbccdd1122"}, nil)
+	m.On("Progress", mock.Anything, "proj", "aabbccdd1122").
+		Return(job.GetJobProgressResponse{TotalWordCount: 80, PercentComplete: 12.5, JSON: []byte(raw)}, nil)
+	s := service{job: m}
+
+	got, err := s.RunProgress(context.Background(), ProgressParams{
+		ProjectUID: "proj", JobUIDOrName: "aabbccdd1122", LocaleIDs: []string{"de-DE"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []LocaleProgress{{
+		LocaleID:        "de-DE",
+		Description:     "German (Germany)",
+		PercentComplete: 25,
+		TotalWordCount:  40,
+		Steps: []WorkflowStepProgress{
+			{WorkflowName: "Translation", StepName: "Translation", StepType: "TRANSLATION", WordCount: 30, StringCount: 3},
+			{WorkflowName: "Translation", StepName: "Published", StepType: "PUBLISH", WordCount: 10, StringCount: 1, Completed: true},
+		},
+	}}, got.Locales)
+
+	_, rows := got.TableData()
+	assert.Equal(t, [][]string{
+		{"TOTAL", "12.5", "", "", "", "80", ""},
+		{"de-DE", "25", "Translation", "Translation", "awaiting", "30", "3"},
+		{"de-DE", "25", "Translation", "Published", "completed", "10", "1"},
+	}, rows)
+
+	_, err = s.RunProgress(context.Background(), ProgressParams{
+		ProjectUID: "proj", JobUIDOrName: "aabbccdd1122", LocaleIDs: []string{"de-DE", "ja-JP"},
+	})
+	assert.EqualError(t, err, "job has no target locale(s): ja-JP")
+}
@@ -0,0 +1,307 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/helpers"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultReportStatuses are the job statuses reported when none are requested.
+var DefaultReportStatuses = []string{"AWAITING_AUTHORIZATION", "IN_PROGRESS", "COMPLETED"}
+
+// DefaultAtRiskMargin is how many percent points a job may lag behind its
+// schedule before it is flagged as at risk.
+const DefaultAtRiskMargin = 10
+
+// Report flags.
+const (
+	FlagOverdue    = "overdue"
+	FlagAtRisk     = "at risk"
+	FlagNoProgress = "no progress"
+)
+
+// ReportParams is the parameters for the RunReport method.
+type ReportParams struct {
+	AccountUID uid.AccountUID
+	ProjectIDs []string
+	// JobStatus filters jobs by status; empty means DefaultReportStatuses.
+	JobStatus []string
+	// AtRiskMargin is the allowed lag behind schedule, in percent points.
+	AtRiskMargin float64
+	// Threads bounds concurrent progress requests; zero means one at a time.
+	Threads uint32
+	// Now is the reference time; zero means the current time.
+	Now time.Time
+}
+
+// Validate validates params for RunReport.
+func (p ReportParams) Validate() error {
+	if err := p.AccountUID.Validate(); err != nil {
+		return err
+	}
+	if p.AtRiskMargin < 0 || p.AtRiskMargin > 100 {
+		return fmt.Errorf("at-risk margin must be in [0, 100] range")
+	}
+	return nil
+}
+
+// ReportJob is a single job of the report joined with its progress.
+type ReportJob struct {
+	ProjectID         string  `json:"projectId"`
+	TranslationJobUID string  `json:"translationJobUid"`
+	JobName           string  `json:"jobName"`
+	JobStatus         string  `json:"jobStatus"`
+	DueDate           string  `json:"dueDate"`
+	TotalWordCount    uint32  `json:"totalWordCount"`
+	PercentComplete   float64 `json:"percentComplete"`
+	// ExpectedPercent is the progress expected by now when work is spread
+	// evenly between job creation and the due date.
+	ExpectedPercent float64 `json:"expectedPercent"`
+	Overdue         bool    `json:"overdue"`
+	AtRisk          bool    `json:"atRisk"`
+	// ProgressError is why the progress of the job could not be read; the
+	// progress fields of such a job are zero and it is never flagged as
+	// overdue or at risk.
+	ProgressError string `json:"progressError,omitempty"`
+}
+
+// Flag returns the report flag of the job, if any.
+func (j ReportJob) Flag() string {
+	switch {
+	case j.ProgressError != "":
+		return FlagNoProgress
+	case j.Overdue:
+		return FlagOverdue
+	case j.AtRisk:
+		return FlagAtRisk
+	}
+	return ""
+}
+
+// ReportTotal aggregates the jobs of one project in one status.
+type ReportTotal struct {
+	ProjectID      string `json:"projectId"`
+	JobStatus      string `json:"jobStatus"`
+	Jobs           int    `json:"jobs"`
+	Overdue        int    `json:"overdue"`
+	AtRisk         int    `json:"atRisk"`
+	TotalWordCount uint64 `json:"totalWordCount"`
+}
+
+// ReportOutput is the result of a jobs report.
+type ReportOutput struct {
+	GeneratedAt time.Time     `json:"generatedAt"`
+	Jobs        []ReportJob   `json:"jobs"`
+	Totals      []ReportTotal `json:"totals"`
+
+	JSON []byte `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the report.
+func (o ReportOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns flagged jobs first, then totals by project and status.
+func (o ReportOutput) SimpleLines() []string {
+	if len(o.Jobs) == 0 {
+		return []string{"No jobs found."}
+	}
+	var lines []string
+	for _, j := range o.Jobs {
+		switch flag := j.Flag(); flag {
+		case "":
+		case FlagNoProgress:
+			lines = append(lines, fmt.Sprintf("%-8s %s  %s  %s  %s",
+				flag, j.ProjectID, j.TranslationJobUID, j.JobName, j.ProgressError))
+		default:
+			lines = append(lines, fmt.Sprintf("%-8s %s  %s  %s  due %s, %.1f%% complete (expected %.1f%%)",
+				flag, j.ProjectID, j.TranslationJobUID, j.JobName, j.DueDate, j.PercentComplete, j.ExpectedPercent))
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "No overdue or at-risk jobs.")
+	}
+	lines = append(lines, "")
+	for _, t := range o.Totals {
+		lines = append(lines, fmt.Sprintf("%s  %-22s %d jobs, %d overdue, %d at risk, %d words",
+			t.ProjectID, t.JobStatus, t.Jobs, t.Overdue, t.AtRisk, t.TotalWordCount))
+	}
+	return lines
+}
+
+// TableData returns the same rows as CSVData.
+func (o ReportOutput) TableData() ([]string, [][]string) {
+	return o.CSVData()
+}
+
+// CSVData returns one record per job followed by one per total, told apart
+// by the TYPE column. The JOBS, OVERDUE and AT RISK columns are only filled
+// for totals.
+func (o ReportOutput) CSVData() ([]string, [][]string) {
+	headers := []string{"TYPE", "PROJECT ID", "TRANSLATION JOB UID", "NAME", "STATUS", "DUE DATE", "WORDS",
+		"PERCENT COMPLETE", "EXPECTED PERCENT", "FLAG", "JOBS", "OVERDUE", "AT RISK"}
+	rows := make([][]string, 0, len(o.Jobs)+len(o.Totals))
+	for _, j := range o.Jobs {
+		rows = append(rows, []string{
+			"job", j.ProjectID, j.TranslationJobUID, j.JobName, j.JobStatus, j.DueDate,
+			fmt.Sprintf("%d", j.TotalWordCount), fmt.Sprintf("%.1f", j.PercentComplete),
+			fmt.Sprintf("%.1f", j.ExpectedPercent), j.Flag(), "", "", "",
+		})
+	}
+	for _, t := range o.Totals {
+		rows = append(rows, []string{
+			"total", t.ProjectID, "", "", t.JobStatus, "",
+			fmt.Sprintf("%d", t.TotalWordCount), "", "", "",
+			fmt.Sprintf("%d", t.Jobs), fmt.Sprintf("%d", t.Overdue), fmt.Sprintf("%d", t.AtRisk),
+		})
+	}
+	return headers, rows
+}
+
+// RunReport lists the account jobs, joins each with its progress and flags
+// overdue and at-risk jobs.
+func (s service) RunReport(ctx context.Context, params ReportParams) (ReportOutput, error) {
+	if err := params.Validate(); err != nil {
+		return ReportOutput{}, fmt.Errorf("invalid report params: %w", err)
+	}
+	rlog.Debugf("running jobs report with params: %+v", params)
+
+	now := params.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	statuses := params.JobStatus
+	if len(statuses) == 0 {
+		statuses = DefaultReportStatuses
+	}
+
+	summaries, err := s.listAllAccountJobs(ctx, params.AccountUID, params.ProjectIDs, statuses)
+	if err != nil {
+		return ReportOutput{}, err
+	}
+
+	jobs := make([]ReportJob, len(summaries))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(max(1, int(params.Threads)))
+	for i, summary := range summaries {
+		group.Go(func() error {
+			progress, err := s.job.Progress(groupCtx, summary.ProjectID, summary.TranslationJobUID)
+			if err != nil {
+				// A job whose progress cannot be read is still reported,
+				// unless the whole report is being cancelled.
+				if ctxErr := groupCtx.Err(); ctxErr != nil {
+					return ctxErr
+				}
+				rlog.Debugf("unable to get progress of job %s: %s", summary.TranslationJobUID, err)
+				jobs[i] = summaryReportJob(summary)
+				jobs[i].ProgressError = err.Error()
+				return nil
+			}
+			jobs[i] = newReportJob(summary, progress, now, params.AtRiskMargin)
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return ReportOutput{}, err
+	}
+
+	out := ReportOutput{GeneratedAt: now, Jobs: jobs, Totals: reportTotals(jobs)}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return ReportOutput{}, fmt.Errorf("marshal jobs report to JSON: %w", err)
+	}
+	out.JSON = b
+	return out, nil
+}
+
+func (s service) listAllAccountJobs(ctx context.Context, accountUID uid.AccountUID, projectIDs, statuses []string) ([]jobapi.JobSummary, error) {
+	var summaries []jobapi.JobSummary
+	for {
+		resp, err := s.job.ListAccountJobs(ctx, string(accountUID), jobapi.ListAccountJobsParams{
+			ProjectIDs: projectIDs,
+			JobStatus:  statuses,
+			Page: jobapi.Page{
+				Limit:  DefaultListPageLimit,
+				Offset: uint32(len(summaries)),
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list jobs: %w", err)
+		}
+		summaries = append(summaries, resp.Items...)
+		if len(resp.Items) == 0 || len(summaries) >= resp.TotalCount {
+			return summaries, nil
+		}
+	}
+}
+
+// summaryReportJob returns the report job with the fields of summary only.
+func summaryReportJob(summary jobapi.JobSummary) ReportJob {
+	return ReportJob{
+		ProjectID:         summary.ProjectID,
+		TranslationJobUID: summary.TranslationJobUID,
+		JobName:           summary.JobName,
+		JobStatus:         summary.JobStatus,
+		DueDate:           helpers.TimeToString(summary.Dates.Due, time.RFC3339),
+	}
+}
+
+func newReportJob(summary jobapi.JobSummary, progress jobapi.GetJobProgressResponse, now time.Time, margin float64) ReportJob {
+	j := summaryReportJob(summary)
+	j.TotalWordCount = progress.TotalWordCount
+	j.PercentComplete = progress.PercentComplete
+	due, created := summary.Dates.Due, summary.Dates.Created
+	if due.IsZero() || summary.JobStatus == StatusCompleted || progress.PercentComplete >= 100 {
+		return j
+	}
+	if !now.Before(due) {
+		j.ExpectedPercent = 100
+		j.Overdue = true
+		return j
+	}
+	if !created.IsZero() && due.After(created) && now.After(created) {
+		j.ExpectedPercent = 100 * float64(now.Sub(created)) / float64(due.Sub(created))
+	}
+	j.AtRisk = progress.PercentComplete+margin < j.ExpectedPercent
+	return j
+}
+
+func reportTotals(jobs []ReportJob) []ReportTotal {
+	type key struct{ project, status string }
+	byKey := map[key]*ReportTotal{}
+	for _, j := range jobs {
+		k := key{j.ProjectID, j.JobStatus}
+		total, ok := byKey[k]
+		if !ok {
+			total = &ReportTotal{ProjectID: j.ProjectID, JobStatus: j.JobStatus}
+			byKey[k] = total
+		}
+		total.Jobs++
+		total.TotalWordCount += uint64(j.TotalWordCount)
+		if j.Overdue {
+			total.Overdue++
+		}
+		if j.AtRisk {
+			total.AtRisk++
+		}
+	}
+	totals := make([]ReportTotal, 0, len(byKey))
+	for _, total := range byKey {
+		totals = append(totals, *total)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].ProjectID != totals[j].ProjectID {
+			return totals[i].ProjectID < totals[j].ProjectID
+		}
+		return totals[i].JobStatus < totals[j].JobStatus
+	})
+	return totals
+}
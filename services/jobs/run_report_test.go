@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	jobmocks "github.com/Smartling/smartling-cli/services/jobs/sdkmocks"
+
+	"github.com/Smartling/api-sdk-go/api/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunReport(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	summary := func(uid, project, status string, created, due time.Time) job.JobSummary {
+		return job.JobSummary{
+			TranslationJobUID: uid,
+			JobName:           "job " + uid,
+			JobStatus:         status,
+			ProjectID:         project,
+			Dates:             job.JobDates{Created: created, Due: due},
+		}
+	}
+	m := jobmocks.NewMockJob(t)
+	m.On("ListAccountJobs", mock.Anything, "acc12345", mock.MatchedBy(func(p job.ListAccountJobsParams) bool {
+		return p.Page.Offset == 0 && assert.ObjectsAreEqual(DefaultReportStatuses, p.JobStatus)
+	})).Return(job.ListJobsResponse{TotalCount: 4, Items: []job.JobSummary{
+		summary("overdue00001", "p1", "IN_PROGRESS", now.AddDate(0, 0, -10), now.AddDate(0, 0, -1)),
+		summary("atrisk000001", "p1", "IN_PROGRESS", now.AddDate(0, 0, -8), now.AddDate(0, 0, 2)),
+	}}, nil)
+	m.On("ListAccountJobs", mock.Anything, "acc12345", mock.MatchedBy(func(p job.ListAccountJobsParams) bool {
+		return p.Page.Offset == 2
+	})).Return(job.ListJobsResponse{TotalCount: 5, Items: []job.JobSummary{
+		summary("ontrack00001", "p1", "IN_PROGRESS", now.AddDate(0, 0, -5), now.AddDate(0, 0, 5)),
+		summary("done00000001", "p2", "COMPLETED", now.AddDate(0, 0, -10), now.AddDate(0, 0, -1)),
+		summary("failed000001", "p2", "COMPLETED", now.AddDate(0, 0, -10), now.AddDate(0, 0, -1)),
+	}}, nil)
+	for uid, percent := range map[string]float64{"overdue00001": 90, "atrisk000001": 50, "ontrack00001": 45, "done00000001": 100} {
+		m.On("Progress", mock.Anything, mock.Anything, uid).
+			Return(job.GetJobProgressResponse{TranslationJobUID: uid, TotalWordCount: 100, PercentComplete: percent}, nil)
+	}
+	m.On("Progress", mock.Anything, mock.Anything, "failed000001").
+		Return(job.GetJobProgressResponse{}, errors.New("progress unavailable"))
+
+	out, err := NewService(m).RunReport(context.Background(), ReportParams{
+		AccountUID:   "acc12345",
+		AtRiskMargin: DefaultAtRiskMargin,
+		Threads:      2,
+		Now:          now,
+	})
+	require.NoError(t, err)
+
+	flags := map[string]string{}
+	for _, j := range out.Jobs {
+		flags[j.TranslationJobUID] = j.Flag()
+	}
+	assert.Equal(t, map[string]string{
+		"overdue00001": FlagOverdue,
+		"atrisk000001": FlagAtRisk,
+		"ontrack00001": "",
+		"done00000001": "",
+		"failed000001": FlagNoProgress,
+	}, flags)
+	assert.Equal(t, "progress unavailable", out.Jobs[4].ProgressError)
+	assert.Contains(t, out.SimpleLines(), "no progress p2  failed000001  job failed000001  progress unavailable")
+	assert.InDelta(t, 80, out.Jobs[1].ExpectedPercent, 0.01)
+
+	assert.Equal(t, []ReportTotal{
+		{ProjectID: "p1", JobStatus: "IN_PROGRESS", Jobs: 3, Overdue: 1, AtRisk: 1, TotalWordCount: 300},
+		{ProjectID: "p2", JobStatus: "COMPLETED", Jobs: 2, TotalWordCount: 100},
+	}, out.Totals)
+
+	headers, records := out.CSVData()
+	require.Len(t, records, 7, "jobs followed by totals")
+	for _, record := range records {
+		assert.Len(t, record, len(headers))
+	}
+	assert.Equal(t, []string{"job", "p1", "overdue00001"}, records[0][:3])
+	assert.Equal(t, []string{"total", "p1", "", "", "IN_PROGRESS", "", "300", "", "", "", "3", "1", "1"}, records[5])
+	_, rows := out.TableData()
+	assert.Equal(t, records, rows)
+}
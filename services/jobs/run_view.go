@@ -97,11 +97,14 @@ func (s service) RunView(ctx context.Context, params ViewParams) (ViewOutput, er
 		return ViewOutput{}, fmt.Errorf("get job %q: %w", jobUID, err)
 	}
 
-	out := ViewOutput{GetJobResponse: detail}
+	return NewViewOutput(detail)
+}
+
+// NewViewOutput wraps job detail for rendering.
+func NewViewOutput(detail jobapi.GetJobResponse) (ViewOutput, error) {
 	b, err := json.Marshal(detail)
 	if err != nil {
 		return ViewOutput{}, fmt.Errorf("marshal job detail to JSON: %w", err)
 	}
-	out.JSON = b
-	return out, nil
+	return ViewOutput{GetJobResponse: detail, JSON: b}, nil
 }
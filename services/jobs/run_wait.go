@@ -0,0 +1,197 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+
+	smerror "github.com/Smartling/api-sdk-go/helpers/sm_error"
+)
+
+// Job statuses which end waiting regardless of progress.
+const (
+	StatusCompleted = "COMPLETED"
+	StatusClosed    = "CLOSED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Results of RunWait.
+const (
+	WaitCompleted = "completed"
+	WaitTimedOut  = "timed out"
+	WaitCancelled = "cancelled"
+)
+
+// WaitParams is the parameters for the RunWait method.
+type WaitParams struct {
+	ProjectUID   string
+	JobUIDOrName string
+	// Until is the overall percent complete to wait for.
+	Until float64
+	// Timeout bounds the total wait; zero waits indefinitely.
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+// Validate validates params for RunWait.
+func (p WaitParams) Validate() error {
+	switch {
+	case p.ProjectUID == "":
+		return smerror.ErrEmptyParam("ProjectUID")
+	case p.JobUIDOrName == "":
+		return smerror.ErrEmptyParam("JobUIDOrName")
+	case p.Until <= 0 || p.Until > 100:
+		return errors.New("until must be in (0, 100] range")
+	case p.Interval <= 0:
+		return errors.New("interval must be positive")
+	case p.Timeout < 0:
+		return errors.New("timeout cannot be negative")
+	}
+	return nil
+}
+
+// WaitUpdate is a single progress snapshot taken while waiting.
+type WaitUpdate struct {
+	TranslationJobUID string           `json:"translationJobUid"`
+	JobName           string           `json:"jobName"`
+	JobStatus         string           `json:"jobStatus"`
+	PercentComplete   float64          `json:"percentComplete"`
+	Locales           []LocaleProgress `json:"locales"`
+	CheckedAt         time.Time        `json:"checkedAt"`
+}
+
+// WaitOutput is the final state of the awaited job.
+type WaitOutput struct {
+	WaitUpdate
+	Result string  `json:"result"`
+	Until  float64 `json:"until"`
+
+	JSON []byte `json:"-"`
+}
+
+func newWaitOutput(update WaitUpdate, result string, until float64) WaitOutput {
+	o := WaitOutput{WaitUpdate: update, Result: result, Until: until}
+	o.JSON, _ = json.Marshal(o)
+	return o
+}
+
+// JSONBytes returns the JSON representation of the result.
+func (o WaitOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns a human-readable summary of the result.
+func (o WaitOutput) SimpleLines() []string {
+	lines := []string{fmt.Sprintf("Job %s %s: %s, %.1f%% complete (waited for %v%%)",
+		o.TranslationJobUID, o.Result, o.JobStatus, o.PercentComplete, o.Until)}
+	for _, locale := range o.Locales {
+		lines = append(lines, fmt.Sprintf("  %s: %.1f%%", locale.LocaleID, locale.PercentComplete))
+	}
+	return lines
+}
+
+// TableData returns one row per locale.
+func (o WaitOutput) TableData() ([]string, [][]string) {
+	headers := []string{"TRANSLATION JOB UID", "RESULT", "STATUS", "LOCALE", "PERCENT COMPLETE"}
+	rows := [][]string{{o.TranslationJobUID, o.Result, o.JobStatus, "", fmt.Sprintf("%.1f", o.PercentComplete)}}
+	for _, locale := range o.Locales {
+		rows = append(rows, []string{o.TranslationJobUID, o.Result, o.JobStatus, locale.LocaleID, fmt.Sprintf("%.1f", locale.PercentComplete)})
+	}
+	return headers, rows
+}
+
+// RunWait polls the job progress every interval until it reaches the
+// threshold or completes. Every snapshot is sent to updates, when given.
+// Cancelled jobs end with clierror.CancelledError, running out of time
+// ends with clierror.TimeoutError; the last snapshot is returned in both cases.
+func (s service) RunWait(ctx context.Context, params WaitParams, updates chan<- WaitUpdate) (WaitOutput, error) {
+	if err := params.Validate(); err != nil {
+		return WaitOutput{}, err
+	}
+	rlog.Debugf("running jobs wait with params: %+v", params)
+
+	if params.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, params.Timeout)
+		defer cancel()
+	}
+	timedOut := func(last WaitUpdate) (WaitOutput, error) {
+		return newWaitOutput(last, WaitTimedOut, params.Until),
+			clierror.TimeoutError{What: fmt.Sprintf("job %q to reach %v%%", params.JobUIDOrName, params.Until), After: params.Timeout}
+	}
+
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectUID, params.JobUIDOrName)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return timedOut(WaitUpdate{})
+		}
+		return WaitOutput{}, fmt.Errorf("resolve job UID: %w", err)
+	}
+
+	ticker := time.NewTicker(params.Interval)
+	defer ticker.Stop()
+
+	var last WaitUpdate
+	for {
+		update, err := s.snapshot(ctx, params.ProjectUID, jobUID)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return timedOut(last)
+			}
+			return newWaitOutput(last, "", params.Until), err
+		}
+		last = update
+		if updates != nil {
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+			}
+		}
+
+		switch {
+		case update.JobStatus == StatusCancelled:
+			return newWaitOutput(update, WaitCancelled, params.Until),
+				clierror.CancelledError{What: fmt.Sprintf("job %q", update.JobName)}
+		case update.PercentComplete >= params.Until,
+			update.JobStatus == StatusCompleted,
+			update.JobStatus == StatusClosed:
+			return newWaitOutput(update, WaitCompleted, params.Until), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return timedOut(last)
+			}
+			return newWaitOutput(last, "", params.Until), ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s service) snapshot(ctx context.Context, projectUID, jobUID string) (WaitUpdate, error) {
+	detail, err := s.job.GetJob(ctx, projectUID, jobUID)
+	if err != nil {
+		return WaitUpdate{}, fmt.Errorf("get job %q: %w", jobUID, err)
+	}
+	progress, err := s.job.Progress(ctx, projectUID, jobUID)
+	if err != nil {
+		return WaitUpdate{}, fmt.Errorf("get job progress for %q: %w", jobUID, err)
+	}
+	locales, err := parseLocaleProgress(progress.JSON)
+	if err != nil {
+		return WaitUpdate{}, err
+	}
+	return WaitUpdate{
+		TranslationJobUID: jobUID,
+		JobName:           detail.JobName,
+		JobStatus:         detail.JobStatus,
+		PercentComplete:   progress.PercentComplete,
+		Locales:           locales,
+		CheckedAt:         time.Now(),
+	}, nil
+}
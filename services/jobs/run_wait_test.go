@@ -0,0 +1,96 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	jobmocks "github.com/Smartling/smartling-cli/services/jobs/sdkmocks"
+
+	"github.com/Smartling/api-sdk-go/api/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const waitJobUID = "aabbccdd1122"
+
+func progressResponse(percent float64, locales string) job.GetJobProgressResponse {
+	return job.GetJobProgressResponse{
+		TranslationJobUID: waitJobUID,
+		PercentComplete:   percent,
+		JSON:              []byte(`{"contentProgressReport":[` + locales + `]}`),
+	}
+}
+
+func waitParams() WaitParams {
+	return WaitParams{
+		ProjectUID:   "proj",
+		JobUIDOrName: waitJobUID,
+		Until:        100,
+		Interval:     time.Millisecond,
+	}
+}
+
+func TestRunWait_Completes(t *testing.T) {
+	m := jobmocks.NewMockJob(t)
+	m.On("GetJob", mock.Anything, "proj", waitJobUID).
+		Return(job.GetJobResponse{TranslationJobUID: waitJobUID, JobName: "Release", JobStatus: "IN_PROGRESS"}, nil)
+	m.On("Progress", mock.Anything, "proj", waitJobUID).
+		Return(progressResponse(50, `{"targetLocaleId":"de-DE","progress":{"percentComplete":50}}`), nil).Once()
+	m.On("Progress", mock.Anything, "proj", waitJobUID).
+		Return(progressResponse(100, `{"targetLocaleId":"de-DE","progress":{"percentComplete":100}}`), nil).Once()
+
+	updates := make(chan WaitUpdate, 10)
+	out, err := NewService(m).RunWait(context.Background(), waitParams(), updates)
+	require.NoError(t, err)
+	close(updates)
+
+	assert.Equal(t, WaitCompleted, out.Result)
+	assert.Equal(t, float64(100), out.PercentComplete)
+	require.Len(t, out.Locales, 1)
+	assert.Equal(t, "de-DE", out.Locales[0].LocaleID)
+
+	var percents []float64
+	for update := range updates {
+		percents = append(percents, update.PercentComplete)
+	}
+	assert.Equal(t, []float64{50, 100}, percents)
+}
+
+func TestRunWait_Cancelled(t *testing.T) {
+	m := jobmocks.NewMockJob(t)
+	m.On("GetJob", mock.Anything, "proj", waitJobUID).
+		Return(job.GetJobResponse{TranslationJobUID: waitJobUID, JobName: "Release", JobStatus: StatusCancelled}, nil)
+	m.On("Progress", mock.Anything, "proj", waitJobUID).Return(progressResponse(10, ""), nil)
+
+	out, err := NewService(m).RunWait(context.Background(), waitParams(), nil)
+	assert.Equal(t, WaitCancelled, out.Result)
+	assert.Equal(t, clierror.ExitCancelled, clierror.ExitCode(err))
+}
+
+func TestRunWait_TimesOut(t *testing.T) {
+	m := jobmocks.NewMockJob(t)
+	m.On("GetJob", mock.Anything, "proj", waitJobUID).
+		Return(job.GetJobResponse{TranslationJobUID: waitJobUID, JobStatus: "IN_PROGRESS"}, nil)
+	m.On("Progress", mock.Anything, "proj", waitJobUID).Return(progressResponse(10, ""), nil)
+
+	params := waitParams()
+	params.Interval = time.Hour
+	params.Timeout = 10 * time.Millisecond
+	out, err := NewService(m).RunWait(context.Background(), params, nil)
+	assert.Equal(t, WaitTimedOut, out.Result)
+	assert.Equal(t, float64(10), out.PercentComplete)
+	assert.Equal(t, clierror.ExitTimeout, clierror.ExitCode(err))
+}
+
+func TestWaitParams_Validate(t *testing.T) {
+	params := waitParams()
+	params.Until = 101
+	assert.Error(t, params.Validate())
+
+	params = waitParams()
+	params.Interval = 0
+	assert.Error(t, params.Validate())
+}
@@ -10,8 +10,11 @@ import (
 type Service interface {
 	RunProgress(ctx context.Context, p ProgressParams) (ProgressOutput, error)
 	RunList(ctx context.Context, p ListParams) (ListOutput, error)
+	RunListAll(ctx context.Context, p ListParams, emit func(JobListItem) error) error
 	RunView(ctx context.Context, p ViewParams) (ViewOutput, error)
 	RunFindByStrings(ctx context.Context, p FindByStringsParams) (FindByStringsOutput, error)
+	RunWait(ctx context.Context, p WaitParams, updates chan<- WaitUpdate) (WaitOutput, error)
+	RunReport(ctx context.Context, p ReportParams) (ReportOutput, error)
 }
 
 // NewService creates a new implementation of the Service
@@ -2,7 +2,9 @@ package jobstrings
 
 import (
 	"context"
+	"errors"
 
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
 
 	api "github.com/Smartling/api-sdk-go/api/job/string"
@@ -22,7 +24,8 @@ func (p AddParams) Validate() error {
 	return validateMutate(p.ProjectID, p.JobUIDOrName, p.Hashcodes)
 }
 
-// RunAdd assigns strings to a translation job.
+// RunAdd assigns strings to a translation job, sending at most
+// MaxHashcodesPerRequest hashcodes per request.
 func (s service) RunAdd(ctx context.Context, params AddParams) (MutateOutput, error) {
 	if err := params.Validate(); err != nil {
 		return MutateOutput{}, err
@@ -31,14 +34,20 @@ func (s service) RunAdd(ctx context.Context, params AddParams) (MutateOutput, er
 	if err != nil {
 		return MutateOutput{}, err
 	}
-	req := api.AddRequest{
-		Hashcodes:       params.Hashcodes,
-		TargetLocaleIDs: params.TargetLocaleIDs,
-		MoveEnabled:     params.MoveEnabled,
-	}
-	res, err := s.jobString.Add(ctx, params.ProjectID, jobUID, req)
-	if err != nil {
+	res, applied, err := inChunks(params.Hashcodes, func(chunk []string) (api.Result, error) {
+		return s.jobString.Add(ctx, params.ProjectID, jobUID, api.AddRequest{
+			Hashcodes:       chunk,
+			TargetLocaleIDs: params.TargetLocaleIDs,
+			MoveEnabled:     params.MoveEnabled,
+		})
+	})
+	if err != nil && !errors.As(err, new(clierror.PartialFailureError)) {
 		return MutateOutput{}, err
 	}
-	return newMutateOutput("added", params.ProjectID, jobUID, params.Hashcodes, params.TargetLocaleIDs, nil, res.SuccessCount, res.FailCount)
+	// A partial failure returns the output of the applied chunks.
+	out, outErr := newMutateOutput("added", params.ProjectID, jobUID, applied, params.TargetLocaleIDs, nil, res.SuccessCount, res.FailCount)
+	if outErr != nil {
+		return MutateOutput{}, outErr
+	}
+	return out, err
 }
@@ -3,6 +3,7 @@ package jobstrings
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -84,6 +85,27 @@ func TestRunAdd(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:   "splits long hashcode lists into chunks and sums results",
+			params: AddParams{ProjectID: projectUID, JobUIDOrName: jobUID, Hashcodes: hashcodes(2*MaxHashcodesPerRequest + 1)},
+			setup: func(j *jobsdkmocks.MockJob, s *stringsdkmocks.MockJobString) {
+				j.EXPECT().GetJob(ctx, projectUID, jobUID).Return(jobapi.GetJobResponse{TranslationJobUID: jobUID}, nil)
+				all := hashcodes(2*MaxHashcodesPerRequest + 1)
+				for start := 0; start < len(all); start += MaxHashcodesPerRequest {
+					chunk := all[start:min(start+MaxHashcodesPerRequest, len(all))]
+					s.EXPECT().Add(ctx, projectUID, jobUID, api.AddRequest{Hashcodes: chunk}).
+						Return(api.Result{SuccessCount: len(chunk), FailCount: 1}, nil).Once()
+				}
+			},
+			check: func(t *testing.T, got MutateOutput) {
+				if got.SuccessCount != 2*MaxHashcodesPerRequest+1 || got.FailCount != 3 {
+					t.Errorf("counts = %d/%d, want %d/3", got.SuccessCount, got.FailCount, 2*MaxHashcodesPerRequest+1)
+				}
+				if len(got.Hashcodes) != 2*MaxHashcodesPerRequest+1 {
+					t.Errorf("len(Hashcodes) = %d, want all hashcodes", len(got.Hashcodes))
+				}
+			},
+		},
 		{
 			name:   "add API error",
 			params: AddParams{ProjectID: projectUID, JobUIDOrName: jobUID, Hashcodes: []string{"h1"}},
@@ -110,3 +132,11 @@ func TestRunAdd(t *testing.T) {
 		})
 	}
 }
+
+func hashcodes(n int) []string {
+	result := make([]string, n)
+	for i := range result {
+		result[i] = fmt.Sprintf("h%d", i)
+	}
+	return result
+}
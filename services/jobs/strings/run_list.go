@@ -5,11 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/Smartling/smartling-cli/services/helpers/pager"
 	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
 
 	api "github.com/Smartling/api-sdk-go/api/job/string"
 )
 
+// DefaultListPageLimit is the page size used when listing all strings.
+const DefaultListPageLimit = 500
+
 // ListParams defines the list-strings params.
 type ListParams struct {
 	ProjectID      string
@@ -30,6 +34,11 @@ type Item struct {
 	Hashcode       string `json:"hashcode"`
 }
 
+// SimpleLine returns the string as a single human-readable line.
+func (it Item) SimpleLine() string {
+	return fmt.Sprintf("%s  %s", it.TargetLocaleID, it.Hashcode)
+}
+
 // ListOutput is the result of listing a job's strings.
 type ListOutput struct {
 	TotalCount uint32 `json:"totalCount"`
@@ -63,7 +72,7 @@ func (o ListOutput) SimpleLines() []string {
 	}
 	lines := make([]string, 0, len(o.Items)+1)
 	for _, it := range o.Items {
-		lines = append(lines, fmt.Sprintf("%s  %s", it.TargetLocaleID, it.Hashcode))
+		lines = append(lines, it.SimpleLine())
 	}
 	lines = append(lines, fmt.Sprintf("Showing %d of %d string(s). Use --limit/--offset to page.", len(o.Items), o.TotalCount))
 	return lines
@@ -98,3 +107,37 @@ func (s service) RunList(ctx context.Context, params ListParams) (ListOutput, er
 	}
 	return newListOutput(resp)
 }
+
+// RunListAll resolves the job and walks every page of its strings, at most
+// pager.DefaultConcurrency pages at a time, passing each string to emit in
+// listing order. Limit is used as the page size.
+func (s service) RunListAll(ctx context.Context, params ListParams, emit func(Item) error) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	jobUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.JobUIDOrName)
+	if err != nil {
+		return err
+	}
+
+	pageSize := params.Limit
+	if pageSize == 0 {
+		pageSize = DefaultListPageLimit
+	}
+	fetch := func(ctx context.Context, limit, offset uint32) (pager.Page[Item], error) {
+		resp, err := s.jobString.List(ctx, params.ProjectID, jobUID, api.ListParams{
+			TargetLocaleID: params.TargetLocaleID,
+			Limit:          limit,
+			Offset:         offset,
+		})
+		if err != nil {
+			return pager.Page[Item]{}, err
+		}
+		items := make([]Item, len(resp.Items))
+		for i, item := range resp.Items {
+			items[i] = Item{TargetLocaleID: item.TargetLocaleID, Hashcode: item.Hashcode}
+		}
+		return pager.Page[Item]{Items: items, TotalCount: int(resp.TotalCount)}, nil
+	}
+	return pager.Walk(ctx, pager.Params{PageSize: pageSize}, fetch, emit)
+}
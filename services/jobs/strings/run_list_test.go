@@ -10,6 +10,7 @@ import (
 
 	jobapi "github.com/Smartling/api-sdk-go/api/job"
 	api "github.com/Smartling/api-sdk-go/api/job/string"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestRunList(t *testing.T) {
@@ -75,3 +76,28 @@ func TestRunList(t *testing.T) {
 		})
 	}
 }
+
+func TestRunListAll(t *testing.T) {
+	ctx := context.Background()
+	j := jobsdkmocks.NewMockJob(t)
+	s := stringsdkmocks.NewMockJobString(t)
+	j.EXPECT().GetJob(ctx, "proj", "aabbccdd1122").Return(jobapi.GetJobResponse{TranslationJobUID: "aabbccdd1122"}, nil)
+	s.EXPECT().List(mock.Anything, "proj", "aabbccdd1122", api.ListParams{Limit: DefaultListPageLimit}).
+		Return(api.ListResponse{TotalCount: 501, Items: make([]api.StringHashcode, DefaultListPageLimit)}, nil)
+	s.EXPECT().List(mock.Anything, "proj", "aabbccdd1122", api.ListParams{Limit: DefaultListPageLimit, Offset: DefaultListPageLimit}).
+		Return(api.ListResponse{TotalCount: 501, Items: []api.StringHashcode{{TargetLocaleID: "fr-FR", Hashcode: "last"}}}, nil)
+
+	var count int
+	var last Item
+	err := service{job: j, jobString: s}.RunListAll(ctx, ListParams{ProjectID: "proj", JobUIDOrName: "aabbccdd1122"}, func(it Item) error {
+		count++
+		last = it
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RunListAll() error = %v", err)
+	}
+	if count != 501 || last.Hashcode != "last" {
+		t.Errorf("RunListAll() emitted %d items ending with %+v", count, last)
+	}
+}
@@ -0,0 +1,210 @@
+package jobstrings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
+
+	api "github.com/Smartling/api-sdk-go/api/job/string"
+)
+
+// MoveParams defines the move-strings params.
+type MoveParams struct {
+	ProjectID        string
+	FromJobUIDOrName string
+	ToJobUIDOrName   string
+	Hashcodes        []string
+	TargetLocaleIDs  []string
+	DryRun           bool
+}
+
+// Validate checks that MoveParams are valid.
+func (p MoveParams) Validate() error {
+	if err := validateMutate(p.ProjectID, p.FromJobUIDOrName, p.Hashcodes); err != nil {
+		return err
+	}
+	switch {
+	case p.ToJobUIDOrName == "":
+		return errors.New("target translation job UID or name is required")
+	case p.ToJobUIDOrName == p.FromJobUIDOrName:
+		return errors.New("source and target jobs must differ")
+	}
+	return nil
+}
+
+// MoveOutput is the result of moving strings between jobs. Hashcodes are
+// the strings found in the source job; Missing are the requested hashcodes
+// which are not in it and were left alone.
+type MoveOutput struct {
+	ProjectUID      string   `json:"projectUid"`
+	FromJobUID      string   `json:"fromJobUid"`
+	ToJobUID        string   `json:"toJobUid"`
+	DryRun          bool     `json:"dryRun"`
+	Hashcodes       []string `json:"hashcodes"`
+	Missing         []string `json:"missing,omitempty"`
+	TargetLocaleIDs []string `json:"targetLocaleIds,omitempty"`
+	SuccessCount    int      `json:"successCount"`
+	FailCount       int      `json:"failCount"`
+	RolledBack      bool     `json:"rolledBack"`
+	RollbackError   string   `json:"rollbackError,omitempty"`
+
+	JSON []byte `json:"-"`
+}
+
+// JSONBytes returns the JSON representation of the result.
+func (o MoveOutput) JSONBytes() []byte { return o.JSON }
+
+// SimpleLines returns a human-readable summary of the result.
+func (o MoveOutput) SimpleLines() []string {
+	var lines []string
+	if o.DryRun {
+		lines = append(lines, fmt.Sprintf("Would move %d string(s) from job %s to job %s: %s",
+			len(o.Hashcodes), o.FromJobUID, o.ToJobUID, strings.Join(o.Hashcodes, ", ")))
+	} else {
+		lines = append(lines, fmt.Sprintf("Strings moved from job %s to job %s: %d succeeded, %d failed",
+			o.FromJobUID, o.ToJobUID, o.SuccessCount, o.FailCount))
+	}
+	if len(o.Missing) > 0 {
+		lines = append(lines, fmt.Sprintf("Not in job %s: %s", o.FromJobUID, strings.Join(o.Missing, ", ")))
+	}
+	if o.RolledBack {
+		lines = append(lines, fmt.Sprintf("Move failed, strings were returned to job %s.", o.FromJobUID))
+	}
+	if o.RollbackError != "" {
+		lines = append(lines, "Rollback error: "+o.RollbackError)
+	}
+	return lines
+}
+
+// TableData returns the result as a single-row table.
+func (o MoveOutput) TableData() ([]string, [][]string) {
+	return []string{"FROM JOB UID", "TO JOB UID", "STRINGS", "MISSING", "SUCCEEDED", "FAILED", "ROLLED BACK"},
+		[][]string{{o.FromJobUID, o.ToJobUID, strconv.Itoa(len(o.Hashcodes)), strconv.Itoa(len(o.Missing)),
+			strconv.Itoa(o.SuccessCount), strconv.Itoa(o.FailCount), strconv.FormatBool(o.RolledBack)}}
+}
+
+// RunMove moves strings from one job to another. Hashcodes missing from the
+// source job are reported and skipped. The strings are added to the target
+// job with moving enabled, which removes them from the source job in the
+// same call; if some of them fail, all of them are moved back.
+func (s service) RunMove(ctx context.Context, params MoveParams) (MoveOutput, error) {
+	if err := params.Validate(); err != nil {
+		return MoveOutput{}, err
+	}
+	fromUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.FromJobUIDOrName)
+	if err != nil {
+		return MoveOutput{}, fmt.Errorf("resolve source job: %w", err)
+	}
+	toUID, err := jobresolver.GetJobUID(ctx, s.job, params.ProjectID, params.ToJobUIDOrName)
+	if err != nil {
+		return MoveOutput{}, fmt.Errorf("resolve target job: %w", err)
+	}
+	if fromUID == toUID {
+		return MoveOutput{}, errors.New("source and target jobs must differ")
+	}
+
+	// sourceLocales are the target locales of each string in the source job,
+	// which a rollback restores.
+	sourceLocales := map[string][]string{}
+	err = s.RunListAll(ctx, ListParams{ProjectID: params.ProjectID, JobUIDOrName: fromUID}, func(it Item) error {
+		sourceLocales[it.Hashcode] = append(sourceLocales[it.Hashcode], it.TargetLocaleID)
+		return nil
+	})
+	if err != nil {
+		return MoveOutput{}, err
+	}
+
+	out := MoveOutput{
+		ProjectUID:      params.ProjectID,
+		FromJobUID:      fromUID,
+		ToJobUID:        toUID,
+		DryRun:          params.DryRun,
+		TargetLocaleIDs: params.TargetLocaleIDs,
+	}
+	seen := map[string]bool{}
+	for _, hashcode := range params.Hashcodes {
+		if seen[hashcode] {
+			continue
+		}
+		seen[hashcode] = true
+		if _, ok := sourceLocales[hashcode]; ok {
+			out.Hashcodes = append(out.Hashcodes, hashcode)
+		} else {
+			out.Missing = append(out.Missing, hashcode)
+		}
+	}
+	if len(out.Hashcodes) == 0 {
+		return MoveOutput{}, fmt.Errorf("none of the hashcodes are in job %s", fromUID)
+	}
+	if params.DryRun {
+		return finishMove(out, nil)
+	}
+
+	res, err := s.jobString.Add(ctx, params.ProjectID, toUID, api.AddRequest{
+		Hashcodes:       out.Hashcodes,
+		TargetLocaleIDs: params.TargetLocaleIDs,
+		MoveEnabled:     true,
+	})
+	if err != nil {
+		return MoveOutput{}, fmt.Errorf("add strings to job %s: %w", toUID, err)
+	}
+	out.SuccessCount, out.FailCount = res.SuccessCount, res.FailCount
+	if res.FailCount == 0 {
+		return finishMove(out, nil)
+	}
+
+	moveErr := fmt.Errorf("%d string(s) failed to move to job %s", res.FailCount, toUID)
+	if res.SuccessCount > 0 {
+		if rollbackErr := s.rollbackMove(ctx, params.ProjectID, fromUID, out.Hashcodes, sourceLocales); rollbackErr != nil {
+			out.RollbackError = rollbackErr.Error()
+			moveErr = errors.Join(moveErr, clierror.PartialFailureError{Failed: res.SuccessCount, Total: res.SuccessCount + res.FailCount})
+		} else {
+			out.RolledBack = true
+		}
+	}
+	return finishMove(out, moveErr)
+}
+
+// rollbackMove moves the strings back to the source job with the target
+// locales each had there. Strings with the same locales are moved back
+// together.
+func (s service) rollbackMove(ctx context.Context, projectID, fromUID string, hashcodes []string, sourceLocales map[string][]string) error {
+	var (
+		keys   []string
+		groups = map[string]*api.AddRequest{}
+	)
+	for _, hashcode := range hashcodes {
+		locales := slices.Sorted(slices.Values(sourceLocales[hashcode]))
+		key := strings.Join(locales, ",")
+		group, ok := groups[key]
+		if !ok {
+			group = &api.AddRequest{TargetLocaleIDs: locales, MoveEnabled: true}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		group.Hashcodes = append(group.Hashcodes, hashcode)
+	}
+	var errs []error
+	for _, key := range keys {
+		if _, err := s.jobString.Add(ctx, projectID, fromUID, *groups[key]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func finishMove(out MoveOutput, moveErr error) (MoveOutput, error) {
+	b, err := json.Marshal(out)
+	if err != nil {
+		return MoveOutput{}, fmt.Errorf("marshal move result to JSON: %w", err)
+	}
+	out.JSON = b
+	return out, moveErr
+}
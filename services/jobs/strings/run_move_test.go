@@ -0,0 +1,92 @@
+package jobstrings
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	jobsdkmocks "github.com/Smartling/smartling-cli/services/jobs/sdkmocks"
+	stringsdkmocks "github.com/Smartling/smartling-cli/services/jobs/strings/sdkmocks"
+
+	jobapi "github.com/Smartling/api-sdk-go/api/job"
+	api "github.com/Smartling/api-sdk-go/api/job/string"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMove(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectUID = "test-project-id"
+		fromUID    = "fromjob00001"
+		toUID      = "tojob0000001"
+	)
+	params := MoveParams{
+		ProjectID:        projectUID,
+		FromJobUIDOrName: fromUID,
+		ToJobUIDOrName:   toUID,
+		Hashcodes:        []string{"h1", "h2", "h1", "other"},
+	}
+	newService := func(t *testing.T) (service, *stringsdkmocks.MockJobString) {
+		job := jobsdkmocks.NewMockJob(t)
+		for _, uid := range []string{fromUID, toUID} {
+			job.EXPECT().GetJob(mock.Anything, projectUID, uid).Return(jobapi.GetJobResponse{TranslationJobUID: uid}, nil)
+		}
+		str := stringsdkmocks.NewMockJobString(t)
+		str.EXPECT().List(mock.Anything, projectUID, fromUID, api.ListParams{Limit: DefaultListPageLimit}).
+			Return(api.ListResponse{TotalCount: 3, Items: []api.StringHashcode{
+				{TargetLocaleID: "fr-FR", Hashcode: "h1"},
+				{TargetLocaleID: "de-DE", Hashcode: "h1"},
+				{TargetLocaleID: "fr-FR", Hashcode: "h2"},
+			}}, nil)
+		return service{jobString: str, job: job}, str
+	}
+
+	t.Run("dry run reports strings missing from the source job", func(t *testing.T) {
+		s, _ := newService(t)
+		p := params
+		p.DryRun = true
+		got, err := s.RunMove(ctx, p)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"h1", "h2"}, got.Hashcodes)
+		assert.Equal(t, []string{"other"}, got.Missing)
+	})
+
+	t.Run("adds to target with moving enabled", func(t *testing.T) {
+		s, str := newService(t)
+		str.EXPECT().Add(ctx, projectUID, toUID, api.AddRequest{Hashcodes: []string{"h1", "h2"}, MoveEnabled: true}).
+			Return(api.Result{SuccessCount: 3}, nil)
+		got, err := s.RunMove(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, 3, got.SuccessCount)
+		assert.False(t, got.RolledBack)
+	})
+
+	t.Run("partial failure moves strings back with their source locales", func(t *testing.T) {
+		s, str := newService(t)
+		str.EXPECT().Add(ctx, projectUID, toUID, mock.Anything).Return(api.Result{SuccessCount: 2, FailCount: 1}, nil)
+		str.EXPECT().Add(ctx, projectUID, fromUID, api.AddRequest{Hashcodes: []string{"h1"}, TargetLocaleIDs: []string{"de-DE", "fr-FR"}, MoveEnabled: true}).
+			Return(api.Result{SuccessCount: 2}, nil)
+		str.EXPECT().Add(ctx, projectUID, fromUID, api.AddRequest{Hashcodes: []string{"h2"}, TargetLocaleIDs: []string{"fr-FR"}, MoveEnabled: true}).
+			Return(api.Result{SuccessCount: 1}, nil)
+		got, err := s.RunMove(ctx, params)
+		require.Error(t, err)
+		assert.True(t, got.RolledBack)
+		assert.Equal(t, clierror.ExitGeneral, clierror.ExitCode(err))
+	})
+
+	t.Run("failed rollback is a partial failure", func(t *testing.T) {
+		s, str := newService(t)
+		str.EXPECT().Add(ctx, projectUID, toUID, mock.Anything).Return(api.Result{SuccessCount: 2, FailCount: 1}, nil)
+		str.EXPECT().Add(ctx, projectUID, fromUID, api.AddRequest{Hashcodes: []string{"h1"}, TargetLocaleIDs: []string{"de-DE", "fr-FR"}, MoveEnabled: true}).
+			Return(api.Result{SuccessCount: 2}, nil)
+		str.EXPECT().Add(ctx, projectUID, fromUID, api.AddRequest{Hashcodes: []string{"h2"}, TargetLocaleIDs: []string{"fr-FR"}, MoveEnabled: true}).
+			Return(api.Result{}, errors.New("boom"))
+		got, err := s.RunMove(ctx, params)
+		assert.False(t, got.RolledBack)
+		assert.Equal(t, "boom", got.RollbackError)
+		assert.Equal(t, clierror.ExitPartial, clierror.ExitCode(err))
+	})
+}
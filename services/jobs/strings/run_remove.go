@@ -2,7 +2,9 @@ package jobstrings
 
 import (
 	"context"
+	"errors"
 
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	"github.com/Smartling/smartling-cli/services/jobs/jobresolver"
 
 	api "github.com/Smartling/api-sdk-go/api/job/string"
@@ -21,7 +23,8 @@ func (p RemoveParams) Validate() error {
 	return validateMutate(p.ProjectID, p.JobUIDOrName, p.Hashcodes)
 }
 
-// RunRemove unassigns strings from a translation job.
+// RunRemove unassigns strings from a translation job, sending at most
+// MaxHashcodesPerRequest hashcodes per request.
 func (s service) RunRemove(ctx context.Context, params RemoveParams) (MutateOutput, error) {
 	if err := params.Validate(); err != nil {
 		return MutateOutput{}, err
@@ -30,13 +33,19 @@ func (s service) RunRemove(ctx context.Context, params RemoveParams) (MutateOutp
 	if err != nil {
 		return MutateOutput{}, err
 	}
-	req := api.RemoveRequest{
-		Hashcodes: params.Hashcodes,
-		LocaleIDs: params.LocaleIDs,
-	}
-	res, err := s.jobString.Remove(ctx, params.ProjectID, jobUID, req)
-	if err != nil {
+	res, applied, err := inChunks(params.Hashcodes, func(chunk []string) (api.Result, error) {
+		return s.jobString.Remove(ctx, params.ProjectID, jobUID, api.RemoveRequest{
+			Hashcodes: chunk,
+			LocaleIDs: params.LocaleIDs,
+		})
+	})
+	if err != nil && !errors.As(err, new(clierror.PartialFailureError)) {
 		return MutateOutput{}, err
 	}
-	return newMutateOutput("removed", params.ProjectID, jobUID, params.Hashcodes, nil, params.LocaleIDs, res.SuccessCount, res.FailCount)
+	// A partial failure returns the output of the applied chunks.
+	out, outErr := newMutateOutput("removed", params.ProjectID, jobUID, applied, nil, params.LocaleIDs, res.SuccessCount, res.FailCount)
+	if outErr != nil {
+		return MutateOutput{}, outErr
+	}
+	return out, err
 }
@@ -3,8 +3,10 @@ package jobstrings
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	jobsdkmocks "github.com/Smartling/smartling-cli/services/jobs/sdkmocks"
 	stringsdkmocks "github.com/Smartling/smartling-cli/services/jobs/strings/sdkmocks"
 
@@ -79,3 +81,33 @@ func TestRunRemove(t *testing.T) {
 		})
 	}
 }
+
+func TestRunRemove_FailedChunkIsPartialFailure(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectUID = "test-project-id"
+		jobUID     = "aabbccdd1122"
+	)
+	job := jobsdkmocks.NewMockJob(t)
+	job.EXPECT().GetJob(ctx, projectUID, jobUID).Return(jobapi.GetJobResponse{TranslationJobUID: jobUID}, nil)
+	str := stringsdkmocks.NewMockJobString(t)
+	all := hashcodes(MaxHashcodesPerRequest + 1)
+	str.EXPECT().Remove(ctx, projectUID, jobUID, api.RemoveRequest{Hashcodes: all[:MaxHashcodesPerRequest]}).
+		Return(api.Result{SuccessCount: MaxHashcodesPerRequest}, nil)
+	str.EXPECT().Remove(ctx, projectUID, jobUID, api.RemoveRequest{Hashcodes: all[MaxHashcodesPerRequest:]}).
+		Return(api.Result{}, errors.New("api error"))
+
+	got, err := service{jobString: str, job: job}.RunRemove(ctx, RemoveParams{ProjectID: projectUID, JobUIDOrName: jobUID, Hashcodes: all})
+	if code := clierror.ExitCode(err); code != clierror.ExitPartial {
+		t.Errorf("ExitCode(%v) = %d, want %d", err, code, clierror.ExitPartial)
+	}
+	if got.SuccessCount != MaxHashcodesPerRequest || got.FailCount != 0 {
+		t.Errorf("counts = %d succeeded, %d failed, want %d, 0", got.SuccessCount, got.FailCount, MaxHashcodesPerRequest)
+	}
+	if !reflect.DeepEqual(got.Hashcodes, all[:MaxHashcodesPerRequest]) {
+		t.Errorf("Hashcodes = %d hashcodes, want the %d of the applied chunk", len(got.Hashcodes), MaxHashcodesPerRequest)
+	}
+	if got.TranslationJobUID != jobUID {
+		t.Errorf("TranslationJobUID = %q, want %q", got.TranslationJobUID, jobUID)
+	}
+}
@@ -5,18 +5,27 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+
 	jobapi "github.com/Smartling/api-sdk-go/api/job"
 	api "github.com/Smartling/api-sdk-go/api/job/string"
 )
 
+// MaxHashcodesPerRequest is the number of hashcodes sent in a single add or
+// remove request; longer lists are split into several requests.
+const MaxHashcodesPerRequest = 1000
+
 // Service defines behavior for managing a translation job's strings.
 type Service interface {
 	RunAdd(ctx context.Context, params AddParams) (MutateOutput, error)
 	RunRemove(ctx context.Context, params RemoveParams) (MutateOutput, error)
 	RunList(ctx context.Context, params ListParams) (ListOutput, error)
+	RunListAll(ctx context.Context, params ListParams, emit func(Item) error) error
+	RunMove(ctx context.Context, params MoveParams) (MoveOutput, error)
 }
 
 // NewService creates a new implementation of the Service. The job API resolves a
@@ -88,6 +97,33 @@ func (o MutateOutput) TableData() ([]string, [][]string) {
 		[][]string{{o.Action, o.TranslationJobUID, strconv.Itoa(o.SuccessCount), strconv.Itoa(o.FailCount)}}
 }
 
+// inChunks calls apply for consecutive chunks of at most
+// MaxHashcodesPerRequest hashcodes and sums the results. It returns the
+// hashcodes of the applied chunks. A failure after some chunks were applied
+// is reported as a partial failure along with the results of those chunks.
+func inChunks(hashcodes []string, apply func(chunk []string) (api.Result, error)) (api.Result, []string, error) {
+	var (
+		total api.Result
+		done  int
+	)
+	for chunk := range slices.Chunk(hashcodes, MaxHashcodesPerRequest) {
+		res, err := apply(chunk)
+		if err != nil {
+			if done == 0 {
+				return api.Result{}, nil, err
+			}
+			return total, hashcodes[:done], errors.Join(
+				fmt.Errorf("request for hashcodes %d-%d of %d: %w", done+1, done+len(chunk), len(hashcodes), err),
+				clierror.PartialFailureError{Failed: len(hashcodes) - done, Total: len(hashcodes)},
+			)
+		}
+		total.SuccessCount += res.SuccessCount
+		total.FailCount += res.FailCount
+		done += len(chunk)
+	}
+	return total, hashcodes, nil
+}
+
 func validateIDs(projectID, jobUIDOrName string) error {
 	switch {
 	case projectID == "":
@@ -7,17 +7,21 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
 	globfiles "github.com/Smartling/smartling-cli/services/helpers/glob_files"
+	"github.com/Smartling/smartling-cli/services/helpers/result"
 	"github.com/Smartling/smartling-cli/services/helpers/rlog"
 
 	api "github.com/Smartling/api-sdk-go/api/mt"
 	smfile "github.com/Smartling/api-sdk-go/helpers/sm_file"
 	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
 )
 
 // TranslateParams is the parameters for the RunTranslate method.
@@ -30,11 +34,18 @@ type TranslateParams struct {
 	Progress         bool
 	OverrideFileType string
 	AccountUID       uid.AccountUID
+	// Threads bounds the files processed at a time and, separately, the
+	// translated files downloaded at a time; zero means one at a time.
+	Threads uint32
 }
 
+// RunTranslate uploads, machine translates and downloads the files. Up to
+// params.Threads files are processed at a time, and up to params.Threads
+// translated files are downloaded at a time across all of them. Every file
+// and locale is recorded in a report: a failure does not stop the other
+// files, the outputs of the translations which were downloaded are returned
+// in the order of files, and failures make the error a partial failure.
 func (s service) RunTranslate(ctx context.Context, params TranslateParams, files []string, updates chan any) ([]TranslateOutput, error) {
-	var res []TranslateOutput
-
 	// rowsPerFile is the upper bound on TUI rows reserved per file. Must match
 	// the renderer's preallocation in cmd/mt/translate/run.go so row IDs stay
 	// in range when len(TargetLocales) == 0 or when the server returns more
@@ -43,207 +54,378 @@ func (s service) RunTranslate(ctx context.Context, params TranslateParams, files
 	if rowsPerFile == 0 {
 		rowsPerFile = 1
 	}
+	if err := checkOutputNames(files); err != nil {
+		return nil, err
+	}
+	outputDirectory, err := makeOutputDirectory(params.OutputDirectory)
+	if err != nil {
+		return nil, err
+	}
 
+	threads := max(1, int(params.Threads))
+	t := translation{
+		service:         s,
+		params:          params,
+		rowsPerFile:     rowsPerFile,
+		outputDirectory: outputDirectory,
+		downloads:       semaphore.NewWeighted(int64(threads)),
+		updates:         updates,
+		report:          result.NewReport("translate"),
+	}
+	res := make([][]TranslateOutput, len(files))
+	var group errgroup.Group
+	group.SetLimit(threads)
 	for fileID, file := range files {
-		rlog.Debugf("Running translate for file %s", file)
-		contents, err := getContent(params.InputDirectory, file)
-		if err != nil {
-			return nil, err
-		}
-		var fileType api.Type
-		var found bool
-		if params.OverrideFileType != "" {
-			fileType, found = smfile.ParseType(api.FirstType, api.LastType, params.OverrideFileType)
-			if !found {
-				rlog.Debugf("unknown override file type: %s", params.OverrideFileType)
+		group.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				t.report.Skipped(file, "", "not started: "+err.Error())
+				return nil
 			}
-		}
-		if !found {
-			var found bool
-			fileType, found = api.TypeByExt[filepath.Ext(file)]
-			if !found {
-				rlog.Debugf("unknown file type for file: %s", file)
+			out, err := t.translateFile(ctx, fileID, file)
+			if err != nil {
+				t.report.Failed(file, "", err)
 			}
+			res[fileID] = out
+			return nil
+		})
+	}
+	_ = group.Wait()
+	out := slices.Concat(res...)
+	if err := ctx.Err(); err != nil {
+		return out, err
+	}
+	return out, translateErr(t.report)
+}
+
+// translateErr returns the partial failure of the report, if any, along with
+// the reason of every failed file and locale.
+func translateErr(report *result.Report) error {
+	err := report.Err()
+	if err == nil {
+		return nil
+	}
+	errs := []error{err}
+	for _, item := range report.Items() {
+		if item.Status != result.StatusFailed {
+			continue
 		}
-		request := api.UploadFileRequest{
-			File:               contents,
-			LocalesToAuthorize: []string{params.SourceLocale},
-			FileType:           fileType,
-			Directives:         params.Directives,
+		name := item.File
+		if item.Locale != "" {
+			name += " " + item.Locale
 		}
-		rlog.Debugf("start upload")
-		uploadFileResponse, err := s.uploader.UploadFile(ctx, params.AccountUID, filepath.Base(file), request)
-		if err != nil {
+		errs = append(errs, fmt.Errorf("%s: %s", name, item.Reason))
+	}
+	return errors.Join(errs...)
+}
+
+// translation is the state shared by the workers of one RunTranslate call.
+// Updates are sent by value and row IDs do not overlap between files, so the
+// renderer sees the same rows as for files processed one after another.
+type translation struct {
+	service
+	params          TranslateParams
+	rowsPerFile     int
+	outputDirectory string
+	// downloads bounds the translated files downloaded at a time.
+	downloads *semaphore.Weighted
+	updates   chan<- any
+	report    *result.Report
+}
+
+// translateFile runs upload, optional source language detection, translation
+// and download for one file. The locales are recorded in the report by
+// downloadLocales; a returned error fails the whole file.
+func (t translation) translateFile(ctx context.Context, fileID int, file string) ([]TranslateOutput, error) {
+	rlog.Debugf("Running translate for file %s", file)
+	contents, err := getContent(t.params.InputDirectory, file)
+	if err != nil {
+		return nil, err
+	}
+	request := api.UploadFileRequest{
+		File:               contents,
+		LocalesToAuthorize: []string{t.params.SourceLocale},
+		FileType:           translateFileType(t.params.OverrideFileType, file),
+		Directives:         t.params.Directives,
+	}
+	rlog.Debugf("start upload")
+	uploadFileResponse, err := t.uploader.UploadFile(ctx, t.params.AccountUID, filepath.Base(file), request)
+	if err != nil {
+		return nil, err
+	}
+	if err := uploadFileResponse.FileUID.Validate(); err != nil {
+		return nil, err
+	}
+	rlog.Debugf("finish upload")
+
+	update := TranslateUpdates{ID: uint32(fileID * t.rowsPerFile), Upload: new(true)}
+	t.updates <- update
+
+	// The source locale is detected for every file, as the files of one
+	// call may be in different languages.
+	sourceLocale := t.params.SourceLocale
+	if sourceLocale == "" {
+		if sourceLocale, err = t.detectSourceLocale(ctx, uploadFileResponse.FileUID); err != nil {
 			return nil, err
 		}
-		if err := uploadFileResponse.FileUID.Validate(); err != nil {
+	}
+
+	startParams := api.StartParams{
+		SourceLocaleID:  sourceLocale,
+		TargetLocaleIDs: t.params.TargetLocales,
+	}
+	rlog.Debugf("start translation")
+	translatorStartResponse, err := t.fileTranslator.Start(ctx, t.params.AccountUID, uploadFileResponse.FileUID, startParams)
+	if err != nil {
+		return nil, err
+	}
+
+	update.Translate = new("start")
+	t.updates <- update
+
+	if err := translatorStartResponse.MtUID.Validate(); err != nil {
+		return nil, clierror.UIError{
+			Err:       err,
+			Operation: "Start translation",
+			Fields: map[string]string{
+				"startTranslationCode": strconv.Itoa(translatorStartResponse.Code),
+				"file":                 file,
+				"uploadCode":           strconv.Itoa(uploadFileResponse.Code),
+				"FileUID":              string(uploadFileResponse.FileUID),
+			},
+			Description: "Translation cannot start. Check if the file is supported and if the source/target locale is valid.",
+		}
+	}
+
+	started := time.Now()
+	for {
+		if time.Since(started) > pollingDuration {
+			return nil, errors.New("timeout exceeded for polling file translation progress FileUID:" + string(uploadFileResponse.FileUID))
+		}
+		rlog.Debugf("check translation progress")
+		progressResponse, err := t.fileTranslator.Progress(ctx, t.params.AccountUID, uploadFileResponse.FileUID, translatorStartResponse.MtUID)
+		if err != nil {
 			return nil, err
 		}
-		rlog.Debugf("finish upload")
 
-		update := TranslateUpdates{ID: uint32(fileID * rowsPerFile), Upload: new(true)}
-		updates <- update
+		update.Translate = new(progressResponse.State)
+		t.updates <- update
 
-		if params.SourceLocale == "" {
-			rlog.Debugf("detect language")
-			detectFileLanguageResponse, err := s.translationControl.DetectFileLanguage(ctx, params.AccountUID, uploadFileResponse.FileUID)
-			if err != nil {
+		rlog.Debugf("progress state: %s", progressResponse.State)
+		switch strings.ToUpper(progressResponse.State) {
+		case api.QueuedTranslatedState, api.ProcessingTranslatedState:
+			if err := wait(ctx); err != nil {
 				return nil, err
 			}
-			started := time.Now()
-			var processed bool
-			for !processed {
-				if time.Since(started) > pollingDuration {
-					return nil, errors.New("timeout exceeded for polling detect file language progress: FileUID:" + string(uploadFileResponse.FileUID))
-				}
-				rlog.Debugf("check detection progress")
-				detectionProgressResponse, err := s.translationControl.DetectionProgress(ctx, params.AccountUID, uploadFileResponse.FileUID, detectFileLanguageResponse.LanguageDetectionUID)
-				if err != nil {
-					return nil, err
-				}
-
-				rlog.Debugf("detection progress state: %s", detectionProgressResponse.State)
-				switch strings.ToUpper(detectionProgressResponse.State) {
-				case api.QueuedTranslatedState, api.ProcessingTranslatedState:
-					time.Sleep(pollingInterval)
-					continue
-				case api.FailedTranslatedState, api.CanceledTranslatedState, api.CompletedTranslatedState:
-					processed = true
-				default:
-					processed = true
-				}
-				if detectionProgressResponse.State != api.CompletedTranslatedState {
-					rlog.Debugf("detection progress break on incomplete state: %s", detectionProgressResponse.State)
-					break
-				}
-				if len(detectionProgressResponse.DetectedSourceLanguages) > 0 {
-					params.SourceLocale = detectionProgressResponse.DetectedSourceLanguages[0].LanguageID
-				}
-			}
+			continue
+		}
+		if progressResponse.State != api.CompletedTranslatedState {
+			t.report.Skipped(file, "", "translation "+strings.ToLower(progressResponse.State))
+			return nil, nil
 		}
+		return t.downloadLocales(ctx, fileID, file, uploadFileResponse.FileUID, translatorStartResponse.MtUID, progressResponse.LocaleProcessStatuses, update)
+	}
+}
 
-		startParams := api.StartParams{
-			SourceLocaleID:  params.SourceLocale,
-			TargetLocaleIDs: params.TargetLocales,
+// detectSourceLocale detects the language of an uploaded file. It returns ""
+// when detection does not complete, which leaves the source locale to the
+// server.
+func (t translation) detectSourceLocale(ctx context.Context, fileUID uid.FileUID) (string, error) {
+	rlog.Debugf("detect language")
+	detectFileLanguageResponse, err := t.translationControl.DetectFileLanguage(ctx, t.params.AccountUID, fileUID)
+	if err != nil {
+		return "", err
+	}
+	started := time.Now()
+	for {
+		if time.Since(started) > pollingDuration {
+			return "", errors.New("timeout exceeded for polling detect file language progress: FileUID:" + string(fileUID))
 		}
-		rlog.Debugf("start translation")
-		translatorStartResponse, err := s.fileTranslator.Start(ctx, params.AccountUID, uploadFileResponse.FileUID, startParams)
+		rlog.Debugf("check detection progress")
+		detectionProgressResponse, err := t.translationControl.DetectionProgress(ctx, t.params.AccountUID, fileUID, detectFileLanguageResponse.LanguageDetectionUID)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
-		update.Translate = new("start")
-		updates <- update
-
-		if err := translatorStartResponse.MtUID.Validate(); err != nil {
-			return nil, clierror.UIError{
-				Err:       err,
-				Operation: "Start translation",
-				Fields: map[string]string{
-					"startTranslationCode": strconv.Itoa(translatorStartResponse.Code),
-					"file":                 file,
-					"uploadCode":           strconv.Itoa(uploadFileResponse.Code),
-					"FileUID":              string(uploadFileResponse.FileUID),
-				},
-				Description: "Translation cannot start. Check if the file is supported and if the source/target locale is valid.",
+		rlog.Debugf("detection progress state: %s", detectionProgressResponse.State)
+		switch strings.ToUpper(detectionProgressResponse.State) {
+		case api.QueuedTranslatedState, api.ProcessingTranslatedState:
+			if err := wait(ctx); err != nil {
+				return "", err
 			}
+			continue
+		}
+		if detectionProgressResponse.State != api.CompletedTranslatedState {
+			rlog.Debugf("detection progress break on incomplete state: %s", detectionProgressResponse.State)
+			return "", nil
+		}
+		if len(detectionProgressResponse.DetectedSourceLanguages) > 0 {
+			return detectionProgressResponse.DetectedSourceLanguages[0].LanguageID, nil
 		}
+		return "", nil
+	}
+}
 
-		started := time.Now()
-		var processed bool
-		for !processed {
-			if time.Since(started) > pollingDuration {
-				return nil, errors.New("timeout exceeded for polling file translation progress FileUID:" + string(uploadFileResponse.FileUID))
-			}
-			rlog.Debugf("check translation progress")
-			progressResponse, err := s.fileTranslator.Progress(ctx, params.AccountUID, uploadFileResponse.FileUID, translatorStartResponse.MtUID)
-			if err != nil {
-				return nil, err
-			}
+// downloadLocales downloads the translations of a file in parallel. The
+// locale rows are announced in order first; each row is then marked when its
+// download is saved. A failed download does not stop the other locales; the
+// outputs of the saved downloads are returned.
+func (t translation) downloadLocales(ctx context.Context,
+	fileID int,
+	file string,
+	fileUID uid.FileUID,
+	mtUID uid.MtUID,
+	statuses []api.LocaleProcessStatusResponse,
+	update TranslateUpdates,
+) ([]TranslateOutput, error) {
+	filename := filepath.Base(file)
+	ext := filepath.Ext(filename)
+	name := strings.TrimSuffix(filename, ext)
 
-			update.Translate = new(progressResponse.State)
-			updates <- update
-
-			rlog.Debugf("progress state: %s", progressResponse.State)
-			switch strings.ToUpper(progressResponse.State) {
-			case api.QueuedTranslatedState, api.ProcessingTranslatedState:
-				time.Sleep(pollingInterval)
-				continue
-			case api.FailedTranslatedState, api.CanceledTranslatedState, api.CompletedTranslatedState:
-				processed = true
-			default:
-				processed = true
+	var (
+		res   []TranslateOutput
+		group errgroup.Group
+	)
+	// saved marks the locales whose download is saved, by index in res.
+	saved := make([]bool, len(statuses))
+	for localeIdx, localeProcessStatus := range statuses {
+		if localeIdx >= t.rowsPerFile {
+			rlog.Debugf("dropping update for unexpected extra locale %q from server (file=%q row capacity=%d)", localeProcessStatus.LocaleID, file, t.rowsPerFile)
+			continue
+		}
+		res = append(res, TranslateOutput{
+			File:      filename,
+			Locale:    localeProcessStatus.LocaleID,
+			Name:      name,
+			Ext:       ext,
+			Directory: filepath.Dir(file),
+		})
+		localeUpdate := update
+		localeUpdate.ID = uint32(fileID*t.rowsPerFile + localeIdx)
+		localeUpdate.Locale = new(localeProcessStatus.LocaleID)
+		t.updates <- localeUpdate
+
+		translatedFile := name + "_" + localeProcessStatus.LocaleID + ext
+		group.Go(func() error {
+			if err := t.downloads.Acquire(ctx, 1); err != nil {
+				t.report.Failed(file, localeProcessStatus.LocaleID, err)
+				return nil
 			}
-			if progressResponse.State != api.CompletedTranslatedState {
-				break
+			defer t.downloads.Release(1)
+			if err := t.download(ctx, fileUID, mtUID, localeProcessStatus.LocaleID, filepath.Join(t.outputDirectory, translatedFile)); err != nil {
+				t.report.Failed(file, localeProcessStatus.LocaleID, err)
+				return nil
 			}
-			for localeIdx, localeProcessStatus := range progressResponse.LocaleProcessStatuses {
-				if localeIdx >= rowsPerFile {
-					rlog.Debugf("dropping update for unexpected extra locale %q from server (file=%q row capacity=%d)", localeProcessStatus.LocaleID, file, rowsPerFile)
-					continue
-				}
-				filename := filepath.Base(file)
-				ext := filepath.Ext(filename)
-				name := strings.TrimSuffix(filename, ext)
-				res = append(res, TranslateOutput{
-					File:      filename,
-					Locale:    localeProcessStatus.LocaleID,
-					Name:      name,
-					Ext:       ext,
-					Directory: filepath.Dir(file),
-				})
-				rowID := uint32(fileID*rowsPerFile + localeIdx)
-				update.ID = rowID
-				update.Locale = new(localeProcessStatus.LocaleID)
-				updates <- update
-
-				filenameLocale := strings.TrimSuffix(file, ext) + "_" + localeProcessStatus.LocaleID + ext
-				outputDirectory, err := filepath.Abs(params.OutputDirectory)
-				if err != nil {
-					return nil, clierror.UIError{
-						Err:         err,
-						Operation:   "get absolute output directory",
-						Description: "unable to get absolute path for output directory",
-						Fields: map[string]string{
-							"outputDirectory": params.OutputDirectory,
-						},
-					}
-				}
-				if err := os.MkdirAll(outputDirectory, 0o755); err != nil {
-					return nil, clierror.UIError{
-						Err:         err,
-						Operation:   "create output directory",
-						Description: "unable to create output directory",
-						Fields: map[string]string{
-							"outputDirectory": outputDirectory,
-						},
-					}
-				}
-				err = func() error {
-					rlog.Debugf("download start")
-					reader, err := s.downloader.File(ctx, params.AccountUID, uploadFileResponse.FileUID, translatorStartResponse.MtUID, localeProcessStatus.LocaleID)
-					if err != nil {
-						return err
-					}
-					defer func() {
-						if err := reader.Close(); err != nil {
-							fmt.Fprintln(os.Stderr, err)
-						}
-					}()
-					rlog.Debugf("download finished")
-					return saveToFile(reader, filepath.Join(outputDirectory, filepath.Base(filenameLocale)))
-				}()
-				if err != nil {
-					return nil, err
-				}
-				update.ID = rowID
-				update.TranslatedFile = new(filepath.Base(filenameLocale))
-				update.Download = new(true)
-				updates <- update
+			t.report.OK(file, localeProcessStatus.LocaleID)
+			saved[localeIdx] = true
+			localeUpdate.TranslatedFile = new(translatedFile)
+			localeUpdate.Download = new(true)
+			t.updates <- localeUpdate
+			return nil
+		})
+	}
+	_ = group.Wait()
+	var out []TranslateOutput
+	for outputID, output := range res {
+		if saved[outputID] {
+			out = append(out, output)
+		}
+	}
+	return out, nil
+}
+
+func (t translation) download(ctx context.Context, fileUID uid.FileUID, mtUID uid.MtUID, localeID, path string) error {
+	rlog.Debugf("download start")
+	reader, err := t.downloader.File(ctx, t.params.AccountUID, fileUID, mtUID, localeID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+	rlog.Debugf("download finished")
+	return saveToFile(reader, path)
+}
+
+// translateFileType returns the override file type when it is known, and
+// the type matching the file extension otherwise.
+func translateFileType(overrideFileType, file string) api.Type {
+	if overrideFileType != "" {
+		fileType, found := smfile.ParseType(api.FirstType, api.LastType, overrideFileType)
+		if found {
+			return fileType
+		}
+		rlog.Debugf("unknown override file type: %s", overrideFileType)
+	}
+	fileType, found := api.TypeByExt[filepath.Ext(file)]
+	if !found {
+		rlog.Debugf("unknown file type for file: %s", file)
+	}
+	return fileType
+}
+
+// checkOutputNames fails when two files share a file name. Translations are
+// saved to the output directory by file name and locale only, so they would
+// overwrite each other.
+func checkOutputNames(files []string) error {
+	seen := map[string]string{}
+	for _, file := range files {
+		name := filepath.Base(file)
+		if other, ok := seen[name]; ok {
+			return clierror.UIError{
+				Err:       fmt.Errorf("%s and %s have the same file name", other, file),
+				Operation: "check files",
+				Description: `Translated files are saved to the output directory by file name,` +
+					` so these would overwrite each other. Translate them in separate runs` +
+					` with different output directories.`,
+				Fields: map[string]string{
+					"name": name,
+				},
 			}
 		}
+		seen[name] = file
+	}
+	return nil
+}
+
+// makeOutputDirectory creates the output directory and returns its absolute
+// path.
+func makeOutputDirectory(directory string) (string, error) {
+	outputDirectory, err := filepath.Abs(directory)
+	if err != nil {
+		return "", clierror.UIError{
+			Err:         err,
+			Operation:   "get absolute output directory",
+			Description: "unable to get absolute path for output directory",
+			Fields: map[string]string{
+				"outputDirectory": directory,
+			},
+		}
+	}
+	if err := os.MkdirAll(outputDirectory, 0o755); err != nil {
+		return "", clierror.UIError{
+			Err:         err,
+			Operation:   "create output directory",
+			Description: "unable to create output directory",
+			Fields: map[string]string{
+				"outputDirectory": outputDirectory,
+			},
+		}
+	}
+	return outputDirectory, nil
+}
+
+// wait pauses for pollingInterval, returning early when ctx is done.
+func wait(ctx context.Context) error {
+	timer := time.NewTimer(pollingInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-	return res, nil
 }
 
 func (s service) GetFiles(inputDirectory, fileOrPattern string) ([]string, error) {
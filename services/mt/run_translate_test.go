@@ -0,0 +1,185 @@
+package mt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Smartling/smartling-cli/services/devserver"
+	clierror "github.com/Smartling/smartling-cli/services/helpers/cli_error"
+	"github.com/Smartling/smartling-cli/services/helpers/rlog"
+
+	sdk "github.com/Smartling/api-sdk-go"
+	api "github.com/Smartling/api-sdk-go/api/mt"
+	"github.com/Smartling/api-sdk-go/helpers/uid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	rlog.Init()
+	os.Exit(m.Run())
+}
+
+// slowUploader counts the uploads in flight, holding each one long enough
+// for the workers to overlap.
+type slowUploader struct {
+	api.Uploader
+	inFlight, maxInFlight atomic.Int32
+}
+
+func (u *slowUploader) UploadFile(ctx context.Context, accountUID uid.AccountUID, filename string, req api.UploadFileRequest) (api.UploadFileResponse, error) {
+	n := u.inFlight.Add(1)
+	defer u.inFlight.Add(-1)
+	for {
+		peak := u.maxInFlight.Load()
+		if n <= peak || u.maxInFlight.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	return u.Uploader.UploadFile(ctx, accountUID, filename, req)
+}
+
+// failingUploader fails the upload of one file name.
+type failingUploader struct {
+	api.Uploader
+	failName string
+}
+
+func (u failingUploader) UploadFile(ctx context.Context, accountUID uid.AccountUID, filename string, req api.UploadFileRequest) (api.UploadFileResponse, error) {
+	if filename == u.failName {
+		return api.UploadFileResponse{}, errors.New("upload rejected")
+	}
+	return u.Uploader.UploadFile(ctx, accountUID, filename, req)
+}
+
+// newDevService returns a service against a dev server, with the uploader
+// wrapped by wrap.
+func newDevService(t *testing.T, wrap func(api.Uploader) api.Uploader) Service {
+	t.Helper()
+	t.Cleanup(func() { pollingInterval = time.Second })
+	pollingInterval = 0
+
+	server := httptest.NewServer(devserver.NewServer(devserver.Params{
+		AccountUID:     "account",
+		ProjectID:      "project",
+		SourceLocaleID: "en-US",
+	}))
+	t.Cleanup(server.Close)
+	client := sdk.NewHttpAPIClient(server.Client(), "user", "secret")
+	client.Client.BaseURL = server.URL
+	// Like the CLI client, authenticate before requests are made concurrently.
+	require.NoError(t, client.Client.Authenticate(t.Context()))
+	return NewService(api.NewDownloader(client.Client), api.NewFileTranslator(client.Client), wrap(api.NewUploader(client.Client)), api.NewTranslationControl(client.Client))
+}
+
+func TestRunTranslate(t *testing.T) {
+	var uploader *slowUploader
+	s := newDevService(t, func(u api.Uploader) api.Uploader {
+		uploader = &slowUploader{Uploader: u}
+		return uploader
+	})
+
+	inputDirectory, outputDirectory := t.TempDir(), t.TempDir()
+	var files []string
+	for i := range 6 {
+		file := filepath.Join(inputDirectory, fmt.Sprintf("file%d.txt", i))
+		require.NoError(t, os.WriteFile(file, []byte(fmt.Sprintf("text %d\n", i)), 0o600))
+		files = append(files, file)
+	}
+	params := TranslateParams{
+		TargetLocales:   []string{"de-DE", "fr-FR"},
+		InputDirectory:  inputDirectory,
+		OutputDirectory: outputDirectory,
+		AccountUID:      "account",
+		Threads:         3,
+	}
+
+	updates := make(chan any)
+	downloaded := map[uint32]string{}
+	var received sync.WaitGroup
+	received.Go(func() {
+		for update := range updates {
+			if u, ok := update.(TranslateUpdates); ok && u.Download != nil {
+				downloaded[u.ID] = *u.Locale + " " + *u.TranslatedFile
+			}
+		}
+	})
+	out, err := s.RunTranslate(t.Context(), params, files, updates)
+	close(updates)
+	received.Wait()
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, uploader.maxInFlight.Load(), int32(3))
+	assert.Greater(t, uploader.maxInFlight.Load(), int32(1))
+
+	require.Len(t, out, 12)
+	require.Len(t, downloaded, 12)
+	for i, o := range out {
+		fileID := i / 2
+		assert.Equal(t, fmt.Sprintf("file%d.txt", fileID), o.File)
+		assert.Equal(t, params.TargetLocales[i%2], o.Locale)
+		translated := fmt.Sprintf("file%d_%s.txt", fileID, o.Locale)
+		assert.Equal(t, o.Locale+" "+translated, downloaded[uint32(i)])
+		content, err := os.ReadFile(filepath.Join(outputDirectory, translated))
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("text %d\n", fileID), string(content))
+	}
+}
+
+func TestRunTranslate_SameFileName(t *testing.T) {
+	outputDirectory := filepath.Join(t.TempDir(), "out")
+	s := NewService(nil, nil, nil, nil)
+	_, err := s.RunTranslate(t.Context(), TranslateParams{
+		TargetLocales:   []string{"de-DE"},
+		OutputDirectory: outputDirectory,
+	}, []string{"a/README.md", "b/guide.md", "c/README.md"}, make(chan any))
+	assert.ErrorContains(t, err, "a/README.md and c/README.md have the same file name")
+	assert.NoDirExists(t, outputDirectory, "nothing is translated")
+}
+
+func TestRunTranslate_PartialFailure(t *testing.T) {
+	s := newDevService(t, func(u api.Uploader) api.Uploader {
+		return failingUploader{Uploader: u, failName: "b.txt"}
+	})
+
+	inputDirectory := t.TempDir()
+	var files []string
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		file := filepath.Join(inputDirectory, name)
+		require.NoError(t, os.WriteFile(file, []byte("text\n"), 0o600))
+		files = append(files, file)
+	}
+	params := TranslateParams{
+		TargetLocales:   []string{"de-DE"},
+		InputDirectory:  inputDirectory,
+		OutputDirectory: t.TempDir(),
+		AccountUID:      "account",
+		Threads:         1,
+	}
+
+	updates := make(chan any)
+	var received sync.WaitGroup
+	received.Go(func() {
+		for range updates {
+		}
+	})
+	out, err := s.RunTranslate(t.Context(), params, files, updates)
+	close(updates)
+	received.Wait()
+
+	assert.Equal(t, clierror.ExitPartial, clierror.ExitCode(err))
+	assert.ErrorContains(t, err, "1 of 3 items failed")
+	assert.ErrorContains(t, err, files[1]+": upload rejected")
+	require.Len(t, out, 2, "the files translated before and after the failure are returned")
+	assert.Equal(t, "a.txt", out[0].File)
+	assert.Equal(t, "c.txt", out[1].File)
+}